@@ -0,0 +1,156 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+)
+
+// Assign stores a decoded value in the provided target, which should be a pointer. The decoded structs
+// (map[string]interface{}) are stored field by field in Go structs, the fields being matched by the `abi:"<name>"` tag
+// or by name, the lists are stored in Go slices, the numbers in any Go integer type large enough to hold them and the
+// addresses in core.AddressHandler, []byte or bech32 string targets
+func Assign(target interface{}, value interface{}) error {
+	reflectedTarget := reflect.ValueOf(target)
+	if reflectedTarget.Kind() != reflect.Ptr || reflectedTarget.IsNil() {
+		return fmt.Errorf("%w, provided: %T", ErrNilTarget, target)
+	}
+
+	return assignValue(reflectedTarget.Elem(), value)
+}
+
+func assignValue(target reflect.Value, value interface{}) error {
+	if isNilValue(value) {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	if reflectedValue.Type().AssignableTo(target.Type()) {
+		target.Set(reflectedValue)
+		return nil
+	}
+
+	switch target.Kind() {
+	case reflect.Ptr:
+		newValue := reflect.New(target.Type().Elem())
+		err := assignValue(newValue.Elem(), value)
+		if err != nil {
+			return err
+		}
+		target.Set(newValue)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := toBigInt(value)
+		if !ok || !number.IsInt64() || target.OverflowInt(number.Int64()) {
+			return cannotAssignError(target, value)
+		}
+		target.SetInt(number.Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, ok := toBigInt(value)
+		if !ok || !number.IsUint64() || target.OverflowUint(number.Uint64()) {
+			return cannotAssignError(target, value)
+		}
+		target.SetUint(number.Uint64())
+		return nil
+	case reflect.String:
+		return assignString(target, value)
+	case reflect.Slice:
+		return assignSlice(target, value)
+	case reflect.Struct:
+		return assignStruct(target, value)
+	default:
+		return cannotAssignError(target, value)
+	}
+}
+
+func assignString(target reflect.Value, value interface{}) error {
+	switch typedValue := value.(type) {
+	case core.AddressHandler:
+		bech32Address, err := typedValue.AddressAsBech32String()
+		if err != nil {
+			return err
+		}
+		target.SetString(bech32Address)
+	case *EnumValue:
+		target.SetString(typedValue.Name)
+	case *big.Int:
+		target.SetString(typedValue.String())
+	case []byte:
+		target.SetString(string(typedValue))
+	default:
+		return cannotAssignError(target, value)
+	}
+
+	return nil
+}
+
+func assignSlice(target reflect.Value, value interface{}) error {
+	if target.Type().Elem().Kind() == reflect.Uint8 {
+		switch typedValue := value.(type) {
+		case core.AddressHandler:
+			target.SetBytes(typedValue.AddressBytes())
+			return nil
+		case string:
+			target.SetBytes([]byte(typedValue))
+			return nil
+		}
+	}
+
+	items, ok := toSlice(value)
+	if !ok {
+		return cannotAssignError(target, value)
+	}
+
+	result := reflect.MakeSlice(target.Type(), len(items), len(items))
+	for idx, item := range items {
+		err := assignValue(result.Index(idx), item)
+		if err != nil {
+			return fmt.Errorf("%w at index %d", err, idx)
+		}
+	}
+	target.Set(result)
+
+	return nil
+}
+
+func assignStruct(target reflect.Value, value interface{}) error {
+	if target.Type() == bigIntReflectType {
+		number, ok := toBigInt(value)
+		if !ok {
+			return cannotAssignError(target, value)
+		}
+		target.Set(reflect.ValueOf(*number))
+		return nil
+	}
+
+	fields, ok := value.(map[string]interface{})
+	if !ok {
+		enumValue, isEnum := value.(*EnumValue)
+		if !isEnum {
+			return cannotAssignError(target, value)
+		}
+		fields = enumValue.Fields
+	}
+
+	for name, fieldValue := range fields {
+		field, found := structFieldByName(target, name)
+		if !found || !field.CanSet() {
+			continue
+		}
+
+		err := assignValue(field, fieldValue)
+		if err != nil {
+			return fmt.Errorf("%w for field %s", err, name)
+		}
+	}
+
+	return nil
+}
+
+func cannotAssignError(target reflect.Value, value interface{}) error {
+	return fmt.Errorf("%w, %v (%T) to %s", ErrCannotAssign, value, value, target.Type().String())
+}
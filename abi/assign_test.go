@@ -0,0 +1,126 @@
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid targets should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := Assign(nil, uint8(1))
+		assert.True(t, errors.Is(err, ErrNilTarget))
+
+		var value uint8
+		err = Assign(value, uint8(1))
+		assert.True(t, errors.Is(err, ErrNilTarget))
+
+		var nilPointer *uint8
+		err = Assign(nilPointer, uint8(1))
+		assert.True(t, errors.Is(err, ErrNilTarget))
+	})
+	t.Run("numbers should be converted", func(t *testing.T) {
+		t.Parallel()
+
+		var intValue int
+		err := Assign(&intValue, uint32(5))
+		require.Nil(t, err)
+		assert.Equal(t, 5, intValue)
+
+		var uintValue uint16
+		err = Assign(&uintValue, big.NewInt(300))
+		require.Nil(t, err)
+		assert.Equal(t, uint16(300), uintValue)
+
+		err = Assign(&uintValue, int8(-1))
+		assert.True(t, errors.Is(err, ErrCannotAssign))
+
+		var int8Value int8
+		err = Assign(&int8Value, big.NewInt(200))
+		assert.True(t, errors.Is(err, ErrCannotAssign))
+
+		var bigValue big.Int
+		err = Assign(&bigValue, uint64(7))
+		require.Nil(t, err)
+		assert.Equal(t, "7", bigValue.String())
+
+		var bigPointer *big.Int
+		err = Assign(&bigPointer, uint64(8))
+		require.Nil(t, err)
+		assert.Equal(t, "8", bigPointer.String())
+
+		var numberAsString string
+		err = Assign(&numberAsString, big.NewInt(9))
+		require.Nil(t, err)
+		assert.Equal(t, "9", numberAsString)
+	})
+	t.Run("addresses should be converted", func(t *testing.T) {
+		t.Parallel()
+
+		address, _ := data.NewAddressFromBech32String(testAddress)
+
+		var bech32Address string
+		err := Assign(&bech32Address, address)
+		require.Nil(t, err)
+		assert.Equal(t, testAddress, bech32Address)
+
+		var addressBytes []byte
+		err = Assign(&addressBytes, address)
+		require.Nil(t, err)
+		assert.Equal(t, address.AddressBytes(), addressBytes)
+	})
+	t.Run("lists, pointers and nil values should work", func(t *testing.T) {
+		t.Parallel()
+
+		var list []*uint64
+		err := Assign(&list, []interface{}{uint32(1), nil})
+		require.Nil(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, uint64(1), *list[0])
+		assert.Nil(t, list[1])
+
+		var text string
+		err = Assign(&text, []byte("abc"))
+		require.Nil(t, err)
+		assert.Equal(t, "abc", text)
+
+		var buff []byte
+		err = Assign(&buff, "abc")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("abc"), buff)
+
+		var generic interface{}
+		err = Assign(&generic, []interface{}{true})
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{true}, generic)
+
+		var flag bool
+		err = Assign(&flag, uint8(1))
+		assert.True(t, errors.Is(err, ErrCannotAssign))
+	})
+	t.Run("enum fields should be assigned to structs", func(t *testing.T) {
+		t.Parallel()
+
+		type cancelled struct {
+			Reason string
+		}
+
+		result := &cancelled{}
+		err := Assign(result, &EnumValue{Name: "Cancelled", Fields: map[string]interface{}{"reason": "abc", "other": 1}})
+		require.Nil(t, err)
+		assert.Equal(t, "abc", result.Reason)
+
+		var name string
+		err = Assign(&name, &EnumValue{Name: "Cancelled"})
+		require.Nil(t, err)
+		assert.Equal(t, "Cancelled", name)
+	})
+}
@@ -0,0 +1,491 @@
+package abi
+
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/serde"
+)
+
+// codec encodes the endpoint arguments and decodes the endpoint results and the events of a smart contract, based
+// on the types declared in its ABI. Each argument and each result is top level encoded, while the values contained
+// in lists, options, tuples, structs and enums are nested encoded. The multi-value types (variadic, optional,
+// multi and counted-variadic) span over several arguments
+type codec struct {
+	definition *Definition
+}
+
+// NewCodec creates a new codec for the provided ABI definition. All the types used by the endpoints and the events
+// should be either primitive types or custom types declared in the ABI
+func NewCodec(definition *Definition) (*codec, error) {
+	if definition == nil {
+		return nil, ErrNilDefinition
+	}
+
+	c := &codec{
+		definition: definition,
+	}
+	err := c.checkDefinition()
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// NewCodecFromFile creates a new codec for the ABI JSON found in the provided file
+func NewCodecFromFile(filename string) (*codec, error) {
+	definition, err := LoadDefinitionFromFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCodec(definition)
+}
+
+func (c *codec) checkDefinition() error {
+	endpoints := append(make([]*Endpoint, 0, len(c.definition.Endpoints)+2), c.definition.Endpoints...)
+	endpoints = append(endpoints, c.definition.Constructor, c.definition.UpgradeConstructor)
+	for _, endpoint := range endpoints {
+		if endpoint == nil {
+			continue
+		}
+
+		parameters := append(append(make([]*Parameter, 0), endpoint.Inputs...), endpoint.Outputs...)
+		for _, parameter := range parameters {
+			err := c.checkType(parameter.Type, make(map[string]bool))
+			if err != nil {
+				return fmt.Errorf("%w in endpoint %s", err, endpoint.Name)
+			}
+		}
+	}
+
+	for _, event := range c.definition.Events {
+		if event == nil {
+			continue
+		}
+		for _, input := range event.Inputs {
+			err := c.checkType(input.Type, make(map[string]bool))
+			if err != nil {
+				return fmt.Errorf("%w in event %s", err, event.Identifier)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *codec) checkType(typeName string, checkedCustomTypes map[string]bool) error {
+	expression, err := parseTypeExpression(typeName)
+	if err != nil {
+		return err
+	}
+
+	return c.checkTypeExpression(expression, checkedCustomTypes)
+}
+
+func (c *codec) checkTypeExpression(expression *typeExpression, checkedCustomTypes map[string]bool) error {
+	for _, generic := range expression.generics {
+		err := c.checkTypeExpression(generic, checkedCustomTypes)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, isArray := expression.arrayLength()
+	switch {
+	case isPrimitiveType(expression.name):
+		return nil
+	case expression.name == listType, expression.name == optionType, isArray,
+		expression.name == variadicType, expression.name == optionalType, expression.name == countedVariadicType:
+		if len(expression.generics) != 1 {
+			return fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+		}
+		return nil
+	case expression.name == tupleType, expression.name == multiType:
+		return nil
+	}
+
+	if checkedCustomTypes[expression.name] {
+		return nil
+	}
+	checkedCustomTypes[expression.name] = true
+
+	typeDefinition, err := c.getTypeDefinition(expression)
+	if err != nil {
+		return err
+	}
+
+	fields := append(make([]*Field, 0, len(typeDefinition.Fields)), typeDefinition.Fields...)
+	for _, variant := range typeDefinition.Variants {
+		fields = append(fields, variant.Fields...)
+	}
+	for _, field := range fields {
+		err = c.checkType(field.Type, checkedCustomTypes)
+		if err != nil {
+			return fmt.Errorf("%w for field %s of %s", err, field.Name, expression.name)
+		}
+	}
+
+	return nil
+}
+
+// EncodeArguments encodes the provided values as the arguments of the endpoint. The values of the trailing optional
+// and variadic inputs can be omitted
+func (c *codec) EncodeArguments(function string, values ...interface{}) ([][]byte, error) {
+	endpoint, err := c.definition.GetEndpoint(function)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > len(endpoint.Inputs) {
+		return nil, fmt.Errorf("%w for %s, provided: %d, expected: %d", ErrWrongNumberOfArguments,
+			function, len(values), len(endpoint.Inputs))
+	}
+
+	args := make([][]byte, 0, len(values))
+	for idx, input := range endpoint.Inputs {
+		expression, errParse := parseTypeExpression(input.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		if idx >= len(values) {
+			if expression.name != optionalType && expression.name != variadicType {
+				return nil, fmt.Errorf("%w for %s, provided: %d, expected: %d", ErrWrongNumberOfArguments,
+					function, len(values), len(endpoint.Inputs))
+			}
+			continue
+		}
+
+		encoded, errEncode := c.encodeMultiValue(expression, values[idx])
+		if errEncode != nil {
+			return nil, fmt.Errorf("%w for the argument %s of %s", errEncode, input.Name, function)
+		}
+		args = append(args, encoded...)
+	}
+
+	return args, nil
+}
+
+func (c *codec) encodeMultiValue(expression *typeExpression, value interface{}) ([][]byte, error) {
+	switch expression.name {
+	case variadicType, countedVariadicType:
+		if len(expression.generics) != 1 {
+			return nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+		}
+		items, ok := toSlice(dereference(value))
+		if !ok && !isNilValue(value) {
+			return nil, invalidValueError(expression, value)
+		}
+
+		args := make([][]byte, 0, len(items)+1)
+		if expression.name == countedVariadicType {
+			numItems, _ := c.encodeTopLevel(&typeExpression{name: usizeType}, len(items))
+			args = append(args, numItems)
+		}
+		for _, item := range items {
+			encoded, err := c.encodeMultiValue(expression.generics[0], item)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, encoded...)
+		}
+		return args, nil
+	case optionalType:
+		if len(expression.generics) != 1 {
+			return nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+		}
+		if isNilValue(value) {
+			return make([][]byte, 0), nil
+		}
+		return c.encodeMultiValue(expression.generics[0], value)
+	case multiType:
+		items, ok := toSlice(dereference(value))
+		if !ok || len(items) != len(expression.generics) {
+			return nil, invalidValueError(expression, value)
+		}
+
+		args := make([][]byte, 0, len(items))
+		for idx, item := range items {
+			encoded, err := c.encodeMultiValue(expression.generics[idx], item)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, encoded...)
+		}
+		return args, nil
+	default:
+		encoded, err := c.encodeTopLevel(expression, value)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{encoded}, nil
+	}
+}
+
+// DecodeOutputs decodes the return data of the endpoint, returning a value for each declared output
+func (c *codec) DecodeOutputs(function string, returnData [][]byte) ([]interface{}, error) {
+	endpoint, err := c.definition.GetEndpoint(function)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]interface{}, 0, len(endpoint.Outputs))
+	remaining := returnData
+	for _, output := range endpoint.Outputs {
+		expression, errParse := parseTypeExpression(output.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		var value interface{}
+		value, remaining, err = c.decodeMultiValue(expression, remaining)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the output of %s", err, function)
+		}
+		results = append(results, value)
+	}
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("%w, %d results left after decoding the outputs of %s", ErrTrailingData,
+			len(remaining), function)
+	}
+
+	return results, nil
+}
+
+// DecodeOutputsInto decodes the return data of the endpoint and stores each output in the corresponding target
+func (c *codec) DecodeOutputsInto(function string, returnData [][]byte, targets ...interface{}) error {
+	results, err := c.DecodeOutputs(function, returnData)
+	if err != nil {
+		return err
+	}
+	if len(targets) != len(results) {
+		return fmt.Errorf("%w, %d targets provided for the %d outputs of %s", ErrWrongNumberOfArguments,
+			len(targets), len(results), function)
+	}
+
+	for idx, result := range results {
+		err = Assign(targets[idx], result)
+		if err != nil {
+			return fmt.Errorf("%w for the output %d of %s", err, idx, function)
+		}
+	}
+
+	return nil
+}
+
+func (c *codec) decodeMultiValue(expression *typeExpression, args [][]byte) (interface{}, [][]byte, error) {
+	switch expression.name {
+	case variadicType:
+		if len(expression.generics) != 1 {
+			return nil, nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+		}
+
+		items := make([]interface{}, 0)
+		for len(args) > 0 {
+			item, remaining, err := c.decodeMultiValue(expression.generics[0], args)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+			args = remaining
+		}
+		return items, args, nil
+	case countedVariadicType:
+		if len(expression.generics) != 1 {
+			return nil, nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+		}
+
+		numItems, remaining, err := c.decodeMultiValue(&typeExpression{name: usizeType}, args)
+		if err != nil {
+			return nil, nil, err
+		}
+		capacity := int(numItems.(uint32))
+		if capacity > len(remaining) {
+			capacity = len(remaining)
+		}
+		items := make([]interface{}, 0, capacity)
+		for idx := uint32(0); idx < numItems.(uint32); idx++ {
+			var item interface{}
+			item, remaining, err = c.decodeMultiValue(expression.generics[0], remaining)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, remaining, nil
+	case optionalType:
+		if len(expression.generics) != 1 {
+			return nil, nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+		}
+		if len(args) == 0 {
+			return nil, args, nil
+		}
+		return c.decodeMultiValue(expression.generics[0], args)
+	case multiType:
+		items := make([]interface{}, 0, len(expression.generics))
+		for _, generic := range expression.generics {
+			item, remaining, err := c.decodeMultiValue(generic, args)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+			args = remaining
+		}
+		return items, args, nil
+	default:
+		if len(args) == 0 {
+			return nil, nil, fmt.Errorf("%w while decoding %s", ErrNotEnoughData, expression.String())
+		}
+		value, err := c.decodeTopLevel(expression, args[0])
+		if err != nil {
+			return nil, nil, err
+		}
+		return value, args[1:], nil
+	}
+}
+
+// DecodeEvent decodes the fields of a contract event. The event identifier is the first topic, the indexed inputs are
+// top level encoded in the following topics, while the other inputs are found in the data field: top level encoded if
+// there is only one, otherwise nested encoded one after the other
+func (c *codec) DecodeEvent(event *transaction.Events) (map[string]interface{}, error) {
+	if event == nil {
+		return nil, ErrNilEvent
+	}
+	if len(event.Topics) == 0 {
+		return nil, fmt.Errorf("%w, missing the event identifier topic", ErrNotEnoughData)
+	}
+
+	eventDefinition, err := c.definition.GetEvent(string(event.Topics[0]))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]interface{}, len(eventDefinition.Inputs))
+	topics := event.Topics[1:]
+	dataInputs := make([]*EventInput, 0)
+	for _, input := range eventDefinition.Inputs {
+		if !input.Indexed {
+			dataInputs = append(dataInputs, input)
+			continue
+		}
+
+		expression, errParse := parseTypeExpression(input.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		var value interface{}
+		value, topics, err = c.decodeMultiValue(expression, topics)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the field %s of the event %s", err, input.Name, eventDefinition.Identifier)
+		}
+		result[input.Name] = value
+	}
+
+	err = c.decodeEventData(eventDefinition, dataInputs, event.Data, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *codec) decodeEventData(eventDefinition *Event, inputs []*EventInput, eventData []byte, result map[string]interface{}) error {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	if len(inputs) == 1 {
+		expression, err := parseTypeExpression(inputs[0].Type)
+		if err != nil {
+			return err
+		}
+
+		value, err := c.decodeTopLevel(expression, eventData)
+		if err != nil {
+			return fmt.Errorf("%w for the field %s of the event %s", err, inputs[0].Name, eventDefinition.Identifier)
+		}
+		result[inputs[0].Name] = value
+
+		return nil
+	}
+
+	buffer := serde.NewSourceBuffer(eventData)
+	for _, input := range inputs {
+		expression, err := parseTypeExpression(input.Type)
+		if err != nil {
+			return err
+		}
+
+		value, err := c.decodeNested(expression, buffer)
+		if err != nil {
+			return fmt.Errorf("%w for the field %s of the event %s", err, input.Name, eventDefinition.Identifier)
+		}
+		result[input.Name] = value
+	}
+	if buffer.Len() > 0 {
+		return fmt.Errorf("%w, %d bytes left while decoding the event %s", ErrTrailingData, buffer.Len(), eventDefinition.Identifier)
+	}
+
+	return nil
+}
+
+// EncodeTopLevel top level encodes the provided value as the provided type
+func (c *codec) EncodeTopLevel(typeName string, value interface{}) ([]byte, error) {
+	expression, err := parseTypeExpression(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.encodeTopLevel(expression, value)
+}
+
+// EncodeNested nested encodes the provided value as the provided type
+func (c *codec) EncodeNested(typeName string, value interface{}) ([]byte, error) {
+	expression, err := parseTypeExpression(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.encodeNested(expression, value)
+}
+
+// DecodeTopLevel decodes the top level encoded value of the provided type
+func (c *codec) DecodeTopLevel(typeName string, buff []byte) (interface{}, error) {
+	expression, err := parseTypeExpression(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.decodeTopLevel(expression, buff)
+}
+
+// DecodeNested decodes the nested encoded value of the provided type. All the bytes should be used
+func (c *codec) DecodeNested(typeName string, buff []byte) (interface{}, error) {
+	expression, err := parseTypeExpression(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := serde.NewSourceBuffer(buff)
+	value, err := c.decodeNested(expression, buffer)
+	if err != nil {
+		return nil, err
+	}
+	if buffer.Len() > 0 {
+		return nil, fmt.Errorf("%w, %d bytes left while decoding %s", ErrTrailingData, buffer.Len(), typeName)
+	}
+
+	return value, nil
+}
+
+// Definition returns the ABI definition
+func (c *codec) Definition() *Definition {
+	return c.definition
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *codec) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,594 @@
+package abi
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	exampleABIFile = "./testdata/example.abi.json"
+	testAddress    = "drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw"
+	testTokenID    = "USDC-a1b2c3"
+)
+
+type testOrder struct {
+	TokenID   string `abi:"token_id"`
+	Amount    *big.Int
+	Receivers []core.AddressHandler
+	Priority  string
+}
+
+func createExampleCodec(t *testing.T) *codec {
+	c, err := NewCodecFromFile(exampleABIFile)
+	require.Nil(t, err)
+
+	return c
+}
+
+func fromHex(t *testing.T, hexString string) []byte {
+	buff, err := hex.DecodeString(hexString)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func toHexList(args [][]byte) []string {
+	result := make([]string, 0, len(args))
+	for _, arg := range args {
+		result = append(result, hex.EncodeToString(arg))
+	}
+
+	return result
+}
+
+func testAddressHex(t *testing.T) string {
+	address, err := data.NewAddressFromBech32String(testAddress)
+	require.Nil(t, err)
+
+	return hex.EncodeToString(address.AddressBytes())
+}
+
+// nestedOrderHex returns the nested encoding of an order of 1000 tokens, with the test address as receiver and a high priority
+func nestedOrderHex(t *testing.T) string {
+	return "0000000b" + hex.EncodeToString([]byte(testTokenID)) +
+		"0000000203e8" +
+		"00000001" + testAddressHex(t) +
+		"00000004" + hex.EncodeToString([]byte("High"))
+}
+
+func assertDecodedValue(t *testing.T, expected interface{}, decoded interface{}, msgAndArgs ...interface{}) {
+	expectedNumber, isNumber := expected.(*big.Int)
+	if isNumber {
+		decodedNumber, ok := decoded.(*big.Int)
+		require.True(t, ok, msgAndArgs...)
+		assert.Equal(t, expectedNumber.String(), decodedNumber.String(), msgAndArgs...)
+		return
+	}
+
+	assert.Equal(t, expected, decoded, msgAndArgs...)
+}
+
+func TestNewCodec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil definition should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewCodec(nil)
+		assert.Nil(t, c)
+		assert.Equal(t, ErrNilDefinition, err)
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewCodecFromFile("./testdata/missing.abi.json")
+		assert.Nil(t, c)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid JSON should error", func(t *testing.T) {
+		t.Parallel()
+
+		definition, err := NewDefinitionFromJSON([]byte("{"))
+		assert.Nil(t, definition)
+		assert.NotNil(t, err)
+	})
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		definition := &Definition{
+			Endpoints: []*Endpoint{
+				{
+					Name:    "get",
+					Outputs: []*Parameter{{Type: "List<Missing>"}},
+				},
+			},
+		}
+		c, err := NewCodec(definition)
+		assert.Nil(t, c)
+		assert.True(t, errors.Is(err, ErrUnknownType))
+		assert.True(t, strings.Contains(err.Error(), "in endpoint get"))
+	})
+	t.Run("invalid custom type should error", func(t *testing.T) {
+		t.Parallel()
+
+		definition := &Definition{
+			Events: []*Event{
+				{
+					Identifier: "event",
+					Inputs:     []*EventInput{{Name: "field", Type: "Custom"}},
+				},
+			},
+			Types: map[string]*TypeDefinition{
+				"Custom": {Type: "union"},
+			},
+		}
+		c, err := NewCodec(definition)
+		assert.Nil(t, c)
+		assert.True(t, errors.Is(err, ErrInvalidTypeDefinition))
+	})
+	t.Run("invalid type expression should error", func(t *testing.T) {
+		t.Parallel()
+
+		definition := &Definition{
+			Constructor: &Endpoint{
+				Inputs: []*Parameter{{Name: "value", Type: "Option<u8,u16>"}},
+			},
+		}
+		c, err := NewCodec(definition)
+		assert.Nil(t, c)
+		assert.True(t, errors.Is(err, ErrInvalidTypeExpression))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		c := createExampleCodec(t)
+		assert.False(t, c.IsInterfaceNil())
+		assert.Equal(t, "Example", c.Definition().Name)
+	})
+}
+
+func TestCodec_EncodeDecodeValues(t *testing.T) {
+	t.Parallel()
+
+	c := createExampleCodec(t)
+	testCases := []struct {
+		typeName string
+		value    interface{}
+		topLevel string
+		nested   string
+		decoded  interface{}
+	}{
+		{typeName: "u8", value: 5, topLevel: "05", nested: "05", decoded: uint8(5)},
+		{typeName: "u16", value: uint16(0), topLevel: "", nested: "0000", decoded: uint16(0)},
+		{typeName: "u32", value: int64(256), topLevel: "0100", nested: "00000100", decoded: uint32(256)},
+		{typeName: "u64", value: "18446744073709551615", topLevel: "ffffffffffffffff", nested: "ffffffffffffffff", decoded: uint64(18446744073709551615)},
+		{typeName: "usize", value: 7, topLevel: "07", nested: "00000007", decoded: uint32(7)},
+		{typeName: "i8", value: -1, topLevel: "ff", nested: "ff", decoded: int8(-1)},
+		{typeName: "i16", value: 128, topLevel: "0080", nested: "0080", decoded: int16(128)},
+		{typeName: "i32", value: -129, topLevel: "ff7f", nested: "ffffff7f", decoded: int32(-129)},
+		{typeName: "i64", value: 0, topLevel: "", nested: "0000000000000000", decoded: int64(0)},
+		{typeName: "BigUint", value: big.NewInt(1000), topLevel: "03e8", nested: "0000000203e8", decoded: big.NewInt(1000)},
+		{typeName: "BigUint", value: 0, topLevel: "", nested: "00000000", decoded: big.NewInt(0)},
+		{typeName: "BigInt", value: -1, topLevel: "ff", nested: "00000001ff", decoded: big.NewInt(-1)},
+		{typeName: "BigInt", value: *big.NewInt(128), topLevel: "0080", nested: "000000020080", decoded: big.NewInt(128)},
+		{typeName: "BigInt", value: -128, topLevel: "80", nested: "0000000180", decoded: big.NewInt(-128)},
+		{typeName: "bool", value: true, topLevel: "01", nested: "01", decoded: true},
+		{typeName: "bool", value: false, topLevel: "", nested: "00", decoded: false},
+		{typeName: "bytes", value: []byte("abc"), topLevel: "616263", nested: "00000003616263", decoded: []byte("abc")},
+		{typeName: "utf-8 string", value: "abc", topLevel: "616263", nested: "00000003616263", decoded: "abc"},
+		{typeName: "TokenIdentifier", value: testTokenID, topLevel: hex.EncodeToString([]byte(testTokenID)), nested: "0000000b" + hex.EncodeToString([]byte(testTokenID)), decoded: testTokenID},
+		{typeName: "CodeMetadata", value: []byte{5, 0}, topLevel: "0500", nested: "0500", decoded: []byte{5, 0}},
+		{typeName: "Option<u32>", value: nil, topLevel: "", nested: "00", decoded: nil},
+		{typeName: "Option<u32>", value: 5, topLevel: "0100000005", nested: "0100000005", decoded: uint32(5)},
+		{typeName: "List<u16>", value: []uint16{1, 2}, topLevel: "00010002", nested: "0000000200010002", decoded: []interface{}{uint16(1), uint16(2)}},
+		{typeName: "List<u8>", value: []byte{1, 2}, topLevel: "0102", nested: "000000020102", decoded: []byte{1, 2}},
+		{typeName: "List<Option<u8>>", value: []interface{}{nil, 3}, topLevel: "000103", nested: "00000002000103", decoded: []interface{}{nil, uint8(3)}},
+		{typeName: "tuple<u8,bool>", value: []interface{}{uint8(1), true}, topLevel: "0101", nested: "0101", decoded: []interface{}{uint8(1), true}},
+		{typeName: "array2<u16>", value: [2]uint16{1, 2}, topLevel: "00010002", nested: "00010002", decoded: []interface{}{uint16(1), uint16(2)}},
+		{typeName: "array3<u8>", value: []byte{1, 2, 3}, topLevel: "010203", nested: "010203", decoded: []byte{1, 2, 3}},
+		{typeName: "Status", value: "Pending", topLevel: "", nested: "00", decoded: &EnumValue{Name: "Pending", Discriminant: 0, Fields: map[string]interface{}{}}},
+		{typeName: "Status", value: EnumValue{Name: "Filled", Fields: map[string]interface{}{"0": 7}}, topLevel: "010000000000000007", nested: "010000000000000007", decoded: &EnumValue{Name: "Filled", Discriminant: 1, Fields: map[string]interface{}{"0": uint64(7)}}},
+		{typeName: "Priority", value: "High", topLevel: "48696768", nested: "0000000448696768", decoded: &EnumValue{Name: "High", Discriminant: 0, Fields: map[string]interface{}{}}},
+	}
+
+	for _, testCase := range testCases {
+		description := testCase.typeName + " " + testCase.topLevel
+
+		topLevel, err := c.EncodeTopLevel(testCase.typeName, testCase.value)
+		require.Nil(t, err, description)
+		assert.Equal(t, testCase.topLevel, hex.EncodeToString(topLevel), description)
+
+		nested, err := c.EncodeNested(testCase.typeName, testCase.value)
+		require.Nil(t, err, description)
+		assert.Equal(t, testCase.nested, hex.EncodeToString(nested), description)
+
+		decoded, err := c.DecodeTopLevel(testCase.typeName, topLevel)
+		require.Nil(t, err, description)
+		assertDecodedValue(t, testCase.decoded, decoded, description)
+
+		decoded, err = c.DecodeNested(testCase.typeName, nested)
+		require.Nil(t, err, description)
+		assertDecodedValue(t, testCase.decoded, decoded, description)
+	}
+}
+
+func TestCodec_EncodeDecodeStruct(t *testing.T) {
+	t.Parallel()
+
+	c := createExampleCodec(t)
+	address, _ := data.NewAddressFromBech32String(testAddress)
+
+	goStruct := &testOrder{
+		TokenID:   testTokenID,
+		Amount:    big.NewInt(1000),
+		Receivers: []core.AddressHandler{address},
+		Priority:  "High",
+	}
+	encoded, err := c.EncodeTopLevel("Order", goStruct)
+	require.Nil(t, err)
+	assert.Equal(t, nestedOrderHex(t), hex.EncodeToString(encoded))
+
+	mapStruct := map[string]interface{}{
+		"token_id":  testTokenID,
+		"amount":    1000,
+		"receivers": []string{testAddress},
+		"priority":  &EnumValue{Name: "High"},
+	}
+	encoded, err = c.EncodeNested("Order", mapStruct)
+	require.Nil(t, err)
+	assert.Equal(t, nestedOrderHex(t), hex.EncodeToString(encoded))
+
+	decoded, err := c.DecodeTopLevel("Order", encoded)
+	require.Nil(t, err)
+	decodedMap, ok := decoded.(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, testTokenID, decodedMap["token_id"])
+	assertDecodedValue(t, big.NewInt(1000), decodedMap["amount"])
+	assert.Equal(t, []interface{}{address}, decodedMap["receivers"])
+	assert.Equal(t, "High", decodedMap["priority"].(*EnumValue).Name)
+
+	decodedStruct := &testOrder{}
+	err = Assign(decodedStruct, decoded)
+	require.Nil(t, err)
+	assert.Equal(t, goStruct, decodedStruct)
+
+	delete(mapStruct, "amount")
+	_, err = c.EncodeNested("Order", mapStruct)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+	assert.True(t, strings.Contains(err.Error(), "missing field amount"))
+}
+
+func TestCodec_EncodeDecodeValuesErrors(t *testing.T) {
+	t.Parallel()
+
+	c := createExampleCodec(t)
+
+	t.Run("encode invalid values should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidValues := []struct {
+			typeName string
+			value    interface{}
+		}{
+			{typeName: "u8", value: 256},
+			{typeName: "u16", value: -1},
+			{typeName: "i8", value: 128},
+			{typeName: "i8", value: -129},
+			{typeName: "BigUint", value: -1},
+			{typeName: "BigUint", value: "not a number"},
+			{typeName: "bool", value: 1},
+			{typeName: "bytes", value: 1},
+			{typeName: "Address", value: "drt1invalid"},
+			{typeName: "Address", value: []byte{1, 2}},
+			{typeName: "List<u8>", value: 1},
+			{typeName: "tuple<u8,u8>", value: []int{1}},
+			{typeName: "array2<u8>", value: []byte{1}},
+			{typeName: "Order", value: 1},
+		}
+		for _, invalidValue := range invalidValues {
+			_, err := c.EncodeTopLevel(invalidValue.typeName, invalidValue.value)
+			assert.True(t, errors.Is(err, ErrInvalidValue), invalidValue.typeName)
+		}
+	})
+	t.Run("encode invalid enum variants should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.EncodeTopLevel("Status", "Unknown")
+		assert.True(t, errors.Is(err, ErrInvalidEnumVariant))
+
+		_, err = c.EncodeTopLevel("Status", 5)
+		assert.True(t, errors.Is(err, ErrInvalidEnumVariant))
+
+		_, err = c.EncodeTopLevel("Status", "Filled")
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("unknown types should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.EncodeNested("Missing", 1)
+		assert.True(t, errors.Is(err, ErrUnknownType))
+
+		_, err = c.DecodeNested("Missing", []byte{1})
+		assert.True(t, errors.Is(err, ErrUnknownType))
+
+		_, err = c.EncodeNested("List<variadic<u8>>", []int{1})
+		assert.True(t, errors.Is(err, ErrInvalidTypeExpression))
+
+		_, err = c.DecodeTopLevel("List<", []byte{1})
+		assert.True(t, errors.Is(err, ErrInvalidTypeExpression))
+	})
+	t.Run("decode invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.DecodeTopLevel("u16", []byte{1, 2, 3})
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		_, err = c.DecodeTopLevel("bool", []byte{2})
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		_, err = c.DecodeNested("u32", []byte{1, 2})
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+
+		_, err = c.DecodeNested("bytes", []byte{0, 0, 0, 5, 1})
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+
+		_, err = c.DecodeNested("u8", []byte{1, 2})
+		assert.True(t, errors.Is(err, ErrTrailingData))
+
+		_, err = c.DecodeTopLevel("Option<u8>", []byte{2, 1})
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		_, err = c.DecodeTopLevel("Status", []byte{9})
+		assert.True(t, errors.Is(err, ErrInvalidEnumVariant))
+
+		_, err = c.DecodeTopLevel("Priority", []byte("Medium"))
+		assert.True(t, errors.Is(err, ErrInvalidEnumVariant))
+	})
+	t.Run("huge length prefix over a short buffer should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := c.DecodeNested("List<u64>", fromHex(t, "ffffffff0000000000000001"))
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+
+		_, err = c.DecodeTopLevel("List<List<u32>>", fromHex(t, "ffffffff"))
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+
+		_, err = c.DecodeNested("Option<List<BigUint>>", fromHex(t, "01ffffffff00"))
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+	})
+}
+
+func TestCodec_EncodeArguments(t *testing.T) {
+	t.Parallel()
+
+	c := createExampleCodec(t)
+	address, _ := data.NewAddressFromBech32String(testAddress)
+
+	t.Run("unknown endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments("missing")
+		assert.Nil(t, args)
+		assert.True(t, errors.Is(err, ErrEndpointNotFound))
+	})
+	t.Run("too many arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments("getSum", 1)
+		assert.Nil(t, args)
+		assert.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+	})
+	t.Run("missing required argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments("getOrder")
+		assert.Nil(t, args)
+		assert.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+	})
+	t.Run("invalid argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments("getOrder", "abc")
+		assert.Nil(t, args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "for the argument id of getOrder"))
+	})
+	t.Run("struct, option and variadic arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		order := &testOrder{
+			TokenID:   testTokenID,
+			Amount:    big.NewInt(1000),
+			Receivers: []core.AddressHandler{address},
+			Priority:  "High",
+		}
+		args, err := c.EncodeArguments("createOrder", order, uint64(100), []string{"a", "b"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{nestedOrderHex(t), "010000000000000064", "61", "62"}, toHexList(args))
+
+		args, err = c.EncodeArguments("createOrder", order, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{nestedOrderHex(t), ""}, toHexList(args))
+	})
+	t.Run("optional, counted variadic, tuple and array arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments("getBalances")
+		require.Nil(t, err)
+		assert.Empty(t, args)
+
+		args, err = c.EncodeArguments("getBalances", testAddress)
+		require.Nil(t, err)
+		assert.Equal(t, []string{testAddressHex(t)}, toHexList(args))
+
+		args, err = c.EncodeArguments("setLimits", []int64{-1, 2}, []interface{}{uint8(1), big.NewInt(-2)}, []byte{1, 2, 3, 4})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"02", "ff", "02", "0100000001fe", "01020304"}, toHexList(args))
+	})
+	t.Run("constructors should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := c.EncodeArguments(ConstructorName, big.NewInt(1000))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"03e8"}, toHexList(args))
+
+		args, err = c.EncodeArguments(UpgradeConstructorName)
+		require.Nil(t, err)
+		assert.Empty(t, args)
+
+		args, err = c.EncodeArguments(UpgradeConstructorName, 5)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"05"}, toHexList(args))
+	})
+}
+
+func TestCodec_DecodeOutputs(t *testing.T) {
+	t.Parallel()
+
+	c := createExampleCodec(t)
+	address, _ := data.NewAddressFromBech32String(testAddress)
+	orderReturnData := [][]byte{fromHex(t, nestedOrderHex(t)), fromHex(t, "010000000000000007")}
+
+	t.Run("unknown endpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := c.DecodeOutputs("missing", nil)
+		assert.Nil(t, results)
+		assert.True(t, errors.Is(err, ErrEndpointNotFound))
+	})
+	t.Run("missing results should error", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := c.DecodeOutputs("getOrder", orderReturnData[:1])
+		assert.Nil(t, results)
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+	})
+	t.Run("extra results should error", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := c.DecodeOutputs("getSum", [][]byte{{1}, {2}})
+		assert.Nil(t, results)
+		assert.True(t, errors.Is(err, ErrTrailingData))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := c.DecodeOutputs("getSum", [][]byte{{3, 232}})
+		require.Nil(t, err)
+		require.Len(t, results, 1)
+		assertDecodedValue(t, big.NewInt(1000), results[0])
+
+		results, err = c.DecodeOutputs("getOrder", orderReturnData)
+		require.Nil(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, testTokenID, results[0].(map[string]interface{})["token_id"])
+		assert.Equal(t, &EnumValue{Name: "Filled", Discriminant: 1, Fields: map[string]interface{}{"0": uint64(7)}}, results[1])
+
+		results, err = c.DecodeOutputs("getBalances", [][]byte{[]byte("A-0001"), {5}, []byte("B-0002"), {}})
+		require.Nil(t, err)
+		require.Len(t, results, 1)
+		balances := results[0].([]interface{})
+		require.Len(t, balances, 2)
+		assert.Equal(t, "A-0001", balances[0].([]interface{})[0])
+		assertDecodedValue(t, big.NewInt(5), balances[0].([]interface{})[1])
+		assert.Equal(t, "B-0002", balances[1].([]interface{})[0])
+		assertDecodedValue(t, big.NewInt(0), balances[1].([]interface{})[1])
+	})
+	t.Run("decode into targets should work", func(t *testing.T) {
+		t.Parallel()
+
+		order := &testOrder{}
+		var status *EnumValue
+		err := c.DecodeOutputsInto("getOrder", orderReturnData, order, &status)
+		require.Nil(t, err)
+		assert.Equal(t, &testOrder{
+			TokenID:   testTokenID,
+			Amount:    big.NewInt(1000),
+			Receivers: []core.AddressHandler{address},
+			Priority:  "High",
+		}, order)
+		assert.Equal(t, "Filled", status.Name)
+
+		var sum uint64
+		err = c.DecodeOutputsInto("getSum", [][]byte{{3, 232}}, &sum)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1000), sum)
+
+		err = c.DecodeOutputsInto("getSum", [][]byte{{3, 232}})
+		assert.True(t, errors.Is(err, ErrWrongNumberOfArguments))
+
+		var small uint8
+		err = c.DecodeOutputsInto("getSum", [][]byte{{3, 232}}, &small)
+		assert.True(t, errors.Is(err, ErrCannotAssign))
+	})
+}
+
+func TestCodec_DecodeEvent(t *testing.T) {
+	t.Parallel()
+
+	c := createExampleCodec(t)
+	address, _ := data.NewAddressFromBech32String(testAddress)
+
+	t.Run("invalid events should error", func(t *testing.T) {
+		t.Parallel()
+
+		fields, err := c.DecodeEvent(nil)
+		assert.Nil(t, fields)
+		assert.Equal(t, ErrNilEvent, err)
+
+		fields, err = c.DecodeEvent(&transaction.Events{})
+		assert.Nil(t, fields)
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+
+		fields, err = c.DecodeEvent(&transaction.Events{Topics: [][]byte{[]byte("missing")}})
+		assert.Nil(t, fields)
+		assert.True(t, errors.Is(err, ErrEventNotFound))
+
+		fields, err = c.DecodeEvent(&transaction.Events{Topics: [][]byte{[]byte("orderCreated"), address.AddressBytes()}})
+		assert.Nil(t, fields)
+		assert.True(t, errors.Is(err, ErrNotEnoughData))
+
+		fields, err = c.DecodeEvent(&transaction.Events{
+			Topics: [][]byte{[]byte("statusChanged"), {1}},
+			Data:   []byte{0, 0, 5},
+		})
+		assert.Nil(t, fields)
+		assert.True(t, errors.Is(err, ErrTrailingData))
+	})
+	t.Run("single data field should work", func(t *testing.T) {
+		t.Parallel()
+
+		fields, err := c.DecodeEvent(&transaction.Events{
+			Identifier: "createOrder",
+			Topics:     [][]byte{[]byte("orderCreated"), address.AddressBytes(), {7}},
+			Data:       fromHex(t, nestedOrderHex(t)),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, address, fields["caller"])
+		assert.Equal(t, uint32(7), fields["id"])
+		assert.Equal(t, testTokenID, fields["order"].(map[string]interface{})["token_id"])
+	})
+	t.Run("multiple data fields should work", func(t *testing.T) {
+		t.Parallel()
+
+		fields, err := c.DecodeEvent(&transaction.Events{
+			Topics: [][]byte{[]byte("statusChanged"), {1}},
+			Data:   fromHex(t, "00"+"02"+"00000003"+hex.EncodeToString([]byte("abc"))),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"id":       uint32(1),
+			"previous": &EnumValue{Name: "Pending", Discriminant: 0, Fields: map[string]interface{}{}},
+			"current":  &EnumValue{Name: "Cancelled", Discriminant: 2, Fields: map[string]interface{}{"reason": "abc"}},
+		}, fields)
+	})
+}
@@ -0,0 +1,373 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/serde"
+)
+
+func (c *codec) decodeNested(expression *typeExpression, buffer *serde.SourceBuffer) (interface{}, error) {
+	size, isFixedSizeInteger := fixedSizeIntegerTypes[expression.name]
+	if isFixedSizeInteger {
+		buff, err := nextBytes(expression, buffer, size)
+		if err != nil {
+			return nil, err
+		}
+		return fixedSizeIntegerFromBytes(expression, buff)
+	}
+
+	switch {
+	case expression.name == bigUintType || expression.name == bigIntType:
+		buff, err := nextLengthPrefixedBytes(expression, buffer)
+		if err != nil {
+			return nil, err
+		}
+		return bigIntegerFromBytes(expression, buff), nil
+	case expression.name == boolType:
+		buff, err := nextBytes(expression, buffer, 1)
+		if err != nil {
+			return nil, err
+		}
+		return boolFromByte(expression, buff[0])
+	case bytesTypes[expression.name]:
+		return nextLengthPrefixedBytes(expression, buffer)
+	case stringTypes[expression.name]:
+		buff, err := nextLengthPrefixedBytes(expression, buffer)
+		if err != nil {
+			return nil, err
+		}
+		return string(buff), nil
+	case expression.name == listType:
+		return c.decodeList(expression, buffer)
+	case expression.name == optionType:
+		return c.decodeOption(expression, buffer)
+	case expression.name == tupleType:
+		return c.decodeNestedSequence(expression.generics, len(expression.generics), false, buffer)
+	}
+
+	length, isFixedSizeBytes := fixedSizeBytesTypes[expression.name]
+	if isFixedSizeBytes {
+		buff, err := nextBytes(expression, buffer, length)
+		if err != nil {
+			return nil, err
+		}
+		return fixedSizeBytesValue(expression, buff), nil
+	}
+	length, isArray := expression.arrayLength()
+	if isArray && len(expression.generics) == 1 {
+		if isByteElement(expression) {
+			return nextBytes(expression, buffer, length)
+		}
+		return c.decodeNestedSequence(expression.generics, length, true, buffer)
+	}
+	if multiValueTypes[expression.name] {
+		return nil, fmt.Errorf("%w, multi-value type %s can not be nested", ErrInvalidTypeExpression, expression.String())
+	}
+
+	return c.decodeCustomType(expression, buffer)
+}
+
+func (c *codec) decodeTopLevel(expression *typeExpression, buff []byte) (interface{}, error) {
+	size, isFixedSizeInteger := fixedSizeIntegerTypes[expression.name]
+	switch {
+	case isFixedSizeInteger:
+		if len(buff) > size {
+			return nil, fmt.Errorf("%w, %d bytes can not be decoded as %s", ErrInvalidValue, len(buff), expression.name)
+		}
+		return fixedSizeIntegerFromBytes(expression, extendTopLevelInteger(buff, size, signedIntegerTypes[expression.name]))
+	case expression.name == bigUintType || expression.name == bigIntType:
+		return bigIntegerFromBytes(expression, buff), nil
+	case expression.name == boolType:
+		if len(buff) == 0 {
+			return false, nil
+		}
+		if len(buff) > 1 {
+			return nil, fmt.Errorf("%w, %d bytes can not be decoded as %s", ErrInvalidValue, len(buff), expression.name)
+		}
+		return boolFromByte(expression, buff[0])
+	case bytesTypes[expression.name]:
+		return copyBytes(buff), nil
+	case stringTypes[expression.name]:
+		return string(buff), nil
+	case expression.name == listType && isByteElement(expression):
+		return copyBytes(buff), nil
+	case expression.name == listType:
+		return c.decodeListItemsUntilEnd(expression, serde.NewSourceBuffer(buff))
+	case expression.name == optionType && len(buff) == 0:
+		return nil, nil
+	}
+
+	typeDefinition, isCustomType := c.definition.Types[expression.name]
+	if isCustomType && typeDefinition != nil && typeDefinition.Type == explicitEnumTypeKind {
+		return c.explicitEnumValue(expression, typeDefinition, string(buff))
+	}
+	if isCustomType && typeDefinition != nil && typeDefinition.Type == enumTypeKind && len(buff) == 0 {
+		return c.decodeEnumVariant(expression, typeDefinition, 0, serde.NewSourceBuffer(buff))
+	}
+
+	buffer := serde.NewSourceBuffer(buff)
+	value, err := c.decodeNested(expression, buffer)
+	if err != nil {
+		return nil, err
+	}
+	if buffer.Len() > 0 {
+		return nil, fmt.Errorf("%w, %d bytes left while decoding %s", ErrTrailingData, buffer.Len(), expression.String())
+	}
+
+	return value, nil
+}
+
+func nextBytes(expression *typeExpression, buffer *serde.SourceBuffer, length int) ([]byte, error) {
+	buff, eof := buffer.NextBytes(uint32(length))
+	if eof {
+		return nil, fmt.Errorf("%w while decoding %s", ErrNotEnoughData, expression.String())
+	}
+
+	return copyBytes(buff), nil
+}
+
+func nextLengthPrefixedBytes(expression *typeExpression, buffer *serde.SourceBuffer) ([]byte, error) {
+	length, eof := buffer.NextUint32()
+	if eof {
+		return nil, fmt.Errorf("%w while decoding the length of %s", ErrNotEnoughData, expression.String())
+	}
+
+	return nextBytes(expression, buffer, int(length))
+}
+
+func copyBytes(buff []byte) []byte {
+	result := make([]byte, len(buff))
+	copy(result, buff)
+
+	return result
+}
+
+// extendTopLevelInteger restores the leading bytes trimmed from a top level encoded integer
+func extendTopLevelInteger(buff []byte, size int, isSigned bool) []byte {
+	padding := byte(0)
+	if isSigned && len(buff) > 0 && buff[0]&0x80 != 0 {
+		padding = 0xff
+	}
+
+	result := make([]byte, size-len(buff), size)
+	for idx := range result {
+		result[idx] = padding
+	}
+
+	return append(result, buff...)
+}
+
+func fixedSizeIntegerFromBytes(expression *typeExpression, buff []byte) (interface{}, error) {
+	switch expression.name {
+	case u8Type:
+		return buff[0], nil
+	case u16Type:
+		return binary.BigEndian.Uint16(buff), nil
+	case u32Type, usizeType:
+		return binary.BigEndian.Uint32(buff), nil
+	case u64Type:
+		return binary.BigEndian.Uint64(buff), nil
+	case i8Type:
+		return int8(buff[0]), nil
+	case i16Type:
+		return int16(binary.BigEndian.Uint16(buff)), nil
+	case i32Type, isizeType:
+		return int32(binary.BigEndian.Uint32(buff)), nil
+	case i64Type:
+		return int64(binary.BigEndian.Uint64(buff)), nil
+	default:
+		return nil, fmt.Errorf("%w, provided: %s", ErrUnknownType, expression.name)
+	}
+}
+
+func bigIntegerFromBytes(expression *typeExpression, buff []byte) *big.Int {
+	result := big.NewInt(0).SetBytes(buff)
+	if expression.name == bigIntType && len(buff) > 0 && buff[0]&0x80 != 0 {
+		result.Sub(result, big.NewInt(0).Lsh(big.NewInt(1), uint(len(buff)*8)))
+	}
+
+	return result
+}
+
+func boolFromByte(expression *typeExpression, value byte) (bool, error) {
+	switch value {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w, byte %d can not be decoded as %s", ErrInvalidValue, value, expression.name)
+	}
+}
+
+func fixedSizeBytesValue(expression *typeExpression, buff []byte) interface{} {
+	if expression.name == addressType {
+		return data.NewAddressFromBytes(buff)
+	}
+
+	return buff
+}
+
+func (c *codec) decodeList(expression *typeExpression, buffer *serde.SourceBuffer) (interface{}, error) {
+	if len(expression.generics) != 1 {
+		return nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+	}
+
+	numItems, eof := buffer.NextUint32()
+	if eof {
+		return nil, fmt.Errorf("%w while decoding the length of %s", ErrNotEnoughData, expression.String())
+	}
+	if isByteElement(expression) {
+		return nextBytes(expression, buffer, int(numItems))
+	}
+
+	return c.decodeNestedSequence(expression.generics, int(numItems), true, buffer)
+}
+
+func (c *codec) decodeListItemsUntilEnd(expression *typeExpression, buffer *serde.SourceBuffer) (interface{}, error) {
+	if len(expression.generics) != 1 {
+		return nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+	}
+
+	result := make([]interface{}, 0)
+	for buffer.Len() > 0 {
+		item, err := c.decodeNested(expression.generics[0], buffer)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (c *codec) decodeOption(expression *typeExpression, buffer *serde.SourceBuffer) (interface{}, error) {
+	if len(expression.generics) != 1 {
+		return nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+	}
+
+	flag, eof := buffer.NextByte()
+	if eof {
+		return nil, fmt.Errorf("%w while decoding %s", ErrNotEnoughData, expression.String())
+	}
+
+	switch flag {
+	case 0:
+		return nil, nil
+	case 1:
+		return c.decodeNested(expression.generics[0], buffer)
+	default:
+		return nil, fmt.Errorf("%w, invalid flag %d for %s", ErrInvalidValue, flag, expression.String())
+	}
+}
+
+// decodeNestedSequence nested decodes numItems items. If sameType is set, all the items have the first type.
+// The capacity is capped by the remaining data because numItems might come from an untrusted length prefix
+func (c *codec) decodeNestedSequence(
+	expressions []*typeExpression,
+	numItems int,
+	sameType bool,
+	buffer *serde.SourceBuffer,
+) ([]interface{}, error) {
+	capacity := numItems
+	if uint64(capacity) > buffer.Len() {
+		capacity = int(buffer.Len())
+	}
+
+	result := make([]interface{}, 0, capacity)
+	for idx := 0; idx < numItems; idx++ {
+		expression := expressions[0]
+		if !sameType {
+			expression = expressions[idx]
+		}
+
+		item, err := c.decodeNested(expression, buffer)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+func (c *codec) decodeFields(fields []*Field, buffer *serde.SourceBuffer) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		fieldExpression, err := parseTypeExpression(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := c.decodeNested(fieldExpression, buffer)
+		if err != nil {
+			return nil, fmt.Errorf("%w for field %s", err, field.Name)
+		}
+		result[field.Name] = value
+	}
+
+	return result, nil
+}
+
+func (c *codec) decodeCustomType(expression *typeExpression, buffer *serde.SourceBuffer) (interface{}, error) {
+	typeDefinition, err := c.getTypeDefinition(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typeDefinition.Type {
+	case structTypeKind:
+		return c.decodeFields(typeDefinition.Fields, buffer)
+	case explicitEnumTypeKind:
+		name, errName := nextLengthPrefixedBytes(expression, buffer)
+		if errName != nil {
+			return nil, errName
+		}
+		return c.explicitEnumValue(expression, typeDefinition, string(name))
+	default:
+		discriminant, eof := buffer.NextByte()
+		if eof {
+			return nil, fmt.Errorf("%w while decoding the discriminant of %s", ErrNotEnoughData, expression.String())
+		}
+		return c.decodeEnumVariant(expression, typeDefinition, discriminant, buffer)
+	}
+}
+
+func (c *codec) decodeEnumVariant(
+	expression *typeExpression,
+	typeDefinition *TypeDefinition,
+	discriminant uint8,
+	buffer *serde.SourceBuffer,
+) (*EnumValue, error) {
+	variant := variantByDiscriminant(typeDefinition, discriminant)
+	if variant == nil {
+		return nil, fmt.Errorf("%w for type %s, discriminant: %d", ErrInvalidEnumVariant, expression.String(), discriminant)
+	}
+
+	fields, err := c.decodeFields(variant.Fields, buffer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnumValue{
+		Name:         variant.Name,
+		Discriminant: variant.Discriminant,
+		Fields:       fields,
+	}, nil
+}
+
+func (c *codec) explicitEnumValue(expression *typeExpression, typeDefinition *TypeDefinition, name string) (*EnumValue, error) {
+	variant := variantByName(typeDefinition, name)
+	if variant == nil {
+		return nil, fmt.Errorf("%w for type %s, provided: %s", ErrInvalidEnumVariant, expression.String(), name)
+	}
+
+	return &EnumValue{
+		Name:         variant.Name,
+		Discriminant: variant.Discriminant,
+		Fields:       make(map[string]interface{}),
+	}, nil
+}
@@ -0,0 +1,133 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+const (
+	// ConstructorName is the name used to address the ABI constructor when encoding arguments
+	ConstructorName = "init"
+	// UpgradeConstructorName is the name used to address the ABI upgrade constructor when encoding arguments
+	UpgradeConstructorName = "upgrade"
+
+	structTypeKind       = "struct"
+	enumTypeKind         = "enum"
+	explicitEnumTypeKind = "explicit-enum"
+)
+
+// Definition is the contract ABI, as generated by the smart contracts framework in the *.abi.json files
+type Definition struct {
+	Name               string                     `json:"name"`
+	Constructor        *Endpoint                  `json:"constructor,omitempty"`
+	UpgradeConstructor *Endpoint                  `json:"upgradeConstructor,omitempty"`
+	Endpoints          []*Endpoint                `json:"endpoints"`
+	Events             []*Event                   `json:"events,omitempty"`
+	Types              map[string]*TypeDefinition `json:"types,omitempty"`
+}
+
+// Endpoint is a contract endpoint, the constructor or the upgrade constructor
+type Endpoint struct {
+	Name            string       `json:"name"`
+	Mutability      string       `json:"mutability,omitempty"`
+	PayableInTokens []string     `json:"payableInTokens,omitempty"`
+	Inputs          []*Parameter `json:"inputs"`
+	Outputs         []*Parameter `json:"outputs"`
+}
+
+// Parameter is an endpoint input or output
+type Parameter struct {
+	Name     string `json:"name,omitempty"`
+	Type     string `json:"type"`
+	MultiArg bool   `json:"multi_arg,omitempty"`
+}
+
+// Event is a contract event. The indexed inputs are found in the event topics, after the event identifier
+type Event struct {
+	Identifier string        `json:"identifier"`
+	Inputs     []*EventInput `json:"inputs"`
+}
+
+// EventInput is a field of a contract event
+type EventInput struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Indexed bool   `json:"indexed,omitempty"`
+}
+
+// TypeDefinition is a custom type declared in the ABI: a struct, an enum or an explicit enum
+type TypeDefinition struct {
+	Type     string         `json:"type"`
+	Fields   []*Field       `json:"fields,omitempty"`
+	Variants []*EnumVariant `json:"variants,omitempty"`
+}
+
+// Field is a named field of a struct or of an enum variant
+type Field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// EnumVariant is a variant of an enum
+type EnumVariant struct {
+	Name         string   `json:"name"`
+	Discriminant uint8    `json:"discriminant"`
+	Fields       []*Field `json:"fields,omitempty"`
+}
+
+// NewDefinitionFromJSON parses the provided ABI JSON
+func NewDefinitionFromJSON(buff []byte) (*Definition, error) {
+	definition := &Definition{}
+	err := json.Unmarshal(buff, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition, nil
+}
+
+// LoadDefinitionFromFile loads the ABI JSON from the provided file
+func LoadDefinitionFromFile(filename string) (*Definition, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := NewDefinitionFromJSON(buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the ABI file %s", err, filename)
+	}
+
+	return definition, nil
+}
+
+// GetEndpoint returns the endpoint with the provided name. The ConstructorName and UpgradeConstructorName names
+// address the constructors, unless an endpoint with the same name is declared
+func (definition *Definition) GetEndpoint(name string) (*Endpoint, error) {
+	for _, endpoint := range definition.Endpoints {
+		if endpoint != nil && endpoint.Name == name {
+			return endpoint, nil
+		}
+	}
+
+	if name == ConstructorName && definition.Constructor != nil {
+		return definition.Constructor, nil
+	}
+	if name == UpgradeConstructorName && definition.UpgradeConstructor != nil {
+		return definition.UpgradeConstructor, nil
+	}
+
+	return nil, fmt.Errorf("%w, provided: %s", ErrEndpointNotFound, name)
+}
+
+// GetEvent returns the event with the provided identifier
+func (definition *Definition) GetEvent(identifier string) (*Event, error) {
+	for _, event := range definition.Events {
+		if event != nil && event.Identifier == identifier {
+			return event, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w, provided: %s", ErrEventNotFound, identifier)
+}
@@ -0,0 +1,381 @@
+package abi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+)
+
+func (c *codec) encodeNested(expression *typeExpression, value interface{}) ([]byte, error) {
+	size, isFixedSizeInteger := fixedSizeIntegerTypes[expression.name]
+	if isFixedSizeInteger {
+		return encodeFixedSizeInteger(expression, value, size)
+	}
+
+	switch {
+	case expression.name == bigUintType || expression.name == bigIntType:
+		encoded, err := encodeBigInteger(expression, value)
+		if err != nil {
+			return nil, err
+		}
+		return withLengthPrefix(encoded), nil
+	case expression.name == boolType:
+		isTrue, ok := dereference(value).(bool)
+		if !ok {
+			return nil, invalidValueError(expression, value)
+		}
+		if isTrue {
+			return []byte{1}, nil
+		}
+		return []byte{0}, nil
+	case bytesTypes[expression.name] || stringTypes[expression.name]:
+		buff, ok := toBytes(dereference(value))
+		if !ok {
+			return nil, invalidValueError(expression, value)
+		}
+		return withLengthPrefix(buff), nil
+	case expression.name == listType:
+		return c.encodeList(expression, value, true)
+	case expression.name == optionType:
+		return c.encodeOption(expression, value)
+	case expression.name == tupleType:
+		return c.encodeTuple(expression, value)
+	}
+
+	length, isFixedSizeBytes := fixedSizeBytesTypes[expression.name]
+	if isFixedSizeBytes {
+		return encodeFixedSizeBytes(expression, value, length)
+	}
+	length, isArray := expression.arrayLength()
+	if isArray && len(expression.generics) == 1 {
+		return c.encodeArray(expression, value, length)
+	}
+	if multiValueTypes[expression.name] {
+		return nil, fmt.Errorf("%w, multi-value type %s can not be nested", ErrInvalidTypeExpression, expression.String())
+	}
+
+	return c.encodeCustomType(expression, value, false)
+}
+
+func (c *codec) encodeTopLevel(expression *typeExpression, value interface{}) ([]byte, error) {
+	_, isFixedSizeInteger := fixedSizeIntegerTypes[expression.name]
+	switch {
+	case isFixedSizeInteger:
+		encoded, err := c.encodeNested(expression, value)
+		if err != nil {
+			return nil, err
+		}
+		return trimTopLevelInteger(encoded, signedIntegerTypes[expression.name]), nil
+	case expression.name == bigUintType || expression.name == bigIntType:
+		return encodeBigInteger(expression, value)
+	case expression.name == boolType:
+		isTrue, ok := dereference(value).(bool)
+		if !ok {
+			return nil, invalidValueError(expression, value)
+		}
+		if isTrue {
+			return []byte{1}, nil
+		}
+		return make([]byte, 0), nil
+	case bytesTypes[expression.name] || stringTypes[expression.name]:
+		buff, ok := toBytes(dereference(value))
+		if !ok {
+			return nil, invalidValueError(expression, value)
+		}
+		return buff, nil
+	case expression.name == listType:
+		return c.encodeList(expression, value, false)
+	case expression.name == optionType:
+		if isNilValue(value) {
+			return make([]byte, 0), nil
+		}
+		return c.encodeOption(expression, value)
+	}
+
+	typeDefinition, isCustomType := c.definition.Types[expression.name]
+	if isCustomType && typeDefinition != nil && typeDefinition.Type != structTypeKind {
+		return c.encodeCustomType(expression, value, true)
+	}
+
+	return c.encodeNested(expression, value)
+}
+
+func encodeFixedSizeInteger(expression *typeExpression, value interface{}, size int) ([]byte, error) {
+	number, ok := toBigInt(dereference(value))
+	if !ok {
+		return nil, invalidValueError(expression, value)
+	}
+
+	numBits := uint(size * 8)
+	upperLimit := big.NewInt(0).Lsh(big.NewInt(1), numBits)
+	if signedIntegerTypes[expression.name] {
+		halfLimit := big.NewInt(0).Rsh(upperLimit, 1)
+		if number.Cmp(halfLimit) >= 0 || number.Cmp(big.NewInt(0).Neg(halfLimit)) < 0 {
+			return nil, invalidValueError(expression, value)
+		}
+		if number.Sign() < 0 {
+			number.Add(number, upperLimit)
+		}
+	}
+	if number.Sign() < 0 || number.Cmp(upperLimit) >= 0 {
+		return nil, invalidValueError(expression, value)
+	}
+
+	return number.FillBytes(make([]byte, size)), nil
+}
+
+// encodeBigInteger returns the minimal big endian representation of the value, in two's complement for BigInt
+func encodeBigInteger(expression *typeExpression, value interface{}) ([]byte, error) {
+	number, ok := toBigInt(dereference(value))
+	if !ok {
+		return nil, invalidValueError(expression, value)
+	}
+	if expression.name == bigIntType {
+		return signedBigIntToBytes(number), nil
+	}
+	if number.Sign() < 0 {
+		return nil, invalidValueError(expression, value)
+	}
+
+	return number.Bytes(), nil
+}
+
+func signedBigIntToBytes(number *big.Int) []byte {
+	switch number.Sign() {
+	case 0:
+		return make([]byte, 0)
+	case 1:
+		buff := number.Bytes()
+		if buff[0]&0x80 != 0 {
+			buff = append([]byte{0}, buff...)
+		}
+		return buff
+	}
+
+	magnitudeMinusOne := big.NewInt(0).Neg(number)
+	magnitudeMinusOne.Sub(magnitudeMinusOne, big.NewInt(1))
+	size := len(magnitudeMinusOne.Bytes())
+	if size == 0 || magnitudeMinusOne.Bytes()[0]&0x80 != 0 {
+		size++
+	}
+
+	twosComplement := big.NewInt(0).Lsh(big.NewInt(1), uint(size*8))
+	twosComplement.Add(twosComplement, number)
+
+	return twosComplement.FillBytes(make([]byte, size))
+}
+
+// trimTopLevelInteger removes the redundant leading bytes of a fixed size integer: the zeros for the positive numbers
+// and the 0xff bytes for the negative ones, as long as the sign bit is kept
+func trimTopLevelInteger(buff []byte, isSigned bool) []byte {
+	if !isSigned || buff[0]&0x80 == 0 {
+		for len(buff) > 0 && buff[0] == 0 {
+			buff = buff[1:]
+		}
+		if isSigned && len(buff) > 0 && buff[0]&0x80 != 0 {
+			buff = append([]byte{0}, buff...)
+		}
+		return buff
+	}
+
+	for len(buff) > 1 && buff[0] == 0xff && buff[1]&0x80 != 0 {
+		buff = buff[1:]
+	}
+
+	return buff
+}
+
+func encodeFixedSizeBytes(expression *typeExpression, value interface{}, length int) ([]byte, error) {
+	var buff []byte
+	var ok bool
+	if expression.name == addressType {
+		buff, ok = toAddressBytes(dereference(value))
+	} else {
+		buff, ok = toBytes(dereference(value))
+	}
+	if !ok || len(buff) != length {
+		return nil, invalidValueError(expression, value)
+	}
+
+	return buff, nil
+}
+
+func withLengthPrefix(buff []byte) []byte {
+	result := make([]byte, lengthPrefixSize, lengthPrefixSize+len(buff))
+	binary.BigEndian.PutUint32(result, uint32(len(buff)))
+
+	return append(result, buff...)
+}
+
+func (c *codec) encodeList(expression *typeExpression, value interface{}, isNested bool) ([]byte, error) {
+	if len(expression.generics) != 1 {
+		return nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+	}
+
+	if isByteElement(expression) {
+		buff, ok := toBytes(dereference(value))
+		if ok {
+			if isNested {
+				return withLengthPrefix(buff), nil
+			}
+			return buff, nil
+		}
+	}
+
+	items, ok := toSlice(dereference(value))
+	if !ok {
+		return nil, invalidValueError(expression, value)
+	}
+
+	result := make([]byte, 0)
+	if isNested {
+		result = binary.BigEndian.AppendUint32(result, uint32(len(items)))
+	}
+	for _, item := range items {
+		encoded, err := c.encodeNested(expression.generics[0], item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, encoded...)
+	}
+
+	return result, nil
+}
+
+func (c *codec) encodeArray(expression *typeExpression, value interface{}, length int) ([]byte, error) {
+	if isByteElement(expression) {
+		buff, ok := toBytes(dereference(value))
+		if ok {
+			if len(buff) != length {
+				return nil, invalidValueError(expression, value)
+			}
+			return buff, nil
+		}
+	}
+
+	items, ok := toSlice(dereference(value))
+	if !ok || len(items) != length {
+		return nil, invalidValueError(expression, value)
+	}
+
+	return c.encodeNestedSequence(expression.generics, items, true)
+}
+
+func (c *codec) encodeOption(expression *typeExpression, value interface{}) ([]byte, error) {
+	if len(expression.generics) != 1 {
+		return nil, fmt.Errorf("%w, provided: %s", ErrInvalidTypeExpression, expression.String())
+	}
+	if isNilValue(value) {
+		return []byte{0}, nil
+	}
+
+	encoded, err := c.encodeNested(expression.generics[0], value)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{1}, encoded...), nil
+}
+
+func (c *codec) encodeTuple(expression *typeExpression, value interface{}) ([]byte, error) {
+	items, ok := toSlice(dereference(value))
+	if !ok || len(items) != len(expression.generics) {
+		return nil, invalidValueError(expression, value)
+	}
+
+	return c.encodeNestedSequence(expression.generics, items, false)
+}
+
+// encodeNestedSequence nested encodes the provided items. If sameType is set, all the items have the first type
+func (c *codec) encodeNestedSequence(expressions []*typeExpression, items []interface{}, sameType bool) ([]byte, error) {
+	result := make([]byte, 0)
+	for idx, item := range items {
+		expression := expressions[0]
+		if !sameType {
+			expression = expressions[idx]
+		}
+
+		encoded, err := c.encodeNested(expression, item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, encoded...)
+	}
+
+	return result, nil
+}
+
+func (c *codec) encodeFields(expression *typeExpression, value interface{}, fields []*Field) ([]byte, error) {
+	values, err := fieldValues(expression, value, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]byte, 0)
+	for idx, field := range fields {
+		fieldExpression, errParse := parseTypeExpression(field.Type)
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		encoded, errEncode := c.encodeNested(fieldExpression, values[idx])
+		if errEncode != nil {
+			return nil, fmt.Errorf("%w for field %s", errEncode, field.Name)
+		}
+		result = append(result, encoded...)
+	}
+
+	return result, nil
+}
+
+func (c *codec) encodeCustomType(expression *typeExpression, value interface{}, isTopLevel bool) ([]byte, error) {
+	typeDefinition, err := c.getTypeDefinition(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typeDefinition.Type {
+	case structTypeKind:
+		return c.encodeFields(expression, value, typeDefinition.Fields)
+	case explicitEnumTypeKind:
+		variant, _, errVariant := enumVariant(expression, typeDefinition, value)
+		if errVariant != nil {
+			return nil, errVariant
+		}
+		if isTopLevel {
+			return []byte(variant.Name), nil
+		}
+		return withLengthPrefix([]byte(variant.Name)), nil
+	default:
+		variant, fields, errVariant := enumVariant(expression, typeDefinition, value)
+		if errVariant != nil {
+			return nil, errVariant
+		}
+		if len(variant.Fields) == 0 {
+			if isTopLevel && variant.Discriminant == 0 {
+				return make([]byte, 0), nil
+			}
+			return []byte{variant.Discriminant}, nil
+		}
+
+		encoded, errEncode := c.encodeFields(expression, fields, variant.Fields)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+		return append([]byte{variant.Discriminant}, encoded...), nil
+	}
+}
+
+func (c *codec) getTypeDefinition(expression *typeExpression) (*TypeDefinition, error) {
+	typeDefinition, found := c.definition.Types[expression.name]
+	if !found || typeDefinition == nil {
+		return nil, fmt.Errorf("%w, provided: %s", ErrUnknownType, expression.String())
+	}
+
+	switch typeDefinition.Type {
+	case structTypeKind, enumTypeKind, explicitEnumTypeKind:
+		return typeDefinition, nil
+	default:
+		return nil, fmt.Errorf("%w, %s has the kind %s", ErrInvalidTypeDefinition, expression.name, typeDefinition.Type)
+	}
+}
@@ -0,0 +1,45 @@
+package abi
+
+import "errors"
+
+// ErrNilDefinition signals that a nil ABI definition was provided
+var ErrNilDefinition = errors.New("nil ABI definition")
+
+// ErrEndpointNotFound signals that the endpoint is not declared in the ABI
+var ErrEndpointNotFound = errors.New("endpoint not found in ABI")
+
+// ErrEventNotFound signals that the event is not declared in the ABI
+var ErrEventNotFound = errors.New("event not found in ABI")
+
+// ErrNilEvent signals that a nil event was provided
+var ErrNilEvent = errors.New("nil event")
+
+// ErrInvalidTypeExpression signals that a type expression could not be parsed
+var ErrInvalidTypeExpression = errors.New("invalid type expression")
+
+// ErrUnknownType signals that a type is neither a known primitive nor a custom type declared in the ABI
+var ErrUnknownType = errors.New("unknown type")
+
+// ErrInvalidTypeDefinition signals that a custom type declared in the ABI is not valid
+var ErrInvalidTypeDefinition = errors.New("invalid type definition")
+
+// ErrInvalidValue signals that the provided Go value can not be encoded as the required type
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrWrongNumberOfArguments signals that the number of provided values does not match the declared inputs
+var ErrWrongNumberOfArguments = errors.New("wrong number of arguments")
+
+// ErrNotEnoughData signals that the encoded data ended before the value was completely decoded
+var ErrNotEnoughData = errors.New("not enough data")
+
+// ErrTrailingData signals that the encoded data contains bytes left after the value was decoded
+var ErrTrailingData = errors.New("trailing data after the decoded value")
+
+// ErrInvalidEnumVariant signals that an enum variant is not declared in the ABI
+var ErrInvalidEnumVariant = errors.New("invalid enum variant")
+
+// ErrNilTarget signals that a nil target was provided for a decoded value
+var ErrNilTarget = errors.New("nil target")
+
+// ErrCannotAssign signals that a decoded value can not be assigned to the provided target
+var ErrCannotAssign = errors.New("cannot assign decoded value")
@@ -0,0 +1,94 @@
+package abi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const arrayTypePrefix = "array"
+
+// typeExpression is a parsed ABI type, such as List<tuple<u8,BigUint>>
+type typeExpression struct {
+	name     string
+	generics []*typeExpression
+}
+
+// parseTypeExpression parses the type names used in the ABI, with the generic arguments between angle brackets
+func parseTypeExpression(expression string) (*typeExpression, error) {
+	parsed, remaining, err := parseTypeExpressionPrefix(strings.TrimSpace(expression))
+	if err != nil {
+		return nil, fmt.Errorf("%w, provided: %s", err, expression)
+	}
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("%w, unexpected %s in %s", ErrInvalidTypeExpression, remaining, expression)
+	}
+
+	return parsed, nil
+}
+
+func parseTypeExpressionPrefix(expression string) (*typeExpression, string, error) {
+	nameEnd := strings.IndexAny(expression, "<>,")
+	if nameEnd < 0 {
+		nameEnd = len(expression)
+	}
+
+	result := &typeExpression{
+		name: strings.TrimSpace(expression[:nameEnd]),
+	}
+	if len(result.name) == 0 {
+		return nil, "", ErrInvalidTypeExpression
+	}
+
+	remaining := expression[nameEnd:]
+	if !strings.HasPrefix(remaining, "<") {
+		return result, remaining, nil
+	}
+
+	remaining = remaining[1:]
+	for {
+		generic, rest, err := parseTypeExpressionPrefix(strings.TrimSpace(remaining))
+		if err != nil {
+			return nil, "", err
+		}
+		result.generics = append(result.generics, generic)
+
+		rest = strings.TrimSpace(rest)
+		switch {
+		case strings.HasPrefix(rest, ","):
+			remaining = rest[1:]
+		case strings.HasPrefix(rest, ">"):
+			return result, rest[1:], nil
+		default:
+			return nil, "", ErrInvalidTypeExpression
+		}
+	}
+}
+
+// arrayLength returns the length of the fixed size array types, written as array<N><T>, such as array32<u8>
+func (expression *typeExpression) arrayLength() (int, bool) {
+	if !strings.HasPrefix(expression.name, arrayTypePrefix) {
+		return 0, false
+	}
+
+	length, err := strconv.Atoi(expression.name[len(arrayTypePrefix):])
+	if err != nil || length < 0 {
+		return 0, false
+	}
+
+	return length, true
+}
+
+// String returns the type expression in the ABI format
+func (expression *typeExpression) String() string {
+	if len(expression.generics) == 0 {
+		return expression.name
+	}
+
+	generics := make([]string, 0, len(expression.generics))
+	for _, generic := range expression.generics {
+		generics = append(generics, generic.String())
+	}
+
+	return expression.name + "<" + strings.Join(generics, ",") + ">"
+}
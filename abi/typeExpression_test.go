@@ -0,0 +1,80 @@
+package abi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTypeExpression(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid expressions should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidExpressions := []string{"", "List<", "List<u8", "List<u8>>", "List<>", "tuple<u8,>", "u8,u16", "<u8>"}
+		for _, expression := range invalidExpressions {
+			parsed, err := parseTypeExpression(expression)
+			assert.Nil(t, parsed, expression)
+			assert.True(t, errors.Is(err, ErrInvalidTypeExpression), expression)
+		}
+	})
+	t.Run("simple type should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parseTypeExpression("utf-8 string")
+		require.Nil(t, err)
+		assert.Equal(t, &typeExpression{name: "utf-8 string"}, parsed)
+	})
+	t.Run("generic types should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parseTypeExpression("variadic<multi<List<tuple<u8, BigUint>>,Option<Address>>>")
+		require.Nil(t, err)
+
+		expected := &typeExpression{
+			name: "variadic",
+			generics: []*typeExpression{
+				{
+					name: "multi",
+					generics: []*typeExpression{
+						{
+							name: "List",
+							generics: []*typeExpression{
+								{
+									name:     "tuple",
+									generics: []*typeExpression{{name: "u8"}, {name: "BigUint"}},
+								},
+							},
+						},
+						{
+							name:     "Option",
+							generics: []*typeExpression{{name: "Address"}},
+						},
+					},
+				},
+			},
+		}
+		assert.Equal(t, expected, parsed)
+		assert.Equal(t, "variadic<multi<List<tuple<u8,BigUint>>,Option<Address>>>", parsed.String())
+	})
+}
+
+func TestTypeExpression_ArrayLength(t *testing.T) {
+	t.Parallel()
+
+	parsed, _ := parseTypeExpression("array32<u8>")
+	length, isArray := parsed.arrayLength()
+	assert.True(t, isArray)
+	assert.Equal(t, 32, length)
+
+	parsed, _ = parseTypeExpression("List<u8>")
+	_, isArray = parsed.arrayLength()
+	assert.False(t, isArray)
+
+	parsed, _ = parseTypeExpression("arrayX<u8>")
+	_, isArray = parsed.arrayLength()
+	assert.False(t, isArray)
+}
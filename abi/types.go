@@ -0,0 +1,111 @@
+package abi
+
+const (
+	u8Type      = "u8"
+	u16Type     = "u16"
+	u32Type     = "u32"
+	u64Type     = "u64"
+	usizeType   = "usize"
+	i8Type      = "i8"
+	i16Type     = "i16"
+	i32Type     = "i32"
+	i64Type     = "i64"
+	isizeType   = "isize"
+	bigUintType = "BigUint"
+	bigIntType  = "BigInt"
+	boolType    = "bool"
+
+	bytesType         = "bytes"
+	managedBufferType = "ManagedBuffer"
+	boxedBytesType    = "BoxedBytes"
+	stringType        = "utf-8 string"
+	tokenIDType       = "TokenIdentifier"
+	dcdtTokenIDType   = "DcdtTokenIdentifier"
+	rewaOrDcdtIDType  = "RewaOrDcdtTokenIdentifier"
+
+	addressType      = "Address"
+	h256Type         = "H256"
+	codeMetadataType = "CodeMetadata"
+
+	listType   = "List"
+	optionType = "Option"
+	tupleType  = "tuple"
+
+	variadicType        = "variadic"
+	optionalType        = "optional"
+	multiType           = "multi"
+	countedVariadicType = "counted-variadic"
+
+	addressLength      = 32
+	h256Length         = 32
+	codeMetadataLength = 2
+	lengthPrefixSize   = 4
+)
+
+var fixedSizeIntegerTypes = map[string]int{
+	u8Type:    1,
+	u16Type:   2,
+	u32Type:   4,
+	u64Type:   8,
+	usizeType: 4,
+	i8Type:    1,
+	i16Type:   2,
+	i32Type:   4,
+	i64Type:   8,
+	isizeType: 4,
+}
+
+var signedIntegerTypes = map[string]bool{
+	i8Type:     true,
+	i16Type:    true,
+	i32Type:    true,
+	i64Type:    true,
+	isizeType:  true,
+	bigIntType: true,
+}
+
+var bytesTypes = map[string]bool{
+	bytesType:         true,
+	managedBufferType: true,
+	boxedBytesType:    true,
+}
+
+var stringTypes = map[string]bool{
+	stringType:       true,
+	tokenIDType:      true,
+	dcdtTokenIDType:  true,
+	rewaOrDcdtIDType: true,
+}
+
+var fixedSizeBytesTypes = map[string]int{
+	addressType:      addressLength,
+	h256Type:         h256Length,
+	codeMetadataType: codeMetadataLength,
+}
+
+var multiValueTypes = map[string]bool{
+	variadicType:        true,
+	optionalType:        true,
+	multiType:           true,
+	countedVariadicType: true,
+}
+
+// EnumValue is the Go representation of an ABI enum value. The fields of the tuple-like variants are named by their
+// position: "0", "1" and so on
+type EnumValue struct {
+	Name         string
+	Discriminant uint8
+	Fields       map[string]interface{}
+}
+
+func isPrimitiveType(name string) bool {
+	_, isFixedSizeInteger := fixedSizeIntegerTypes[name]
+	_, isFixedSizeBytes := fixedSizeBytesTypes[name]
+
+	return isFixedSizeInteger || isFixedSizeBytes || bytesTypes[name] || stringTypes[name] ||
+		name == bigUintType || name == bigIntType || name == boolType
+}
+
+func isByteElement(expression *typeExpression) bool {
+	return len(expression.generics) == 1 && expression.generics[0].name == u8Type
+}
@@ -0,0 +1,240 @@
+package abi
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const abiFieldTag = "abi"
+
+var bigIntReflectType = reflect.TypeOf(big.Int{})
+
+func invalidValueError(expression *typeExpression, value interface{}) error {
+	return fmt.Errorf("%w for type %s, provided: %v (%T)", ErrInvalidValue, expression.String(), value, value)
+}
+
+// toBigInt converts any Go integer, *big.Int, big.Int or decimal string to a *big.Int
+func toBigInt(value interface{}) (*big.Int, bool) {
+	switch typedValue := value.(type) {
+	case *big.Int:
+		if typedValue == nil {
+			return nil, false
+		}
+		return big.NewInt(0).Set(typedValue), true
+	case big.Int:
+		return big.NewInt(0).Set(&typedValue), true
+	case string:
+		return big.NewInt(0).SetString(typedValue, 10)
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	switch reflectedValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(reflectedValue.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return big.NewInt(0).SetUint64(reflectedValue.Uint()), true
+	default:
+		return nil, false
+	}
+}
+
+// toBytes converts a byte slice, a byte array or a string to a byte slice
+func toBytes(value interface{}) ([]byte, bool) {
+	switch typedValue := value.(type) {
+	case []byte:
+		return typedValue, true
+	case string:
+		return []byte(typedValue), true
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	if reflectedValue.Kind() == reflect.Array && reflectedValue.Type().Elem().Kind() == reflect.Uint8 {
+		result := make([]byte, reflectedValue.Len())
+		reflect.Copy(reflect.ValueOf(result), reflectedValue)
+		return result, true
+	}
+
+	return nil, false
+}
+
+// toAddressBytes converts an address handler, a bech32 string or the address bytes to the address bytes
+func toAddressBytes(value interface{}) ([]byte, bool) {
+	switch typedValue := value.(type) {
+	case core.AddressHandler:
+		if check.IfNil(typedValue) {
+			return nil, false
+		}
+		return typedValue.AddressBytes(), true
+	case string:
+		address, err := data.NewAddressFromBech32String(typedValue)
+		if err != nil {
+			return nil, false
+		}
+		return address.AddressBytes(), true
+	}
+
+	return toBytes(value)
+}
+
+// toSlice converts any Go slice or array to a slice of interfaces
+func toSlice(value interface{}) ([]interface{}, bool) {
+	if typedValue, ok := value.([]interface{}); ok {
+		return typedValue, true
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	if reflectedValue.Kind() != reflect.Slice && reflectedValue.Kind() != reflect.Array {
+		return nil, false
+	}
+
+	result := make([]interface{}, 0, reflectedValue.Len())
+	for idx := 0; idx < reflectedValue.Len(); idx++ {
+		result = append(result, reflectedValue.Index(idx).Interface())
+	}
+
+	return result, true
+}
+
+func isNilValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	switch reflectedValue.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return reflectedValue.IsNil()
+	default:
+		return false
+	}
+}
+
+// dereference returns the value pointed by the provided pointer, leaving untouched the *big.Int values and the
+// address handlers, which are handled as they are
+func dereference(value interface{}) interface{} {
+	switch value.(type) {
+	case *big.Int, core.AddressHandler, *EnumValue:
+		return value
+	}
+
+	reflectedValue := reflect.ValueOf(value)
+	for reflectedValue.Kind() == reflect.Ptr && !reflectedValue.IsNil() {
+		reflectedValue = reflectedValue.Elem()
+	}
+	if !reflectedValue.IsValid() {
+		return nil
+	}
+
+	return reflectedValue.Interface()
+}
+
+// normalizeFieldName is used to match the ABI field names, usually written in snake case, with the Go struct fields
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// structFieldByName returns the struct field tagged with `abi:"<name>"` or, if there is no such field, the exported
+// field with the same name, compared case insensitive and ignoring the underscores
+func structFieldByName(structValue reflect.Value, name string) (reflect.Value, bool) {
+	structType := structValue.Type()
+	for idx := 0; idx < structType.NumField(); idx++ {
+		if structType.Field(idx).Tag.Get(abiFieldTag) == name {
+			return structValue.Field(idx), true
+		}
+	}
+
+	normalizedName := normalizeFieldName(name)
+	for idx := 0; idx < structType.NumField(); idx++ {
+		field := structType.Field(idx)
+		if field.IsExported() && normalizeFieldName(field.Name) == normalizedName {
+			return structValue.Field(idx), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// fieldValues returns the values of the provided fields, read either from a map[string]interface{} or from a Go struct
+func fieldValues(expression *typeExpression, value interface{}, fields []*Field) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(fields))
+	if mapValue, ok := value.(map[string]interface{}); ok {
+		for _, field := range fields {
+			fieldValue, found := mapValue[field.Name]
+			if !found {
+				return nil, fmt.Errorf("%w for type %s, missing field %s", ErrInvalidValue, expression.String(), field.Name)
+			}
+			result = append(result, fieldValue)
+		}
+
+		return result, nil
+	}
+
+	structValue := reflect.ValueOf(dereference(value))
+	if structValue.Kind() != reflect.Struct {
+		return nil, invalidValueError(expression, value)
+	}
+	for _, field := range fields {
+		fieldValue, found := structFieldByName(structValue, field.Name)
+		if !found {
+			return nil, fmt.Errorf("%w for type %s, missing field %s", ErrInvalidValue, expression.String(), field.Name)
+		}
+		result = append(result, fieldValue.Interface())
+	}
+
+	return result, nil
+}
+
+// enumVariant returns the variant selected by the provided value, which can be an EnumValue, the variant name or the
+// variant discriminant
+func enumVariant(expression *typeExpression, definition *TypeDefinition, value interface{}) (*EnumVariant, map[string]interface{}, error) {
+	var variant *EnumVariant
+	var fields map[string]interface{}
+	switch typedValue := dereference(value).(type) {
+	case *EnumValue:
+		variant = variantByName(definition, typedValue.Name)
+		fields = typedValue.Fields
+	case EnumValue:
+		variant = variantByName(definition, typedValue.Name)
+		fields = typedValue.Fields
+	case string:
+		variant = variantByName(definition, typedValue)
+	default:
+		discriminant, ok := toBigInt(value)
+		if !ok || !discriminant.IsUint64() || discriminant.Uint64() > 255 {
+			return nil, nil, invalidValueError(expression, value)
+		}
+		variant = variantByDiscriminant(definition, uint8(discriminant.Uint64()))
+	}
+
+	if variant == nil {
+		return nil, nil, fmt.Errorf("%w for type %s, provided: %v", ErrInvalidEnumVariant, expression.String(), value)
+	}
+
+	return variant, fields, nil
+}
+
+func variantByName(definition *TypeDefinition, name string) *EnumVariant {
+	for _, variant := range definition.Variants {
+		if variant.Name == name {
+			return variant
+		}
+	}
+
+	return nil
+}
+
+func variantByDiscriminant(definition *TypeDefinition, discriminant uint8) *EnumVariant {
+	for _, variant := range definition.Variants {
+		if variant.Discriminant == discriminant {
+			return variant
+		}
+	}
+
+	return nil
+}
@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNilHttpServer signals that a nil http server has been provided
 var ErrNilHttpServer = errors.New("nil http server")
+
+// ErrNilMetricsExporter signals that a nil metrics exporter has been provided
+var ErrNilMetricsExporter = errors.New("nil metrics exporter")
@@ -1,8 +1,17 @@
 package gin
 
-import "context"
+import (
+	"context"
+	"net/http"
+)
 
 type server interface {
 	ListenAndServe() error
 	Shutdown(ctx context.Context) error
 }
+
+// MetricsExporter is able to write the collected metrics on an http response
+type MetricsExporter interface {
+	ServeHTTP(writer http.ResponseWriter, request *http.Request)
+	IsInterfaceNil() bool
+}
@@ -5,10 +5,12 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-chain-core/marshal"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 	"github.com/TerraDharitri/drt-go-chain/api/logs"
 	drtChainShared "github.com/TerraDharitri/drt-go-chain/api/shared"
+	apiErrors "github.com/TerraDharitri/drt-go-sdk/aggregator/api/errors"
 	"github.com/TerraDharitri/drt-go-sdk/core"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -17,11 +19,14 @@ import (
 
 var log = logger.GetOrCreate("api")
 
+const metricsRoute = "/metrics"
+
 type webServer struct {
 	sync.RWMutex
-	httpServer   drtChainShared.HttpServerCloser
-	apiInterface string
-	cancelFunc   func()
+	httpServer      drtChainShared.HttpServerCloser
+	apiInterface    string
+	cancelFunc      func()
+	metricsExporter MetricsExporter
 }
 
 // NewWebServerHandler returns a new instance of webServer
@@ -33,6 +38,19 @@ func NewWebServerHandler(apiInterface string) (*webServer, error) {
 	return gws, nil
 }
 
+// SetMetricsExporter sets the exporter served on the /metrics route. It should be called before StartHttpServer
+func (ws *webServer) SetMetricsExporter(exporter MetricsExporter) error {
+	if check.IfNil(exporter) {
+		return apiErrors.ErrNilMetricsExporter
+	}
+
+	ws.Lock()
+	ws.metricsExporter = exporter
+	ws.Unlock()
+
+	return nil
+}
+
 // StartHttpServer will create a new instance of http.Server and populate it with all the routes
 func (ws *webServer) StartHttpServer() error {
 	ws.Lock()
@@ -72,6 +90,10 @@ func (ws *webServer) StartHttpServer() error {
 func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 	marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 	registerLoggerWsRoute(ginRouter, marshalizerForLogs)
+
+	if !check.IfNil(ws.metricsExporter) {
+		ginRouter.GET(metricsRoute, gin.WrapH(ws.metricsExporter))
+	}
 }
 
 // registerLoggerWsRoute will register the log route
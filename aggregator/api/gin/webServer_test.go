@@ -2,10 +2,14 @@ package gin
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/aggregator/api/errors"
+	"github.com/TerraDharitri/drt-go-sdk/core/metrics"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -68,3 +72,34 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestWebServer_SetMetricsExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil exporter should error", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := NewWebServerHandler("127.0.0.1:8080")
+		err := ws.SetMetricsExporter(nil)
+		assert.Equal(t, errors.ErrNilMetricsExporter, err)
+	})
+	t.Run("should serve the metrics route", func(t *testing.T) {
+		t.Parallel()
+
+		exporter := metrics.NewPrometheusMetricsHandler()
+		exporter.SetCurrentNonce("drt1a", 7)
+
+		ws, _ := NewWebServerHandler("127.0.0.1:8080")
+		err := ws.SetMetricsExporter(exporter)
+		assert.Nil(t, err)
+
+		engine := gin.New()
+		ws.registerRoutes(engine)
+
+		recorder := httptest.NewRecorder()
+		engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, metricsRoute, nil))
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, metrics.PrometheusContentType, recorder.Header().Get("Content-Type"))
+		assert.Contains(t, recorder.Body.String(), `drt_sdk_nonce_handler_current_nonce{address="drt1a"} 7`)
+	})
+}
@@ -3,6 +3,7 @@ package notifees
 import (
 	"context"
 
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 	"github.com/TerraDharitri/drt-go-sdk/core"
 	"github.com/TerraDharitri/drt-go-sdk/data"
@@ -18,7 +19,7 @@ type TxBuilder interface {
 // dependency inversion: blockchain package is considered inner business logic, this package is considered "plugin"
 type Proxy interface {
 	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
-	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	GetAccount(ctx context.Context, address core.AddressHandler, queryOptions api.AccountQueryOptions) (*data.Account, error)
 	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
 	SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
 	IsInterfaceNil() bool
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/TerraDharitri/drt-go-sdk/builders"
 	"github.com/TerraDharitri/drt-go-sdk/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -73,3 +74,32 @@ func TestAddressGenerator_ComputeWasmVMScAddress(t *testing.T) {
 
 	assert.Equal(t, "drt1qqqqqqqqqqqqqpgqxcy5fma93yhw44xcmt3zwrl0tlhaqmxrdwps7km5yl", scAddressAsBech32)
 }
+
+func TestAddressGenerator_PredictsDeployedContractAddress(t *testing.T) {
+	t.Parallel()
+
+	coord, err := NewShardCoordinator(3, 0)
+	require.Nil(t, err)
+
+	ag, err := NewAddressGenerator(coord)
+	require.Nil(t, err)
+
+	deployBuilder, err := builders.NewContractDeployBuilder(ag)
+	require.Nil(t, err)
+
+	_, scAddress, err := deployBuilder.
+		SetCode([]byte{0, 'a', 's', 'm', 1, 0, 0, 0}).
+		SetSenderAccount(&data.Account{
+			Address: "drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw",
+			Nonce:   10,
+		}).
+		SetNetworkConfig(&data.NetworkConfig{ChainID: "T", MinTransactionVersion: 1}).
+		SetGasLimit(60000000).
+		Build()
+	require.Nil(t, err)
+
+	scAddressAsBech32, err := scAddress.AddressAsBech32String()
+	require.Nil(t, err)
+
+	assert.Equal(t, "drt1qqqqqqqqqqqqqpgqxcy5fma93yhw44xcmt3zwrl0tlhaqmxrdwps7km5yl", scAddressAsBech32)
+}
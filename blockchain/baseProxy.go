@@ -173,6 +173,15 @@ func (proxy *baseProxy) GetNetworkStatus(ctx context.Context, shardID uint32) (*
 	return &data.NetworkStatus{}, ErrInvalidEndpointProvider
 }
 
+func (proxy *baseProxy) getNetworkStatusNonce(ctx context.Context, shardID uint32) (uint64, error) {
+	networkStatus, err := proxy.GetNetworkStatus(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+
+	return networkStatus.Nonce, nil
+}
+
 func (proxy *baseProxy) getNetworkStatus(buff []byte, shardID uint32) (*data.NetworkStatus, error) {
 	response := &data.NetworkStatusResponse{}
 	err := json.Unmarshal(buff, response)
@@ -0,0 +1,22 @@
+package blockchain
+
+import sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+
+// DisabledLogsCursorStorer is a no-op implementation of the LogsCursorStorer interface
+type DisabledLogsCursorStorer struct {
+}
+
+// Load returns nil, nil
+func (storer *DisabledLogsCursorStorer) Load() (*sdkCore.LogsCursor, error) {
+	return nil, nil
+}
+
+// Save does nothing
+func (storer *DisabledLogsCursorStorer) Save(_ sdkCore.LogsCursor) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *DisabledLogsCursorStorer) IsInterfaceNil() bool {
+	return storer == nil
+}
@@ -9,6 +9,7 @@ const (
 	enableEpochsConfig         = "network/enable-epochs"
 	account                    = "address/%s"
 	costTransaction            = "transaction/cost"
+	simulateTransaction        = "transaction/simulate"
 	sendTransaction            = "transaction/send"
 	sendMultipleTransactions   = "transaction/send-multiple"
 	transactionStatus          = "transaction/%s/status"
@@ -24,6 +25,13 @@ const (
 	nft                        = "address/%s/nft/%s/nonce/%d"
 	nodeGetGuardianData        = "address/%s/guardian-data"
 	isDataTrieMigrated         = "address/%s/is-data-trie-migrated"
+	accountStorageValue        = "address/%s/key/%s"
+	accountStorageKeys         = "address/%s/keys"
+	allDCDTTokens              = "address/%s/dcdt"
+	dcdtRoles                  = "address/%s/dcdts/roles"
+	transactionsPoolForSender  = "transaction/pool?by-sender=%s&fields=hash,nonce,sender,receiver,gaslimit,gasprice,data,value"
+	lastPoolNonceForSender     = "transaction/pool?by-sender=%s&last-nonce=true"
+	poolNonceGapsForSender     = "transaction/pool?by-sender=%s&nonce-gaps=true"
 )
 
 type baseEndpointProvider struct{}
@@ -68,6 +76,11 @@ func (base *baseEndpointProvider) GetCostTransaction() string {
 	return costTransaction
 }
 
+// GetSimulateTransaction returns the transaction simulation endpoint
+func (base *baseEndpointProvider) GetSimulateTransaction() string {
+	return simulateTransaction
+}
+
 // GetSendTransaction returns the send transaction endpoint
 func (base *baseEndpointProvider) GetSendTransaction() string {
 	return sendTransaction
@@ -132,3 +145,38 @@ func (base *baseEndpointProvider) GetValidatorsInfo(epoch uint32) string {
 func (base *baseEndpointProvider) IsDataTrieMigrated(addressAsBech32 string) string {
 	return fmt.Sprintf(isDataTrieMigrated, addressAsBech32)
 }
+
+// GetAccountStorageValue returns the account storage value endpoint
+func (base *baseEndpointProvider) GetAccountStorageValue(addressAsBech32 string, hexKey string) string {
+	return fmt.Sprintf(accountStorageValue, addressAsBech32, hexKey)
+}
+
+// GetAccountStorageKeys returns the account storage key-value pairs endpoint
+func (base *baseEndpointProvider) GetAccountStorageKeys(addressAsBech32 string) string {
+	return fmt.Sprintf(accountStorageKeys, addressAsBech32)
+}
+
+// GetAllDCDTTokens returns the endpoint of all the tokens held by an address
+func (base *baseEndpointProvider) GetAllDCDTTokens(addressAsBech32 string) string {
+	return fmt.Sprintf(allDCDTTokens, addressAsBech32)
+}
+
+// GetDCDTRoles returns the endpoint of all the special roles held by an address
+func (base *baseEndpointProvider) GetDCDTRoles(addressAsBech32 string) string {
+	return fmt.Sprintf(dcdtRoles, addressAsBech32)
+}
+
+// GetTransactionsPoolForSender returns the endpoint of the transactions from pool of a sender
+func (base *baseEndpointProvider) GetTransactionsPoolForSender(addressAsBech32 string) string {
+	return fmt.Sprintf(transactionsPoolForSender, addressAsBech32)
+}
+
+// GetLastPoolNonceForSender returns the endpoint of the last nonce from pool of a sender
+func (base *baseEndpointProvider) GetLastPoolNonceForSender(addressAsBech32 string) string {
+	return fmt.Sprintf(lastPoolNonceForSender, addressAsBech32)
+}
+
+// GetTransactionsPoolNonceGapsForSender returns the endpoint of the nonce gaps from pool of a sender
+func (base *baseEndpointProvider) GetTransactionsPoolNonceGapsForSender(addressAsBech32 string) string {
+	return fmt.Sprintf(poolNonceGapsForSender, addressAsBech32)
+}
@@ -16,6 +16,7 @@ func TestBaseEndpointProvider(t *testing.T) {
 	assert.Equal(t, enableEpochsConfig, base.GetEnableEpochsConfig())
 	assert.Equal(t, "address/addressAsBech32", base.GetAccount("addressAsBech32"))
 	assert.Equal(t, costTransaction, base.GetCostTransaction())
+	assert.Equal(t, simulateTransaction, base.GetSimulateTransaction())
 	assert.Equal(t, sendTransaction, base.GetSendTransaction())
 	assert.Equal(t, sendMultipleTransactions, base.GetSendMultipleTransactions())
 	assert.Equal(t, "transaction/hex/status", base.GetTransactionStatus("hex"))
@@ -28,4 +29,12 @@ func TestBaseEndpointProvider(t *testing.T) {
 	assert.Equal(t, "address/drt1address/dcdt/TKN-001122", base.GetDCDTTokenData("drt1address", "TKN-001122"))
 	assert.Equal(t, "address/drt1address/nft/TKN-001122/nonce/37", base.GetNFTTokenData("drt1address", "TKN-001122", 37))
 	assert.Equal(t, "address/dummyAddress/guardian-data", base.GetGuardianData("dummyAddress"))
+	assert.Equal(t, "address/drt1address/key/6b6579", base.GetAccountStorageValue("drt1address", "6b6579"))
+	assert.Equal(t, "address/drt1address/keys", base.GetAccountStorageKeys("drt1address"))
+	assert.Equal(t, "address/drt1address/dcdt", base.GetAllDCDTTokens("drt1address"))
+	assert.Equal(t, "address/drt1address/dcdts/roles", base.GetDCDTRoles("drt1address"))
+	assert.Equal(t, "transaction/pool?by-sender=drt1address&fields=hash,nonce,sender,receiver,gaslimit,gasprice,data,value",
+		base.GetTransactionsPoolForSender("drt1address"))
+	assert.Equal(t, "transaction/pool?by-sender=drt1address&last-nonce=true", base.GetLastPoolNonceForSender("drt1address"))
+	assert.Equal(t, "transaction/pool?by-sender=drt1address&nonce-gaps=true", base.GetTransactionsPoolNonceGapsForSender("drt1address"))
 }
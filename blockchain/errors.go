@@ -54,6 +54,9 @@ var ErrNilRequest = errors.New("nil request")
 // ErrNilProxy signals that a nil proxy has been provided
 var ErrNilProxy = errors.New("nil proxy")
 
+// ErrNilOutputsDecoder signals that a nil outputs decoder was provided
+var ErrNilOutputsDecoder = errors.New("nil outputs decoder")
+
 // ErrNotUint64Bytes signals that the provided bytes do not represent a valid uint64 number
 var ErrNotUint64Bytes = errors.New("provided bytes do not represent a valid uint64 number")
 
@@ -63,6 +66,39 @@ var ErrInvalidBlockRange = errors.New("invalid block range: too many blocks to p
 // ErrNoBlockRangeProvided signals that no block range was provided
 var ErrNoBlockRangeProvided = errors.New("no block range specified")
 
+// ErrNoProxyURLsProvided signals that no proxy URLs were provided
+var ErrNoProxyURLsProvided = errors.New("no proxy URLs provided")
+
+// ErrAllEndpointsUnhealthy signals that none of the provided endpoints could be reached
+var ErrAllEndpointsUnhealthy = errors.New("all endpoints are unhealthy")
+
+// ErrInvalidHealthCheckInterval signals that an invalid health check interval was provided
+var ErrInvalidHealthCheckInterval = errors.New("invalid health check interval")
+
+// ErrBlockHashWithMultipleShards signals that a block hash was provided in a filter that spans multiple shards
+var ErrBlockHashWithMultipleShards = errors.New("block hash can not be used when filtering logs from multiple shards")
+
+// ErrUnsupportedSubscriptionFilter signals that the provided filter can not be used in a logs subscription
+var ErrUnsupportedSubscriptionFilter = errors.New("unsupported subscription filter")
+
+// ErrSubscriptionClosed signals that the logs subscription is closed
+var ErrSubscriptionClosed = errors.New("logs subscription closed")
+
+// ErrEmptyStorageKey signals that an empty storage key was provided
+var ErrEmptyStorageKey = errors.New("empty storage key")
+
+// ErrNilBlock signals that the network returned a response without a block
+var ErrNilBlock = errors.New("nil block")
+
+// ErrEmptyBlockHash signals that an empty block hash was provided
+var ErrEmptyBlockHash = errors.New("empty block hash")
+
+// ErrInvalidResponseCacheTTL signals that an invalid response cache time to live was provided
+var ErrInvalidResponseCacheTTL = errors.New("invalid response cache time to live")
+
+// ErrInvalidResponseCacheMaxEntries signals that an invalid maximum number of cached responses was provided
+var ErrInvalidResponseCacheMaxEntries = errors.New("invalid maximum number of cached responses")
+
 func createHTTPStatusError(httpStatusCode int, err error) error {
 	if err == nil {
 		err = ErrHTTPStatusCodeIsNotOK
@@ -21,6 +21,7 @@ type EndpointProvider interface {
 	GetEnableEpochsConfig() string
 	GetAccount(addressAsBech32 string) string
 	GetCostTransaction() string
+	GetSimulateTransaction() string
 	GetSendTransaction() string
 	GetSendMultipleTransactions() string
 	GetTransactionStatus(hexHash string) string
@@ -42,6 +43,13 @@ type EndpointProvider interface {
 	GetDCDTTokenData(addressAsBech32 string, tokenIdentifier string) string
 	GetNFTTokenData(addressAsBech32 string, tokenIdentifier string, nonce uint64) string
 	IsDataTrieMigrated(addressAsBech32 string) string
+	GetAccountStorageValue(addressAsBech32 string, hexKey string) string
+	GetAccountStorageKeys(addressAsBech32 string) string
+	GetAllDCDTTokens(addressAsBech32 string) string
+	GetDCDTRoles(addressAsBech32 string) string
+	GetTransactionsPoolForSender(addressAsBech32 string) string
+	GetLastPoolNonceForSender(addressAsBech32 string) string
+	GetTransactionsPoolNonceGapsForSender(addressAsBech32 string) string
 	GetBlockByNonce(shardID uint32, nonce uint64) string
 	GetBlockByHash(shardID uint32, hash string) string
 	IsInterfaceNil() bool
@@ -12,11 +12,11 @@ import (
 // Proxy holds the primitive functions that the dharitri proxy engine supports & implements
 type Proxy interface {
 	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
-	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	GetAccount(ctx context.Context, address core.AddressHandler, queryOptions api.AccountQueryOptions) (*data.Account, error)
 	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
 	SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
-	GetGuardianData(ctx context.Context, address core.AddressHandler) (*api.GuardianData, error)
-	ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error)
+	GetGuardianData(ctx context.Context, address core.AddressHandler, queryOptions api.AccountQueryOptions) (*data.GuardianData, error)
+	ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest, queryOptions api.AccountQueryOptions) (*data.VmValuesResponseData, error)
 	FilterLogs(ctx context.Context, filter *core.FilterQuery) ([]string, error)
 	IsInterfaceNil() bool
 }
@@ -27,6 +27,13 @@ type httpClientWrapper interface {
 	IsInterfaceNil() bool
 }
 
+type pollingHandler interface {
+	StartProcessingLoop() error
+	IsRunning() bool
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // EndpointProvider is able to return endpoint routes strings
 type EndpointProvider interface {
 	GetNetworkConfig() string
@@ -35,6 +42,7 @@ type EndpointProvider interface {
 	GetEnableEpochsConfig() string
 	GetAccount(addressAsBech32 string) string
 	GetCostTransaction() string
+	GetSimulateTransaction() string
 	GetSendTransaction() string
 	GetSendMultipleTransactions() string
 	GetTransactionStatus(hexHash string) string
@@ -56,6 +64,13 @@ type EndpointProvider interface {
 	GetDCDTTokenData(addressAsBech32 string, tokenIdentifier string) string
 	GetNFTTokenData(addressAsBech32 string, tokenIdentifier string, nonce uint64) string
 	IsDataTrieMigrated(addressAsBech32 string) string
+	GetAccountStorageValue(addressAsBech32 string, hexKey string) string
+	GetAccountStorageKeys(addressAsBech32 string) string
+	GetAllDCDTTokens(addressAsBech32 string) string
+	GetDCDTRoles(addressAsBech32 string) string
+	GetTransactionsPoolForSender(addressAsBech32 string) string
+	GetLastPoolNonceForSender(addressAsBech32 string) string
+	GetTransactionsPoolNonceGapsForSender(addressAsBech32 string) string
 	GetBlockByNonce(shardID uint32, nonce uint64) string
 	GetBlockByHash(shardID uint32, hash string) string
 	IsInterfaceNil() bool
@@ -73,3 +88,16 @@ type BlockDataCache interface {
 	Put(key []byte, value interface{}, sizeInBytes int) (evicted bool)
 	IsInterfaceNil() bool
 }
+
+// LogsCursorStorer defines the component able to persist the position of a logs subscription
+type LogsCursorStorer interface {
+	Load() (*core.LogsCursor, error)
+	Save(cursor core.LogsCursor) error
+	IsInterfaceNil() bool
+}
+
+// OutputsDecoder defines the behavior of a component able to decode the return data of a smart contract endpoint
+type OutputsDecoder interface {
+	DecodeOutputs(function string, returnData [][]byte) ([]interface{}, error)
+	IsInterfaceNil() bool
+}
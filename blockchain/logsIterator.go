@@ -0,0 +1,241 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const defaultLogsIteratorMaxConcurrentFetches = 4
+
+// LogsIteratorOptions holds the optional settings of a logs iterator
+type LogsIteratorOptions struct {
+	// Cursor, if set, resumes the iteration from the provided position, overriding the FromBlock filter field
+	Cursor *sdkCore.LogsCursor
+	// WindowSize is the number of blocks fetched at once. Defaults to MaximumBlocksDelta
+	WindowSize uint64
+	// MaxConcurrentFetches is the maximum number of blocks fetched in parallel. Defaults to 4
+	MaxConcurrentFetches int
+}
+
+type blockEvents struct {
+	nonce  uint64
+	events []*data.TaggedEvent
+}
+
+// logsIterator walks an arbitrarily large block range, one window at a time, and yields the matching events in the
+// order they appear in the blocks
+type logsIterator struct {
+	proxy                *proxy
+	filter               *sdkCore.FilterQuery
+	shardID              uint32
+	nextNonce            uint64
+	toNonce              uint64
+	isExhausted          bool
+	windowSize           uint64
+	maxConcurrentFetches int
+
+	pending      []*blockEvents
+	current      *blockEvents
+	currentIndex int
+	event        *data.TaggedEvent
+	err          error
+}
+
+// NewLogsIterator creates an iterator over the logs matching the provided filter. Unlike FilterLogs, the block range
+// is not bounded by MaximumBlocksDelta: the blocks are fetched in windows, on demand, while iterating. If the filter
+// does not specify the end of the range, the latest block at the moment of the call is used.
+func (ep *proxy) NewLogsIterator(
+	ctx context.Context,
+	filter *sdkCore.FilterQuery,
+	options LogsIteratorOptions,
+) (*logsIterator, error) {
+	shardID, err := ep.computeShardId(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	iteratorFilter := *filter
+	if options.Cursor != nil {
+		if options.Cursor.ShardID != shardID {
+			return nil, fmt.Errorf("%w, cursor shard %d, filter shard %d", ErrShardIDMismatch, options.Cursor.ShardID, shardID)
+		}
+
+		iteratorFilter.FromBlock.HasValue = true
+		iteratorFilter.FromBlock.Value = options.Cursor.Nonce
+	}
+
+	fromBlock, toBlock, err := ep.computeIteratorBlockRange(ctx, &iteratorFilter, shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	windowSize := options.WindowSize
+	if windowSize == 0 {
+		windowSize = MaximumBlocksDelta
+	}
+	maxConcurrentFetches := options.MaxConcurrentFetches
+	if maxConcurrentFetches <= 0 {
+		maxConcurrentFetches = defaultLogsIteratorMaxConcurrentFetches
+	}
+
+	return &logsIterator{
+		proxy:                ep,
+		filter:               filter,
+		shardID:              shardID,
+		nextNonce:            fromBlock,
+		toNonce:              toBlock,
+		isExhausted:          fromBlock > toBlock,
+		windowSize:           windowSize,
+		maxConcurrentFetches: maxConcurrentFetches,
+	}, nil
+}
+
+func (ep *proxy) computeIteratorBlockRange(ctx context.Context, filter *sdkCore.FilterQuery, shardID uint32) (uint64, uint64, error) {
+	if filter.BlockHash != nil {
+		blockNum, err := ep.getBlockNumberByHash(ctx, shardID, filter.BlockHash)
+		if err != nil {
+			return 0, 0, err
+		}
+		if filter.FromBlock.HasValue && filter.FromBlock.Value > blockNum {
+			// resumed after the only block that had to be processed
+			return blockNum + 1, blockNum, nil
+		}
+
+		return blockNum, blockNum, nil
+	}
+
+	status, err := ep.GetNetworkStatus(ctx, shardID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lastBlock := status.Nonce
+	if filter.ToBlock.HasValue && filter.ToBlock.Value <= lastBlock {
+		lastBlock = filter.ToBlock.Value
+	}
+	if filter.FromBlock.HasValue && filter.FromBlock.Value > lastBlock {
+		// resumed after the last block of the range, nothing to process
+		return filter.FromBlock.Value, filter.FromBlock.Value - 1, nil
+	}
+
+	return resolveUnboundedBlockRange(filter, status.Nonce)
+}
+
+// Next advances the iterator to the next matching event. It returns false when there are no more events or if an
+// error occurred, in which case the error can be retrieved by calling Err
+func (it *logsIterator) Next(ctx context.Context) bool {
+	for it.err == nil {
+		if it.current != nil && it.currentIndex < len(it.current.events) {
+			it.event = it.current.events[it.currentIndex]
+			it.currentIndex++
+			return true
+		}
+
+		if len(it.pending) > 0 {
+			it.current = it.pending[0]
+			it.pending = it.pending[1:]
+			it.currentIndex = 0
+			continue
+		}
+
+		if it.isExhausted {
+			break
+		}
+
+		it.err = it.fetchNextWindow(ctx)
+	}
+
+	it.event = nil
+	return false
+}
+
+func (it *logsIterator) fetchNextWindow(ctx context.Context) error {
+	windowEnd := it.toNonce
+	if it.toNonce-it.nextNonce >= it.windowSize {
+		windowEnd = it.nextNonce + it.windowSize - 1
+	}
+
+	// the final nonce is fetched once for the whole window, not for each block
+	finalNonce, hasFinalNonce := it.proxy.getFinalNonce(ctx, it.shardID, windowEnd)
+
+	numBlocks := windowEnd - it.nextNonce + 1
+	window := make([]*blockEvents, numBlocks)
+	errs := make([]error, numBlocks)
+	semaphore := make(chan struct{}, it.maxConcurrentFetches)
+
+	var wg sync.WaitGroup
+	for idx := uint64(0); idx < numBlocks; idx++ {
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(index uint64) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			nonce := it.nextNonce + index
+			isFinal := hasFinalNonce && nonce <= finalNonce
+			events, err := it.proxy.getLogsFromBlock(ctx, it.shardID, nonce, it.filter, isFinal)
+			window[index] = &blockEvents{
+				nonce:  nonce,
+				events: events,
+			}
+			errs[index] = err
+		}(idx)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	it.pending = append(it.pending, window...)
+	it.isExhausted = windowEnd == it.toNonce
+	it.nextNonce = windowEnd + 1
+
+	return nil
+}
+
+// Event returns the current event
+func (it *logsIterator) Event() *transaction.Events {
+	if it.event == nil {
+		return nil
+	}
+
+	return it.event.Event
+}
+
+// TaggedEvent returns the current event, tagged with the shard, block and transaction that generated it
+func (it *logsIterator) TaggedEvent() *data.TaggedEvent {
+	return it.event
+}
+
+// Cursor returns the position from which a new iterator should resume in order to continue after the events already
+// returned by this iterator. Events from a block that was only partially iterated will be returned again when resuming.
+func (it *logsIterator) Cursor() sdkCore.LogsCursor {
+	cursor := sdkCore.LogsCursor{
+		ShardID: it.shardID,
+		Nonce:   it.nextNonce,
+	}
+
+	if len(it.pending) > 0 {
+		cursor.Nonce = it.pending[0].nonce
+	}
+	if it.current != nil && it.currentIndex < len(it.current.events) {
+		cursor.Nonce = it.current.nonce
+	}
+
+	return cursor
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *logsIterator) Err() error {
+	return it.err
+}
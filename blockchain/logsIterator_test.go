@@ -0,0 +1,249 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const blockByNoncePrefix = "/block/by-nonce/"
+
+// createMockBlockResponse creates a block that holds one event for each of the provided identifiers
+func createMockBlockResponse(nonce uint64, identifiers ...string) []byte {
+	events := make([]*transaction.Events, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		events = append(events, &transaction.Events{
+			Address:    "address",
+			Identifier: identifier,
+		})
+	}
+
+	response := data.BlockResponse{
+		Data: data.BlockDataResponse{
+			Block: &api.Block{
+				Nonce: nonce,
+				MiniBlocks: []*api.MiniBlock{
+					{
+						Transactions: []*transaction.ApiTransactionResult{
+							{
+								Logs: &transaction.ApiLogs{
+									Events: events,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	buff, _ := json.Marshal(response)
+	return buff
+}
+
+// createLogsMockClient serves blocks that hold two events each, except for the blocks divisible by 3 that are empty
+func createLogsMockClient(latestNonce uint64, numBlockRequests *int32) *mockHTTPClient {
+	return &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			response, handled, err := handleRequestNetworkConfigAndStatus(req, 3, latestNonce, latestNonce)
+			if handled {
+				return response, err
+			}
+			if !strings.HasPrefix(req.URL.Path, blockByNoncePrefix) {
+				return nil, fmt.Errorf("unexpected request %s", req.URL.String())
+			}
+
+			atomic.AddInt32(numBlockRequests, 1)
+			nonce := uint64(0)
+			_, _ = fmt.Sscanf(strings.TrimPrefix(req.URL.Path, blockByNoncePrefix), "%d", &nonce)
+			identifiers := []string{fmt.Sprintf("first-%d", nonce), fmt.Sprintf("second-%d", nonce)}
+			if nonce%3 == 0 {
+				identifiers = nil
+			}
+
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewReader(createMockBlockResponse(nonce, identifiers...))),
+				StatusCode: http.StatusOK,
+			}, nil
+		},
+	}
+}
+
+func createIteratorFilter(fromBlock uint64, toBlock uint64) *sdkCore.FilterQuery {
+	return &sdkCore.FilterQuery{
+		FromBlock: core.OptionalUint64{Value: fromBlock, HasValue: true},
+		ToBlock:   core.OptionalUint64{Value: toBlock, HasValue: true},
+		ShardID:   core.OptionalUint32{Value: 2, HasValue: true},
+	}
+}
+
+func collectIdentifiers(t *testing.T, iterator *logsIterator, maxEvents int) []string {
+	identifiers := make([]string, 0)
+	for len(identifiers) < maxEvents && iterator.Next(context.Background()) {
+		identifiers = append(identifiers, iterator.Event().Identifier)
+	}
+	require.Nil(t, iterator.Err())
+
+	return identifiers
+}
+
+func TestProxy_NewLogsIterator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cursor from another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		ep, _ := NewProxy(createMockArgsProxy(createLogsMockClient(100, &numBlockRequests)))
+
+		iterator, err := ep.NewLogsIterator(context.Background(), createIteratorFilter(1, 10), LogsIteratorOptions{
+			Cursor: &sdkCore.LogsCursor{ShardID: 1, Nonce: 5},
+		})
+		assert.Nil(t, iterator)
+		assert.True(t, errors.Is(err, ErrShardIDMismatch))
+	})
+	t.Run("to block greater than the latest block should error", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		ep, _ := NewProxy(createMockArgsProxy(createLogsMockClient(100, &numBlockRequests)))
+
+		iterator, err := ep.NewLogsIterator(context.Background(), createIteratorFilter(1, 101), LogsIteratorOptions{})
+		assert.Nil(t, iterator)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestLogsIterator_Next(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should iterate over a range larger than the maximum blocks delta", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		ep, _ := NewProxy(createMockArgsProxy(createLogsMockClient(2000, &numBlockRequests)))
+
+		iterator, err := ep.NewLogsIterator(context.Background(), createIteratorFilter(1, 1200), LogsIteratorOptions{
+			MaxConcurrentFetches: 10,
+		})
+		require.Nil(t, err)
+
+		identifiers := collectIdentifiers(t, iterator, 10000)
+		assert.Equal(t, 1600, len(identifiers))
+		assert.Equal(t, "first-1", identifiers[0])
+		assert.Equal(t, "second-1", identifiers[1])
+		assert.Equal(t, "first-2", identifiers[2])
+		assert.Equal(t, "first-4", identifiers[4])
+		assert.Equal(t, "second-1199", identifiers[len(identifiers)-1])
+		assert.Equal(t, int32(1200), atomic.LoadInt32(&numBlockRequests))
+		assert.Equal(t, sdkCore.LogsCursor{ShardID: 2, Nonce: 1201}, iterator.Cursor())
+		assert.False(t, iterator.Next(context.Background()))
+	})
+	t.Run("should fetch the blocks in windows", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		ep, _ := NewProxy(createMockArgsProxy(createLogsMockClient(100, &numBlockRequests)))
+
+		iterator, err := ep.NewLogsIterator(context.Background(), createIteratorFilter(1, 100), LogsIteratorOptions{
+			WindowSize: 10,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&numBlockRequests))
+
+		identifiers := collectIdentifiers(t, iterator, 1)
+		assert.Equal(t, []string{"first-1"}, identifiers)
+		assert.Equal(t, int32(10), atomic.LoadInt32(&numBlockRequests))
+	})
+	t.Run("should resume from the cursor", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		ep, _ := NewProxy(createMockArgsProxy(createLogsMockClient(100, &numBlockRequests)))
+
+		filter := createIteratorFilter(1, 20)
+		iterator, err := ep.NewLogsIterator(context.Background(), filter, LogsIteratorOptions{WindowSize: 3})
+		require.Nil(t, err)
+
+		identifiers := collectIdentifiers(t, iterator, 3)
+		assert.Equal(t, []string{"first-1", "second-1", "first-2"}, identifiers)
+		cursor := iterator.Cursor()
+		assert.Equal(t, sdkCore.LogsCursor{ShardID: 2, Nonce: 2}, cursor)
+
+		identifiers = collectIdentifiers(t, iterator, 1)
+		assert.Equal(t, []string{"second-2"}, identifiers)
+		cursor = iterator.Cursor()
+		assert.Equal(t, sdkCore.LogsCursor{ShardID: 2, Nonce: 3}, cursor)
+
+		resumedIterator, err := ep.NewLogsIterator(context.Background(), filter, LogsIteratorOptions{Cursor: &cursor})
+		require.Nil(t, err)
+		identifiers = collectIdentifiers(t, resumedIterator, 2)
+		assert.Equal(t, []string{"first-4", "second-4"}, identifiers)
+	})
+	t.Run("cursor after the end of the range should not yield events", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		ep, _ := NewProxy(createMockArgsProxy(createLogsMockClient(100, &numBlockRequests)))
+
+		iterator, err := ep.NewLogsIterator(context.Background(), createIteratorFilter(1, 20), LogsIteratorOptions{
+			Cursor: &sdkCore.LogsCursor{ShardID: 2, Nonce: 21},
+		})
+		require.Nil(t, err)
+		assert.False(t, iterator.Next(context.Background()))
+		assert.Nil(t, iterator.Err())
+		assert.Equal(t, sdkCore.LogsCursor{ShardID: 2, Nonce: 21}, iterator.Cursor())
+		assert.Equal(t, int32(0), atomic.LoadInt32(&numBlockRequests))
+	})
+	t.Run("should use the block data cache", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		args := createMockArgsProxy(createLogsMockClient(100, &numBlockRequests))
+		args.FilterQueryBlockCacher = storage.NewMapCacher()
+		ep, _ := NewProxy(args)
+
+		for i := 0; i < 2; i++ {
+			iterator, err := ep.NewLogsIterator(context.Background(), createIteratorFilter(1, 50), LogsIteratorOptions{})
+			require.Nil(t, err)
+			_ = collectIdentifiers(t, iterator, 1000)
+		}
+
+		assert.Equal(t, int32(50), atomic.LoadInt32(&numBlockRequests))
+	})
+	t.Run("fetch error should stop the iteration", func(t *testing.T) {
+		t.Parallel()
+
+		numBlockRequests := int32(0)
+		httpClient := createLogsMockClient(100, &numBlockRequests)
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		iterator, err := ep.NewLogsIterator(context.Background(), createIteratorFilter(1, 20), LogsIteratorOptions{WindowSize: 5})
+		require.Nil(t, err)
+
+		expectedErr := errors.New("expected error")
+		httpClient.doCalled = func(req *http.Request) (*http.Response, error) {
+			return nil, expectedErr
+		}
+
+		assert.False(t, iterator.Next(context.Background()))
+		assert.True(t, errors.Is(iterator.Err(), expectedErr))
+		assert.Equal(t, sdkCore.LogsCursor{ShardID: 2, Nonce: 1}, iterator.Cursor())
+	})
+}
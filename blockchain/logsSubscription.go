@@ -0,0 +1,308 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/factory"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/core/polling"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const (
+	defaultSubscriptionPollingInterval  = time.Second * 6
+	defaultSubscriptionPollingWhenError = time.Second * 12
+	logsSubscriptionName                = "logs subscription"
+)
+
+// SubscribeLogsOptions holds the optional settings of a logs subscription
+type SubscribeLogsOptions struct {
+	// Cursor, if set, is the position from which the subscription starts. It takes precedence over the cursor
+	// loaded from the CursorStorer and over the FromBlock filter field. If none are set, the subscription starts
+	// after the latest final block.
+	Cursor *sdkCore.LogsCursor
+	// CursorStorer persists the position of the subscription after the events were delivered. Optional
+	CursorStorer LogsCursorStorer
+	// PollingInterval is the time between two consecutive checks of the shard head. Defaults to 6 seconds
+	PollingInterval time.Duration
+	// PollingWhenError is the time to wait after a failed check. Defaults to 12 seconds
+	PollingWhenError time.Duration
+	// AllowedDeltaToFinal is the number of nonces, behind the shard head, considered not final. Defaults to 1
+	AllowedDeltaToFinal int
+	// EventsBufferSize is the size of the events channel buffer
+	EventsBufferSize int
+}
+
+// logsSubscription polls the head of a shard and delivers the events matching the filter, only from final blocks.
+// The events are delivered at least once: the cursor is persisted after the events were pushed on the channel.
+type logsSubscription struct {
+	proxy               *proxy
+	filter              *sdkCore.FilterQuery
+	finalityProvider    FinalityProvider
+	cursorStorer        LogsCursorStorer
+	allowedDeltaToFinal uint64
+	pollingHandler      pollingHandler
+	events              chan *data.TaggedEvent
+
+	mutCursor sync.RWMutex
+	cursor    sdkCore.LogsCursor
+	hasCursor bool
+
+	mutState sync.Mutex
+	isClosed bool
+}
+
+// SubscribeLogs starts a subscription that delivers, on the returned subscription's channel, the events matching the
+// provided filter, as the blocks of the filter's shard become final. The subscription stops when the provided context
+// is done or when Close is called, after which the events channel is closed.
+func (ep *proxy) SubscribeLogs(
+	ctx context.Context,
+	filter *sdkCore.FilterQuery,
+	options SubscribeLogsOptions,
+) (*logsSubscription, error) {
+	if filter.BlockHash != nil || filter.ToBlock.HasValue || filter.AllShards {
+		return nil, fmt.Errorf("%w, block hash, to block and all shards fields are not supported", ErrUnsupportedSubscriptionFilter)
+	}
+
+	shardID, err := ep.computeShardId(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := ep.createLogsSubscription(filter, shardID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subscription.loadCursor(options.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subscription.pollingHandler.StartProcessingLoop()
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = subscription.Close()
+	}()
+
+	return subscription, nil
+}
+
+func (ep *proxy) createLogsSubscription(
+	filter *sdkCore.FilterQuery,
+	shardID uint32,
+	options SubscribeLogsOptions,
+) (*logsSubscription, error) {
+	allowedDeltaToFinal := options.AllowedDeltaToFinal
+	if allowedDeltaToFinal == 0 {
+		allowedDeltaToFinal = sdkCore.MinAllowedDeltaToFinal
+	}
+	if allowedDeltaToFinal < sdkCore.MinAllowedDeltaToFinal {
+		return nil, fmt.Errorf("%w, provided: %d, minimum: %d",
+			ErrInvalidAllowedDeltaToFinal, allowedDeltaToFinal, sdkCore.MinAllowedDeltaToFinal)
+	}
+
+	finalityProvider, err := factory.CreateFinalityProvider(ep.baseProxy, true)
+	if err != nil {
+		return nil, err
+	}
+
+	cursorStorer := options.CursorStorer
+	if check.IfNil(cursorStorer) {
+		cursorStorer = &DisabledLogsCursorStorer{}
+	}
+
+	subscription := &logsSubscription{
+		proxy:               ep,
+		filter:              filter,
+		finalityProvider:    finalityProvider,
+		cursorStorer:        cursorStorer,
+		allowedDeltaToFinal: uint64(allowedDeltaToFinal),
+		events:              make(chan *data.TaggedEvent, options.EventsBufferSize),
+		cursor: sdkCore.LogsCursor{
+			ShardID: shardID,
+		},
+	}
+
+	pollingInterval := options.PollingInterval
+	if pollingInterval == 0 {
+		pollingInterval = defaultSubscriptionPollingInterval
+	}
+	pollingWhenError := options.PollingWhenError
+	if pollingWhenError == 0 {
+		pollingWhenError = defaultSubscriptionPollingWhenError
+	}
+
+	subscription.pollingHandler, err = polling.NewPollingHandler(polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             logsSubscriptionName,
+		PollingInterval:  pollingInterval,
+		PollingWhenError: pollingWhenError,
+		Executor:         subscription,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (subscription *logsSubscription) loadCursor(providedCursor *sdkCore.LogsCursor) error {
+	cursor := providedCursor
+	if cursor == nil {
+		storedCursor, err := subscription.cursorStorer.Load()
+		if err != nil {
+			return err
+		}
+
+		cursor = storedCursor
+	}
+
+	if cursor != nil {
+		if cursor.ShardID != subscription.cursor.ShardID {
+			return fmt.Errorf("%w, cursor shard %d, filter shard %d", ErrShardIDMismatch, cursor.ShardID, subscription.cursor.ShardID)
+		}
+
+		subscription.setCursor(cursor.Nonce)
+		return nil
+	}
+
+	if subscription.filter.FromBlock.HasValue {
+		subscription.setCursor(subscription.filter.FromBlock.Value)
+	}
+
+	return nil
+}
+
+func (subscription *logsSubscription) setCursor(nonce uint64) {
+	subscription.mutCursor.Lock()
+	subscription.cursor.Nonce = nonce
+	subscription.hasCursor = true
+	subscription.mutCursor.Unlock()
+}
+
+// Execute delivers the events from the blocks that became final since the last call
+func (subscription *logsSubscription) Execute(ctx context.Context) error {
+	subscription.mutState.Lock()
+	defer subscription.mutState.Unlock()
+
+	if subscription.isClosed {
+		return ErrSubscriptionClosed
+	}
+
+	shardID := subscription.cursor.ShardID
+	status, err := subscription.proxy.GetNetworkStatus(ctx, shardID)
+	if err != nil {
+		return err
+	}
+
+	err = subscription.finalityProvider.CheckShardFinalization(ctx, shardID, subscription.allowedDeltaToFinal)
+	if err != nil {
+		return err
+	}
+
+	if status.Nonce < subscription.allowedDeltaToFinal {
+		return nil
+	}
+	finalNonce := status.Nonce - subscription.allowedDeltaToFinal
+
+	cursor, hasCursor := subscription.getCursor()
+	if !hasCursor {
+		// no starting point was provided, only the events from the next final blocks will be delivered
+		subscription.setCursor(finalNonce + 1)
+		return subscription.cursorStorer.Save(subscription.Cursor())
+	}
+	if cursor.Nonce > finalNonce {
+		return nil
+	}
+
+	// the blocks are cached by the proxy only if they are final for its own allowed delta to final as well
+	cacheableNonce, canCache := subscription.proxy.computeFinalNonce(ctx, shardID, status.Nonce, finalNonce)
+	for nonce := cursor.Nonce; nonce <= finalNonce; nonce++ {
+		err = subscription.deliverBlockEvents(ctx, nonce, canCache && nonce <= cacheableNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return subscription.cursorStorer.Save(subscription.Cursor())
+}
+
+func (subscription *logsSubscription) deliverBlockEvents(ctx context.Context, nonce uint64, isFinal bool) error {
+	events, err := subscription.proxy.getLogsFromBlock(ctx, subscription.cursor.ShardID, nonce, subscription.filter, isFinal)
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		select {
+		case subscription.events <- event:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	subscription.setCursor(nonce + 1)
+	if len(events) == 0 {
+		return nil
+	}
+
+	return subscription.cursorStorer.Save(subscription.Cursor())
+}
+
+// Events returns the channel on which the matching events are delivered
+func (subscription *logsSubscription) Events() <-chan *data.TaggedEvent {
+	return subscription.events
+}
+
+// Cursor returns the position of the subscription: all the events from the blocks before it were delivered
+func (subscription *logsSubscription) Cursor() sdkCore.LogsCursor {
+	cursor, _ := subscription.getCursor()
+	return cursor
+}
+
+func (subscription *logsSubscription) getCursor() (sdkCore.LogsCursor, bool) {
+	subscription.mutCursor.RLock()
+	defer subscription.mutCursor.RUnlock()
+
+	return subscription.cursor, subscription.hasCursor
+}
+
+// Close stops the subscription, persists its cursor and closes the events channel
+func (subscription *logsSubscription) Close() error {
+	err := subscription.pollingHandler.Close()
+
+	subscription.mutState.Lock()
+	defer subscription.mutState.Unlock()
+
+	if subscription.isClosed {
+		return err
+	}
+	subscription.isClosed = true
+	close(subscription.events)
+
+	cursor, hasCursor := subscription.getCursor()
+	if !hasCursor {
+		return err
+	}
+
+	errSave := subscription.cursorStorer.Save(cursor)
+	if err != nil {
+		return err
+	}
+
+	return errSave
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (subscription *logsSubscription) IsInterfaceNil() bool {
+	return subscription == nil
+}
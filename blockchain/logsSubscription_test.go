@@ -0,0 +1,275 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logsCursorStorerStub struct {
+	mut         sync.Mutex
+	loadCalled  func() (*sdkCore.LogsCursor, error)
+	savedCursor []sdkCore.LogsCursor
+}
+
+func (stub *logsCursorStorerStub) Load() (*sdkCore.LogsCursor, error) {
+	if stub.loadCalled != nil {
+		return stub.loadCalled()
+	}
+
+	return nil, nil
+}
+
+func (stub *logsCursorStorerStub) Save(cursor sdkCore.LogsCursor) error {
+	stub.mut.Lock()
+	stub.savedCursor = append(stub.savedCursor, cursor)
+	stub.mut.Unlock()
+
+	return nil
+}
+
+func (stub *logsCursorStorerStub) lastSaved() (sdkCore.LogsCursor, bool) {
+	stub.mut.Lock()
+	defer stub.mut.Unlock()
+
+	if len(stub.savedCursor) == 0 {
+		return sdkCore.LogsCursor{}, false
+	}
+
+	return stub.savedCursor[len(stub.savedCursor)-1], true
+}
+
+func (stub *logsCursorStorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+// createSubscriptionMockClient creates a client for an observer in shard 2 whose head is given by the provided counter
+func createSubscriptionMockClient(latestNonce *uint64) *mockHTTPClient {
+	return &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			currentNonce := atomic.LoadUint64(latestNonce)
+			response, handled, err := handleRequestNetworkConfigAndStatus(req, 3, currentNonce, currentNonce)
+			if handled {
+				return response, err
+			}
+			if !strings.HasPrefix(req.URL.Path, blockByNoncePrefix) {
+				return nil, fmt.Errorf("unexpected request %s", req.URL.String())
+			}
+
+			nonce := uint64(0)
+			_, _ = fmt.Sscanf(strings.TrimPrefix(req.URL.Path, blockByNoncePrefix), "%d", &nonce)
+			if nonce > currentNonce {
+				return nil, fmt.Errorf("block %d was not produced yet", nonce)
+			}
+
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewReader(createMockBlockResponse(nonce, fmt.Sprintf("event-%d", nonce)))),
+				StatusCode: http.StatusOK,
+			}, nil
+		},
+	}
+}
+
+func createSubscriptionFilter() *sdkCore.FilterQuery {
+	return &sdkCore.FilterQuery{
+		ShardID: core.OptionalUint32{Value: 2, HasValue: true},
+	}
+}
+
+func createSubscriptionOptions() SubscribeLogsOptions {
+	return SubscribeLogsOptions{
+		PollingInterval:  time.Millisecond * 10,
+		PollingWhenError: time.Millisecond * 10,
+	}
+}
+
+func readSubscriptionEvents(t *testing.T, subscription *logsSubscription, numEvents int) []string {
+	identifiers := make([]string, 0, numEvents)
+	for len(identifiers) < numEvents {
+		select {
+		case event := <-subscription.Events():
+			identifiers = append(identifiers, event.Event.Identifier)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "timeout waiting for events")
+		}
+	}
+
+	return identifiers
+}
+
+func TestProxy_SubscribeLogs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported filter should error", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		ep, _ := NewProxy(createMockArgsProxy(createSubscriptionMockClient(&latestNonce)))
+
+		filter := createSubscriptionFilter()
+		filter.ToBlock = core.OptionalUint64{Value: 10, HasValue: true}
+		subscription, err := ep.SubscribeLogs(context.Background(), filter, createSubscriptionOptions())
+		assert.Nil(t, subscription)
+		assert.True(t, errors.Is(err, ErrUnsupportedSubscriptionFilter))
+
+		filter = createSubscriptionFilter()
+		filter.BlockHash = []byte("hash")
+		subscription, err = ep.SubscribeLogs(context.Background(), filter, createSubscriptionOptions())
+		assert.Nil(t, subscription)
+		assert.True(t, errors.Is(err, ErrUnsupportedSubscriptionFilter))
+	})
+	t.Run("stored cursor from another shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		ep, _ := NewProxy(createMockArgsProxy(createSubscriptionMockClient(&latestNonce)))
+
+		options := createSubscriptionOptions()
+		options.CursorStorer = &logsCursorStorerStub{
+			loadCalled: func() (*sdkCore.LogsCursor, error) {
+				return &sdkCore.LogsCursor{ShardID: 1, Nonce: 5}, nil
+			},
+		}
+		subscription, err := ep.SubscribeLogs(context.Background(), createSubscriptionFilter(), options)
+		assert.Nil(t, subscription)
+		assert.True(t, errors.Is(err, ErrShardIDMismatch))
+	})
+	t.Run("storer load error should error", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		ep, _ := NewProxy(createMockArgsProxy(createSubscriptionMockClient(&latestNonce)))
+
+		expectedErr := errors.New("expected error")
+		options := createSubscriptionOptions()
+		options.CursorStorer = &logsCursorStorerStub{
+			loadCalled: func() (*sdkCore.LogsCursor, error) {
+				return nil, expectedErr
+			},
+		}
+		subscription, err := ep.SubscribeLogs(context.Background(), createSubscriptionFilter(), options)
+		assert.Nil(t, subscription)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestLogsSubscription_Events(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should deliver only the events from final blocks, starting from the filter's from block", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		ep, _ := NewProxy(createMockArgsProxy(createSubscriptionMockClient(&latestNonce)))
+
+		filter := createSubscriptionFilter()
+		filter.FromBlock = core.OptionalUint64{Value: 7, HasValue: true}
+		storer := &logsCursorStorerStub{}
+		options := createSubscriptionOptions()
+		options.CursorStorer = storer
+		subscription, err := ep.SubscribeLogs(context.Background(), filter, options)
+		require.Nil(t, err)
+		defer func() {
+			_ = subscription.Close()
+		}()
+
+		assert.Equal(t, []string{"event-7", "event-8", "event-9"}, readSubscriptionEvents(t, subscription, 3))
+
+		atomic.StoreUint64(&latestNonce, 12)
+		assert.Equal(t, []string{"event-10", "event-11"}, readSubscriptionEvents(t, subscription, 2))
+
+		require.Eventually(t, func() bool {
+			cursor, _ := storer.lastSaved()
+			return cursor == sdkCore.LogsCursor{ShardID: 2, Nonce: 12}
+		}, time.Second*5, time.Millisecond*10)
+	})
+	t.Run("should resume from the stored cursor", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		ep, _ := NewProxy(createMockArgsProxy(createSubscriptionMockClient(&latestNonce)))
+
+		filter := createSubscriptionFilter()
+		filter.FromBlock = core.OptionalUint64{Value: 1, HasValue: true}
+		options := createSubscriptionOptions()
+		options.CursorStorer = &logsCursorStorerStub{
+			loadCalled: func() (*sdkCore.LogsCursor, error) {
+				return &sdkCore.LogsCursor{ShardID: 2, Nonce: 9}, nil
+			},
+		}
+		subscription, err := ep.SubscribeLogs(context.Background(), filter, options)
+		require.Nil(t, err)
+		defer func() {
+			_ = subscription.Close()
+		}()
+
+		assert.Equal(t, []string{"event-9"}, readSubscriptionEvents(t, subscription, 1))
+	})
+	t.Run("without a starting point should deliver only the events from new final blocks", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		ep, _ := NewProxy(createMockArgsProxy(createSubscriptionMockClient(&latestNonce)))
+
+		subscription, err := ep.SubscribeLogs(context.Background(), createSubscriptionFilter(), createSubscriptionOptions())
+		require.Nil(t, err)
+		defer func() {
+			_ = subscription.Close()
+		}()
+
+		require.Eventually(t, func() bool {
+			return subscription.Cursor().Nonce == 10
+		}, time.Second*5, time.Millisecond*10)
+
+		atomic.StoreUint64(&latestNonce, 11)
+		assert.Equal(t, []string{"event-10"}, readSubscriptionEvents(t, subscription, 1))
+	})
+	t.Run("canceling the context should close the events channel", func(t *testing.T) {
+		t.Parallel()
+
+		latestNonce := uint64(10)
+		ep, _ := NewProxy(createMockArgsProxy(createSubscriptionMockClient(&latestNonce)))
+
+		filter := createSubscriptionFilter()
+		filter.FromBlock = core.OptionalUint64{Value: 1, HasValue: true}
+		storer := &logsCursorStorerStub{}
+		options := createSubscriptionOptions()
+		options.CursorStorer = storer
+		ctx, cancel := context.WithCancel(context.Background())
+		subscription, err := ep.SubscribeLogs(ctx, filter, options)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"event-1"}, readSubscriptionEvents(t, subscription, 1))
+		cancel()
+
+		require.Eventually(t, func() bool {
+			for {
+				select {
+				case _, ok := <-subscription.Events():
+					if !ok {
+						return true
+					}
+				default:
+					return false
+				}
+			}
+		}, time.Second*5, time.Millisecond*10)
+
+		cursor, _ := storer.lastSaved()
+		assert.Equal(t, subscription.Cursor(), cursor)
+		assert.Nil(t, subscription.Close())
+	})
+}
@@ -0,0 +1,292 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
+)
+
+const (
+	healthScoreSmoothingFactor = 0.2
+	healthScoreErrorWeight     = 1000.0
+	healthScoreNonceLagWeight  = 100.0
+)
+
+// EndpointHealth holds a snapshot of the health metrics of one of the endpoints used by the multi-endpoint proxy
+type EndpointHealth struct {
+	URL             string
+	AverageLatency  time.Duration
+	ErrorRate       float64
+	LastKnownNonce  uint64
+	NonceLag        uint64
+	NumRequests     uint64
+	NumErrors       uint64
+	LastStatusError error
+	IsUnhealthy     bool
+	Score           float64
+}
+
+type endpointStatusGetter interface {
+	getNetworkStatusNonce(ctx context.Context, shardID uint32) (uint64, error)
+}
+
+type trackedEndpoint struct {
+	url            string
+	wrapper        httpClientWrapper
+	statusGetter   endpointStatusGetter
+	averageLatency float64
+	errorRate      float64
+	lastNonce      uint64
+	numRequests    uint64
+	numErrors      uint64
+	lastStatusErr  error
+}
+
+// multiEndpointClientWrapper is an httpClientWrapper implementation that dispatches the requests towards the
+// healthiest endpoint out of a provided list. The health of an endpoint is scored by its average latency, its error
+// rate and how far it lags behind the highest nonce reported by all the endpoints. Failed requests are moved to the
+// next endpoint in the scored order.
+type multiEndpointClientWrapper struct {
+	mut                sync.RWMutex
+	endpoints          []*trackedEndpoint
+	highestNonce       uint64
+	healthCheckShardID uint32
+	maxNonceLag        uint64
+	timeHandler        func() time.Time
+}
+
+func newMultiEndpointClientWrapper(endpoints []*trackedEndpoint, healthCheckShardID uint32, maxNonceLag uint64) (*multiEndpointClientWrapper, error) {
+	if len(endpoints) == 0 {
+		return nil, ErrNoProxyURLsProvided
+	}
+	for _, endpoint := range endpoints {
+		if check.IfNil(endpoint.wrapper) {
+			return nil, fmt.Errorf("%w for URL %s", ErrNilHTTPClientWrapper, endpoint.url)
+		}
+	}
+
+	return &multiEndpointClientWrapper{
+		endpoints:          endpoints,
+		healthCheckShardID: healthCheckShardID,
+		maxNonceLag:        maxNonceLag,
+		timeHandler:        time.Now,
+	}, nil
+}
+
+// GetHTTP does a GET method operation on the healthiest endpoint, failing over to the next ones in case of errors
+func (wrapper *multiEndpointClientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	return wrapper.doWithFailover(ctx, true, nil, func(client httpClientWrapper) ([]byte, int, error) {
+		return client.GetHTTP(ctx, endpoint)
+	})
+}
+
+// PostHTTP does a POST method operation on the healthiest endpoint. The request is moved to the next endpoints in case
+// of errors only if it is marked as idempotent or if its resend checker allows it, the same as the retries of a
+// single endpoint
+func (wrapper *multiEndpointClientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	isIdempotent := sdkHttp.IsIdempotentRequest(ctx)
+	resendChecker := sdkHttp.ResendCheckerFromContext(ctx)
+
+	return wrapper.doWithFailover(ctx, isIdempotent, resendChecker, func(client httpClientWrapper) ([]byte, int, error) {
+		return client.PostHTTP(ctx, endpoint, data)
+	})
+}
+
+func (wrapper *multiEndpointClientWrapper) doWithFailover(
+	ctx context.Context,
+	isIdempotent bool,
+	resendChecker sdkHttp.ResendChecker,
+	handler func(client httpClientWrapper) ([]byte, int, error),
+) ([]byte, int, error) {
+	var buff []byte
+	var code int
+	var err error
+	for idx, endpoint := range wrapper.sortedEndpoints() {
+		if idx > 0 && !isIdempotent {
+			canResend, response := checkResend(ctx, resendChecker)
+			if !canResend {
+				if response != nil {
+					return response, http.StatusOK, nil
+				}
+
+				return buff, code, err
+			}
+		}
+
+		startTime := wrapper.timeHandler()
+		buff, code, err = handler(endpoint.wrapper)
+		failed := isFailedRequest(code, err)
+		wrapper.recordRequest(endpoint, wrapper.timeHandler().Sub(startTime), failed)
+		if !failed {
+			return buff, code, err
+		}
+		if ctx.Err() != nil {
+			return buff, code, err
+		}
+
+		log.Debug("multiEndpointClientWrapper: request failed, trying the next endpoint",
+			"url", endpoint.url, "code", code, "error", err)
+	}
+
+	return buff, code, err
+}
+
+// checkResend returns true if a non-idempotent request can be posted on another endpoint. If the resend checker finds
+// that the request was already accepted, the response built by the checker is returned
+func checkResend(ctx context.Context, resendChecker sdkHttp.ResendChecker) (bool, []byte) {
+	if resendChecker == nil {
+		return false, nil
+	}
+
+	canResend, response, err := resendChecker(ctx)
+	if err != nil {
+		log.Debug("multiEndpointClientWrapper: can not check if the request can be sent again", "error", err)
+		return false, nil
+	}
+
+	return canResend, response
+}
+
+func isFailedRequest(code int, err error) bool {
+	return err != nil || code >= http.StatusInternalServerError || code == http.StatusTooManyRequests
+}
+
+func (wrapper *multiEndpointClientWrapper) recordRequest(endpoint *trackedEndpoint, latency time.Duration, failed bool) {
+	wrapper.mut.Lock()
+	defer wrapper.mut.Unlock()
+
+	errorSample := 0.0
+	endpoint.numRequests++
+	if failed {
+		errorSample = 1
+		endpoint.numErrors++
+	}
+
+	endpoint.errorRate = smoothValue(endpoint.errorRate, errorSample, endpoint.numRequests)
+	endpoint.averageLatency = smoothValue(endpoint.averageLatency, float64(latency), endpoint.numRequests)
+}
+
+func smoothValue(oldValue float64, sample float64, numSamples uint64) float64 {
+	if numSamples <= 1 {
+		return sample
+	}
+
+	return oldValue*(1-healthScoreSmoothingFactor) + sample*healthScoreSmoothingFactor
+}
+
+func (wrapper *multiEndpointClientWrapper) sortedEndpoints() []*trackedEndpoint {
+	wrapper.mut.RLock()
+	defer wrapper.mut.RUnlock()
+
+	sorted := make([]*trackedEndpoint, len(wrapper.endpoints))
+	copy(sorted, wrapper.endpoints)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		isUnhealthyI, isUnhealthyJ := wrapper.isUnhealthy(sorted[i]), wrapper.isUnhealthy(sorted[j])
+		if isUnhealthyI != isUnhealthyJ {
+			return isUnhealthyJ
+		}
+
+		return wrapper.computeScore(sorted[i]) < wrapper.computeScore(sorted[j])
+	})
+
+	return sorted
+}
+
+// computeScore returns the score of the provided endpoint. A lower score means a healthier endpoint
+func (wrapper *multiEndpointClientWrapper) computeScore(endpoint *trackedEndpoint) float64 {
+	return endpoint.averageLatency/float64(time.Millisecond) +
+		endpoint.errorRate*healthScoreErrorWeight +
+		float64(wrapper.computeNonceLag(endpoint))*healthScoreNonceLagWeight
+}
+
+// isUnhealthy returns true if the endpoint could not report its status or lags more than the allowed maximum. The
+// unhealthy endpoints are moved to the back of the list, whatever their score
+func (wrapper *multiEndpointClientWrapper) isUnhealthy(endpoint *trackedEndpoint) bool {
+	return endpoint.lastStatusErr != nil || (wrapper.maxNonceLag > 0 && wrapper.computeNonceLag(endpoint) > wrapper.maxNonceLag)
+}
+
+func (wrapper *multiEndpointClientWrapper) computeNonceLag(endpoint *trackedEndpoint) uint64 {
+	if endpoint.lastNonce >= wrapper.highestNonce {
+		return 0
+	}
+
+	return wrapper.highestNonce - endpoint.lastNonce
+}
+
+// Execute will query all the endpoints for their network status and update the nonce lag of each one
+func (wrapper *multiEndpointClientWrapper) Execute(ctx context.Context) error {
+	nonces := make([]uint64, len(wrapper.endpoints))
+	errs := make([]error, len(wrapper.endpoints))
+
+	var wg sync.WaitGroup
+	wg.Add(len(wrapper.endpoints))
+	for idx, endpoint := range wrapper.endpoints {
+		go func(index int, endpointInstance *trackedEndpoint) {
+			defer wg.Done()
+
+			nonces[index], errs[index] = endpointInstance.statusGetter.getNetworkStatusNonce(ctx, wrapper.healthCheckShardID)
+		}(idx, endpoint)
+	}
+	wg.Wait()
+
+	wrapper.mut.Lock()
+	defer wrapper.mut.Unlock()
+
+	numFailed := 0
+	for idx, endpoint := range wrapper.endpoints {
+		endpoint.lastStatusErr = errs[idx]
+		if errs[idx] != nil {
+			numFailed++
+			log.Debug("multiEndpointClientWrapper: network status check failed", "url", endpoint.url, "error", errs[idx])
+			continue
+		}
+
+		endpoint.lastNonce = nonces[idx]
+		if endpoint.lastNonce > wrapper.highestNonce {
+			wrapper.highestNonce = endpoint.lastNonce
+		}
+	}
+
+	if numFailed == len(wrapper.endpoints) {
+		return ErrAllEndpointsUnhealthy
+	}
+
+	return nil
+}
+
+// GetEndpointsHealth returns a snapshot of the health of all the endpoints, sorted from the healthiest one
+func (wrapper *multiEndpointClientWrapper) GetEndpointsHealth() []EndpointHealth {
+	sorted := wrapper.sortedEndpoints()
+
+	wrapper.mut.RLock()
+	defer wrapper.mut.RUnlock()
+
+	result := make([]EndpointHealth, 0, len(sorted))
+	for _, endpoint := range sorted {
+		result = append(result, EndpointHealth{
+			URL:             endpoint.url,
+			AverageLatency:  time.Duration(endpoint.averageLatency),
+			ErrorRate:       endpoint.errorRate,
+			LastKnownNonce:  endpoint.lastNonce,
+			NonceLag:        wrapper.computeNonceLag(endpoint),
+			NumRequests:     endpoint.numRequests,
+			NumErrors:       endpoint.numErrors,
+			LastStatusError: endpoint.lastStatusErr,
+			IsUnhealthy:     wrapper.isUnhealthy(endpoint),
+			Score:           wrapper.computeScore(endpoint),
+		})
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (wrapper *multiEndpointClientWrapper) IsInterfaceNil() bool {
+	return wrapper == nil
+}
@@ -0,0 +1,282 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type endpointStatusGetterStub struct {
+	getNetworkStatusNonceCalled func(ctx context.Context, shardID uint32) (uint64, error)
+}
+
+func (stub *endpointStatusGetterStub) getNetworkStatusNonce(ctx context.Context, shardID uint32) (uint64, error) {
+	if stub.getNetworkStatusNonceCalled != nil {
+		return stub.getNetworkStatusNonceCalled(ctx, shardID)
+	}
+
+	return 0, nil
+}
+
+func createTrackedEndpointStub(url string, nonce uint64, getHandler func() ([]byte, int, error)) *trackedEndpoint {
+	return &trackedEndpoint{
+		url: url,
+		wrapper: &testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				return getHandler()
+			},
+			PostHTTPCalled: func(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+				return getHandler()
+			},
+		},
+		statusGetter: &endpointStatusGetterStub{
+			getNetworkStatusNonceCalled: func(ctx context.Context, shardID uint32) (uint64, error) {
+				return nonce, nil
+			},
+		},
+	}
+}
+
+func respondWith(buff []byte, code int, err error) func() ([]byte, int, error) {
+	return func() ([]byte, int, error) {
+		return buff, code, err
+	}
+}
+
+func TestNewMultiEndpointClientWrapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no endpoints should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := newMultiEndpointClientWrapper(nil, 0, 0)
+		assert.True(t, check.IfNil(wrapper))
+		assert.Equal(t, ErrNoProxyURLsProvided, err)
+	})
+	t.Run("nil wrapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := newMultiEndpointClientWrapper([]*trackedEndpoint{{url: "url"}}, 0, 0)
+		assert.True(t, check.IfNil(wrapper))
+		assert.True(t, errors.Is(err, ErrNilHTTPClientWrapper))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []*trackedEndpoint{createTrackedEndpointStub("url", 0, respondWith(nil, http.StatusOK, nil))}
+		wrapper, err := newMultiEndpointClientWrapper(endpoints, 0, 0)
+		assert.False(t, check.IfNil(wrapper))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMultiEndpointClientWrapper_GetHTTP(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("should fail over to the next endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []*trackedEndpoint{
+			createTrackedEndpointStub("url1", 0, respondWith(nil, http.StatusBadRequest, expectedErr)),
+			createTrackedEndpointStub("url2", 0, respondWith([]byte("response"), http.StatusOK, nil)),
+		}
+		wrapper, _ := newMultiEndpointClientWrapper(endpoints, 0, 0)
+
+		buff, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []byte("response"), buff)
+
+		health := wrapper.GetEndpointsHealth()
+		require.Equal(t, 2, len(health))
+		assert.Equal(t, "url2", health[0].URL)
+		assert.Equal(t, "url1", health[1].URL)
+		assert.Equal(t, uint64(1), health[1].NumErrors)
+	})
+	t.Run("should not fail over on client errors", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []*trackedEndpoint{
+			createTrackedEndpointStub("url1", 0, respondWith([]byte("not found"), http.StatusNotFound, nil)),
+			createTrackedEndpointStub("url2", 0, respondWith([]byte("response"), http.StatusOK, nil)),
+		}
+		wrapper, _ := newMultiEndpointClientWrapper(endpoints, 0, 0)
+
+		buff, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNotFound, code)
+		assert.Equal(t, []byte("not found"), buff)
+	})
+	t.Run("all endpoints fail should return the last error", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []*trackedEndpoint{
+			createTrackedEndpointStub("url1", 0, respondWith(nil, http.StatusBadGateway, nil)),
+			createTrackedEndpointStub("url2", 0, respondWith(nil, http.StatusBadRequest, expectedErr)),
+		}
+		wrapper, _ := newMultiEndpointClientWrapper(endpoints, 0, 0)
+
+		buff, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Nil(t, buff)
+	})
+}
+
+func TestMultiEndpointClientWrapper_PostHTTP(t *testing.T) {
+	t.Parallel()
+
+	createEndpoints := func() []*trackedEndpoint {
+		return []*trackedEndpoint{
+			createTrackedEndpointStub("url1", 0, respondWith(nil, http.StatusGatewayTimeout, nil)),
+			createTrackedEndpointStub("url2", 0, respondWith([]byte("response"), http.StatusOK, nil)),
+		}
+	}
+
+	t.Run("non-idempotent request without resend checker should not fail over", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, _ := newMultiEndpointClientWrapper(createEndpoints(), 0, 0)
+
+		buff, code, err := wrapper.PostHTTP(context.Background(), "endpoint", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, code)
+		assert.Nil(t, buff)
+	})
+	t.Run("idempotent request should fail over", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, _ := newMultiEndpointClientWrapper(createEndpoints(), 0, 0)
+
+		buff, code, err := wrapper.PostHTTP(sdkHttp.WithIdempotentRequest(context.Background()), "endpoint", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []byte("response"), buff)
+	})
+	t.Run("resend checker allowing the resend should fail over", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, _ := newMultiEndpointClientWrapper(createEndpoints(), 0, 0)
+		ctx := sdkHttp.WithResendChecker(context.Background(), func(ctx context.Context) (bool, []byte, error) {
+			return true, nil, nil
+		})
+
+		buff, code, err := wrapper.PostHTTP(ctx, "endpoint", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []byte("response"), buff)
+	})
+	t.Run("resend checker finding the request accepted should return its response", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, _ := newMultiEndpointClientWrapper(createEndpoints(), 0, 0)
+		ctx := sdkHttp.WithResendChecker(context.Background(), func(ctx context.Context) (bool, []byte, error) {
+			return false, []byte("accepted"), nil
+		})
+
+		buff, code, err := wrapper.PostHTTP(ctx, "endpoint", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []byte("accepted"), buff)
+	})
+	t.Run("resend checker error should not fail over", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, _ := newMultiEndpointClientWrapper(createEndpoints(), 0, 0)
+		ctx := sdkHttp.WithResendChecker(context.Background(), func(ctx context.Context) (bool, []byte, error) {
+			return true, nil, errors.New("expected error")
+		})
+
+		buff, code, err := wrapper.PostHTTP(ctx, "endpoint", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusGatewayTimeout, code)
+		assert.Nil(t, buff)
+	})
+}
+
+func TestMultiEndpointClientWrapper_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("lagging endpoint should be used last", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []*trackedEndpoint{
+			createTrackedEndpointStub("url1", 90, respondWith([]byte("url1"), http.StatusOK, nil)),
+			createTrackedEndpointStub("url2", 100, respondWith([]byte("url2"), http.StatusOK, nil)),
+		}
+		wrapper, _ := newMultiEndpointClientWrapper(endpoints, 0, 5)
+
+		err := wrapper.Execute(context.Background())
+		assert.Nil(t, err)
+
+		buff, _, _ := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, []byte("url2"), buff)
+
+		health := wrapper.GetEndpointsHealth()
+		assert.Equal(t, uint64(10), health[1].NonceLag)
+		assert.Equal(t, uint64(0), health[0].NonceLag)
+	})
+	t.Run("slower endpoint should be used last", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []*trackedEndpoint{
+			createTrackedEndpointStub("url1", 100, respondWith([]byte("url1"), http.StatusOK, nil)),
+			createTrackedEndpointStub("url2", 100, respondWith([]byte("url2"), http.StatusOK, nil)),
+		}
+		wrapper, _ := newMultiEndpointClientWrapper(endpoints, 0, 0)
+		wrapper.recordRequest(endpoints[0], time.Second, false)
+		wrapper.recordRequest(endpoints[1], time.Millisecond, false)
+
+		buff, _, _ := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, []byte("url2"), buff)
+	})
+	t.Run("unhealthy endpoints should be used last and sorted by their score", func(t *testing.T) {
+		t.Parallel()
+
+		endpoints := []*trackedEndpoint{
+			createTrackedEndpointStub("url1", 80, respondWith([]byte("url1"), http.StatusOK, nil)),
+			createTrackedEndpointStub("url2", 90, respondWith([]byte("url2"), http.StatusOK, nil)),
+			createTrackedEndpointStub("url3", 100, respondWith([]byte("url3"), http.StatusOK, nil)),
+		}
+		wrapper, _ := newMultiEndpointClientWrapper(endpoints, 0, 5)
+		wrapper.recordRequest(endpoints[2], time.Second*10, false)
+
+		err := wrapper.Execute(context.Background())
+		assert.Nil(t, err)
+
+		health := wrapper.GetEndpointsHealth()
+		require.Len(t, health, 3)
+		assert.Equal(t, "url3", health[0].URL)
+		assert.False(t, health[0].IsUnhealthy)
+		assert.Equal(t, "url2", health[1].URL)
+		assert.True(t, health[1].IsUnhealthy)
+		assert.Equal(t, "url1", health[2].URL)
+		assert.True(t, health[2].IsUnhealthy)
+		assert.Less(t, health[1].Score, health[2].Score)
+	})
+	t.Run("all endpoints failing should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		endpoint := createTrackedEndpointStub("url1", 0, respondWith(nil, http.StatusOK, nil))
+		endpoint.statusGetter = &endpointStatusGetterStub{
+			getNetworkStatusNonceCalled: func(ctx context.Context, shardID uint32) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		wrapper, _ := newMultiEndpointClientWrapper([]*trackedEndpoint{endpoint}, 0, 0)
+
+		err := wrapper.Execute(context.Background())
+		assert.Equal(t, ErrAllEndpointsUnhealthy, err)
+		assert.Equal(t, expectedErr, wrapper.GetEndpointsHealth()[0].LastStatusError)
+	})
+}
@@ -0,0 +1,172 @@
+package blockchain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/factory"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
+	"github.com/TerraDharitri/drt-go-sdk/core/polling"
+)
+
+const (
+	minimumHealthCheckInterval = time.Second
+	healthCheckerName          = "multi-endpoint proxy health checker"
+)
+
+// ArgsMultiEndpointProxy is the DTO used in the multi-endpoint dharitri proxy constructor
+type ArgsMultiEndpointProxy struct {
+	ProxyURLs              []string
+	Client                 sdkHttp.Client
+	SameScState            bool
+	ShouldBeSynced         bool
+	FinalityCheck          bool
+	AllowedDeltaToFinal    int
+	CacheExpirationTime    time.Duration
+	EntityType             sdkCore.RestAPIEntityType
+	FilterQueryBlockCacher BlockDataCache
+	HealthCheckInterval    time.Duration
+	HealthCheckShardID     uint32
+	MaxNonceLag            uint64
+	RetryPolicy            sdkHttp.RetryPolicy
+	// RequestLimiter, if set, is shared by all the endpoints. Its rate and concurrency limits apply to the requests
+	// towards all the endpoints, while the Retry-After delays only hold back the requests towards the asking endpoint
+	RequestLimiter sdkHttp.RequestLimiter
+	// MetricsHandler, if set, is shared by all the endpoints
+	MetricsHandler sdkCore.MetricsHandler
+	// Interceptors, if set, are called on the requests towards all the endpoints
+	Interceptors []sdkHttp.RequestInterceptor
+	// ResponseCacheConfig holds the settings of the response cache, shared by all the endpoints
+	ResponseCacheConfig ResponseCacheConfig
+}
+
+// multiEndpointProxy is a proxy implementation that works with a list of proxy or observer URLs. The requests are
+// dispatched towards the healthiest endpoint, failing over to the next ones in case of errors.
+// The Close method should be called whenever the instance is no longer used.
+type multiEndpointProxy struct {
+	*proxy
+	clientWrapper *multiEndpointClientWrapper
+	healthChecker pollingHandler
+}
+
+// NewMultiEndpointProxy initializes and returns a proxy object that works with multiple endpoints
+func NewMultiEndpointProxy(args ArgsMultiEndpointProxy) (*multiEndpointProxy, error) {
+	proxyArgs := ArgsProxy{
+		Client:                 args.Client,
+		SameScState:            args.SameScState,
+		ShouldBeSynced:         args.ShouldBeSynced,
+		FinalityCheck:          args.FinalityCheck,
+		AllowedDeltaToFinal:    args.AllowedDeltaToFinal,
+		CacheExpirationTime:    args.CacheExpirationTime,
+		EntityType:             args.EntityType,
+		FilterQueryBlockCacher: args.FilterQueryBlockCacher,
+		RetryPolicy:            args.RetryPolicy,
+		RequestLimiter:         args.RequestLimiter,
+		MetricsHandler:         args.MetricsHandler,
+		Interceptors:           args.Interceptors,
+		ResponseCacheConfig:    args.ResponseCacheConfig,
+	}
+	err := checkArgsMultiEndpointProxy(args, proxyArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	endpointProvider, err := factory.CreateEndpointProvider(args.EntityType)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := make([]*trackedEndpoint, 0, len(args.ProxyURLs))
+	for _, url := range args.ProxyURLs {
+		endpoint, errCreate := createTrackedEndpoint(args, url, endpointProvider)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+
+	clientWrapper, err := newMultiEndpointClientWrapper(endpoints, args.HealthCheckShardID, args.MaxNonceLag)
+	if err != nil {
+		return nil, err
+	}
+
+	proxyInstance, err := newProxyWithClientWrapper(proxyArgs, clientWrapper, endpointProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecker, err := polling.NewPollingHandler(polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             healthCheckerName,
+		PollingInterval:  args.HealthCheckInterval,
+		PollingWhenError: args.HealthCheckInterval,
+		Executor:         clientWrapper,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = healthChecker.StartProcessingLoop()
+	if err != nil {
+		return nil, err
+	}
+
+	return &multiEndpointProxy{
+		proxy:         proxyInstance,
+		clientWrapper: clientWrapper,
+		healthChecker: healthChecker,
+	}, nil
+}
+
+func checkArgsMultiEndpointProxy(args ArgsMultiEndpointProxy, proxyArgs ArgsProxy) error {
+	if len(args.ProxyURLs) == 0 {
+		return ErrNoProxyURLsProvided
+	}
+	if args.HealthCheckInterval < minimumHealthCheckInterval {
+		return fmt.Errorf("%w, provided: %v, minimum: %v", ErrInvalidHealthCheckInterval, args.HealthCheckInterval, minimumHealthCheckInterval)
+	}
+
+	return checkArgsProxy(proxyArgs)
+}
+
+func createTrackedEndpoint(args ArgsMultiEndpointProxy, url string, endpointProvider EndpointProvider) (*trackedEndpoint, error) {
+	clientWrapper := sdkHttp.NewHttpClientWrapperWithArgs(sdkHttp.ArgsHttpClientWrapper{
+		Client:         args.Client,
+		URL:            url,
+		RetryPolicy:    args.RetryPolicy,
+		RequestLimiter: args.RequestLimiter,
+		MetricsHandler: args.MetricsHandler,
+		Interceptors:   args.Interceptors,
+	})
+	statusGetter, err := newBaseProxy(argsBaseProxy{
+		httpClientWrapper: clientWrapper,
+		expirationTime:    args.CacheExpirationTime,
+		endpointProvider:  endpointProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &trackedEndpoint{
+		url:          url,
+		wrapper:      clientWrapper,
+		statusGetter: statusGetter,
+	}, nil
+}
+
+// GetEndpointsHealth returns a snapshot of the health of all the endpoints, sorted from the healthiest one
+func (ep *multiEndpointProxy) GetEndpointsHealth() []EndpointHealth {
+	return ep.clientWrapper.GetEndpointsHealth()
+}
+
+// Close stops the health checking loop
+func (ep *multiEndpointProxy) Close() error {
+	return ep.healthChecker.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ep *multiEndpointProxy) IsInterfaceNil() bool {
+	return ep == nil
+}
@@ -0,0 +1,115 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const secondTestHttpURL = "https://test2.org"
+
+func createMockArgsMultiEndpointProxy(httpClient *mockHTTPClient) ArgsMultiEndpointProxy {
+	return ArgsMultiEndpointProxy{
+		ProxyURLs:           []string{testHttpURL, secondTestHttpURL},
+		Client:              httpClient,
+		AllowedDeltaToFinal: 1,
+		CacheExpirationTime: time.Second,
+		EntityType:          sdkCore.Proxy,
+		HealthCheckInterval: time.Second,
+	}
+}
+
+func TestNewMultiEndpointProxy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no URLs should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiEndpointProxy(nil)
+		args.ProxyURLs = nil
+		proxyInstance, err := NewMultiEndpointProxy(args)
+
+		assert.True(t, check.IfNil(proxyInstance))
+		assert.Equal(t, ErrNoProxyURLsProvided, err)
+	})
+	t.Run("invalid health check interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiEndpointProxy(nil)
+		args.HealthCheckInterval = time.Second - time.Nanosecond
+		proxyInstance, err := NewMultiEndpointProxy(args)
+
+		assert.True(t, check.IfNil(proxyInstance))
+		assert.True(t, errors.Is(err, ErrInvalidHealthCheckInterval))
+	})
+	t.Run("invalid nonce delta should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiEndpointProxy(nil)
+		args.FinalityCheck = true
+		args.AllowedDeltaToFinal = 0
+		proxyInstance, err := NewMultiEndpointProxy(args)
+
+		assert.True(t, check.IfNil(proxyInstance))
+		assert.True(t, errors.Is(err, ErrInvalidAllowedDeltaToFinal))
+	})
+	t.Run("unknown entity type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiEndpointProxy(nil)
+		args.EntityType = "unknown"
+		proxyInstance, err := NewMultiEndpointProxy(args)
+
+		assert.True(t, check.IfNil(proxyInstance))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proxyInstance, err := NewMultiEndpointProxy(createMockArgsMultiEndpointProxy(nil))
+		assert.False(t, check.IfNil(proxyInstance))
+		assert.Nil(t, err)
+
+		assert.Nil(t, proxyInstance.Close())
+	})
+}
+
+func TestMultiEndpointProxy_GetNetworkEconomicsShouldFailOver(t *testing.T) {
+	t.Parallel()
+
+	responseBytes := []byte(`{"data":{"metrics":{"drt_dev_rewards":"0","drt_epoch_for_economics_data":263}},"code":"successful"}`)
+	httpClient := &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			if strings.HasPrefix(req.URL.String(), testHttpURL) {
+				return nil, errors.New("connection refused")
+			}
+
+			return createMockClientRespondingBytes(responseBytes).Do(req)
+		},
+	}
+
+	for _, entityType := range []sdkCore.RestAPIEntityType{sdkCore.Proxy, sdkCore.ObserverNode} {
+		args := createMockArgsMultiEndpointProxy(httpClient)
+		args.EntityType = entityType
+		proxyInstance, err := NewMultiEndpointProxy(args)
+		require.Nil(t, err)
+
+		networkEconomics, err := proxyInstance.GetNetworkEconomics(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint32(263), networkEconomics.EpochForEconomicsData)
+
+		health := proxyInstance.GetEndpointsHealth()
+		require.Equal(t, 2, len(health))
+		assert.Equal(t, secondTestHttpURL, health[0].URL)
+
+		_ = proxyInstance.Close()
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
@@ -25,7 +27,9 @@ import (
 
 const (
 	withResultsQueryParam = "?withResults=true"
+	checkSignatureParam   = "?checkSignature=%t"
 	withTxsAndLogs        = "?withTxs=true&withLogs=true"
+	withTxs               = "?withTxs=true"
 )
 
 var (
@@ -44,6 +48,11 @@ type ArgsProxy struct {
 	CacheExpirationTime    time.Duration
 	EntityType             sdkCore.RestAPIEntityType
 	FilterQueryBlockCacher BlockDataCache
+	RetryPolicy            sdkHttp.RetryPolicy
+	RequestLimiter         sdkHttp.RequestLimiter
+	MetricsHandler         sdkCore.MetricsHandler
+	Interceptors           []sdkHttp.RequestInterceptor
+	ResponseCacheConfig    ResponseCacheConfig
 }
 
 // proxy implements basic functions for interacting with a dharitri Proxy
@@ -55,6 +64,10 @@ type proxy struct {
 	allowedDeltaToFinal    int
 	finalityProvider       FinalityProvider
 	filterQueryBlockCacher BlockDataCache
+	responseCache          *responseCache
+
+	mutFinalNonces sync.RWMutex
+	finalNonces    map[uint32]uint64
 }
 
 // NewProxy initializes and returns a proxy object
@@ -69,7 +82,19 @@ func NewProxy(args ArgsProxy) (*proxy, error) {
 		return nil, err
 	}
 
-	clientWrapper := sdkHttp.NewHttpClientWrapper(args.Client, args.ProxyURL)
+	clientWrapper := sdkHttp.NewHttpClientWrapperWithArgs(sdkHttp.ArgsHttpClientWrapper{
+		Client:         args.Client,
+		URL:            args.ProxyURL,
+		RetryPolicy:    args.RetryPolicy,
+		RequestLimiter: args.RequestLimiter,
+		MetricsHandler: args.MetricsHandler,
+		Interceptors:   args.Interceptors,
+	})
+
+	return newProxyWithClientWrapper(args, clientWrapper, endpointProvider)
+}
+
+func newProxyWithClientWrapper(args ArgsProxy, clientWrapper httpClientWrapper, endpointProvider EndpointProvider) (*proxy, error) {
 	baseArgs := argsBaseProxy{
 		httpClientWrapper: clientWrapper,
 		expirationTime:    args.CacheExpirationTime,
@@ -90,6 +115,11 @@ func NewProxy(args ArgsProxy) (*proxy, error) {
 		cacher = &DisabledBlockDataCache{}
 	}
 
+	responseCacheInstance, err := newResponseCache(args.ResponseCacheConfig, args.MetricsHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	ep := &proxy{
 		baseProxy:              baseProxyInstance,
 		sameScState:            args.SameScState,
@@ -98,6 +128,8 @@ func NewProxy(args ArgsProxy) (*proxy, error) {
 		allowedDeltaToFinal:    args.AllowedDeltaToFinal,
 		finalityProvider:       finalityProvider,
 		filterQueryBlockCacher: cacher,
+		responseCache:          responseCacheInstance,
+		finalNonces:            make(map[uint32]uint64),
 	}
 
 	return ep, nil
@@ -114,9 +146,14 @@ func checkArgsProxy(args ArgsProxy) error {
 	return nil
 }
 
-// ExecuteVMQuery retrieves data from existing SC trie through the use of a VM
-func (ep *proxy) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
-	err := ep.checkFinalState(ctx, vmRequest.Address)
+// ExecuteVMQuery retrieves data from existing SC trie through the use of a VM. The query options can be used to
+// execute the query on a historical state
+func (ep *proxy) ExecuteVMQuery(
+	ctx context.Context,
+	vmRequest *data.VmValueRequest,
+	queryOptions api.AccountQueryOptions,
+) (*data.VmValuesResponseData, error) {
+	err := ep.checkFinalState(ctx, vmRequest.Address, queryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +168,8 @@ func (ep *proxy) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequ
 		return nil, err
 	}
 
-	buff, code, err := ep.PostHTTP(ctx, ep.endpointProvider.GetVmValues(), jsonVMRequest)
+	endpoint := sdkCore.BuildUrlWithAccountQueryOptions(ep.endpointProvider.GetVmValues(), queryOptions)
+	buff, code, err := ep.PostHTTP(sdkHttp.WithIdempotentRequest(ctx), endpoint, jsonVMRequest)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -148,10 +186,14 @@ func (ep *proxy) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequ
 	return &response.Data, nil
 }
 
-func (ep *proxy) checkFinalState(ctx context.Context, address string) error {
+func (ep *proxy) checkFinalState(ctx context.Context, address string, queryOptions api.AccountQueryOptions) error {
 	if !ep.finalityCheck {
 		return nil
 	}
+	if isHistoricalQuery(queryOptions) {
+		// the requested state is already in the past, the current finalization status of the shard is irrelevant
+		return nil
+	}
 
 	targetShardID, err := ep.GetShardOfAddress(ctx, address)
 	if err != nil {
@@ -161,9 +203,16 @@ func (ep *proxy) checkFinalState(ctx context.Context, address string) error {
 	return ep.finalityProvider.CheckShardFinalization(ctx, targetShardID, uint64(ep.allowedDeltaToFinal))
 }
 
+func isHistoricalQuery(queryOptions api.AccountQueryOptions) bool {
+	return queryOptions.BlockNonce.HasValue ||
+		len(queryOptions.BlockHash) > 0 ||
+		len(queryOptions.BlockRootHash) > 0 ||
+		queryOptions.OnStartOfEpoch.HasValue
+}
+
 // GetNetworkEconomics retrieves the network economics from the proxy
 func (ep *proxy) GetNetworkEconomics(ctx context.Context) (*data.NetworkEconomics, error) {
-	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetNetworkEconomics())
+	buff, code, err := ep.getCachedHTTP(ctx, ep.endpointProvider.GetNetworkEconomics(), false)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -193,7 +242,7 @@ func (ep *proxy) GetDefaultTransactionArguments(
 		return transaction.FrontendTransaction{}, "", ErrNilAddress
 	}
 
-	account, err := ep.GetAccount(ctx, address)
+	account, err := ep.GetAccount(ctx, address, api.AccountQueryOptions{})
 	if err != nil {
 		return transaction.FrontendTransaction{}, "", err
 	}
@@ -218,8 +267,13 @@ func (ep *proxy) GetDefaultTransactionArguments(
 	}, account.Balance, nil
 }
 
-// GetAccount retrieves an account info from the network (nonce, balance)
-func (ep *proxy) GetAccount(ctx context.Context, address sdkCore.AddressHandler) (*data.Account, error) {
+// GetAccount retrieves an account info from the network (nonce, balance). The query options can be used to read the
+// account state at a given block
+func (ep *proxy) GetAccount(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.Account, error) {
 	if check.IfNil(address) {
 		return nil, ErrNilAddress
 	}
@@ -232,12 +286,13 @@ func (ep *proxy) GetAccount(ctx context.Context, address sdkCore.AddressHandler)
 		return nil, err
 	}
 
-	err = ep.checkFinalState(ctx, addressAsBech32)
+	err = ep.checkFinalState(ctx, addressAsBech32, queryOptions)
 	if err != nil {
 		return nil, err
 	}
 
 	endpoint := ep.endpointProvider.GetAccount(addressAsBech32)
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
 
 	buff, code, err := ep.GetHTTP(ctx, endpoint)
 	if err != nil || code != http.StatusOK {
@@ -252,6 +307,9 @@ func (ep *proxy) GetAccount(ctx context.Context, address sdkCore.AddressHandler)
 	if response.Error != "" {
 		return nil, errors.New(response.Error)
 	}
+	if response.Data.Account != nil {
+		response.Data.Account.BlockInfo = response.Data.BlockInfo
+	}
 
 	return response.Data.Account, nil
 }
@@ -262,6 +320,7 @@ func (ep *proxy) SendTransaction(ctx context.Context, tx *transaction.FrontendTr
 	if err != nil {
 		return "", err
 	}
+	ctx = ep.withSendTransactionsResendChecker(ctx, []*transaction.FrontendTransaction{tx}, createSendTransactionResponse)
 	buff, code, err := ep.PostHTTP(ctx, ep.endpointProvider.GetSendTransaction(), jsonTx)
 	if err != nil {
 		return "", createHTTPStatusError(code, err)
@@ -285,6 +344,7 @@ func (ep *proxy) SendTransactions(ctx context.Context, txs []*transaction.Fronte
 	if err != nil {
 		return nil, err
 	}
+	ctx = ep.withSendTransactionsResendChecker(ctx, txs, createSendTransactionsResponse)
 	buff, code, err := ep.PostHTTP(ctx, ep.endpointProvider.GetSendMultipleTransactions(), jsonTx)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
@@ -379,7 +439,7 @@ func (ep *proxy) RequestTransactionCost(ctx context.Context, tx *transaction.Fro
 	if err != nil {
 		return nil, err
 	}
-	buff, code, err := ep.PostHTTP(ctx, ep.endpointProvider.GetCostTransaction(), jsonTx)
+	buff, code, err := ep.PostHTTP(sdkHttp.WithIdempotentRequest(ctx), ep.endpointProvider.GetCostTransaction(), jsonTx)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -396,6 +456,121 @@ func (ep *proxy) RequestTransactionCost(ctx context.Context, tx *transaction.Fro
 	return &response.Data, nil
 }
 
+// SimulateTransaction executes the provided transaction on the current state of the network, without broadcasting it.
+// The signature is verified only if checkSignature is set. Cross-shard transactions are simulated on both the sender
+// and the receiver shards
+func (ep *proxy) SimulateTransaction(
+	ctx context.Context,
+	tx *transaction.FrontendTransaction,
+	checkSignature bool,
+) (*data.TransactionSimulation, error) {
+	jsonTx, err := json.Marshal(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetSimulateTransaction() + fmt.Sprintf(checkSignatureParam, checkSignature)
+	buff, code, err := ep.PostHTTP(sdkHttp.WithIdempotentRequest(ctx), endpoint, jsonTx)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.TransactionSimulationResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	return &response.Data.Result, nil
+}
+
+// GetTransactionsPoolForSender returns the transactions of a sender that are waiting in the transactions pool
+func (ep *proxy) GetTransactionsPoolForSender(ctx context.Context, address sdkCore.AddressHandler) ([]*data.PoolTransaction, error) {
+	bech32Address, err := ep.checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetTransactionsPoolForSender(bech32Address))
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.TransactionsPoolForSenderResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	transactions := make([]*data.PoolTransaction, 0, len(response.Data.TxPool.Transactions))
+	for idx := range response.Data.TxPool.Transactions {
+		transactions = append(transactions, &response.Data.TxPool.Transactions[idx].TxFields)
+	}
+
+	return transactions, nil
+}
+
+// GetLastPoolNonceForSender returns the highest nonce of the transactions of a sender that are waiting in the
+// transactions pool
+func (ep *proxy) GetLastPoolNonceForSender(ctx context.Context, address sdkCore.AddressHandler) (uint64, error) {
+	bech32Address, err := ep.checkAccountAddress(address)
+	if err != nil {
+		return 0, err
+	}
+
+	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetLastPoolNonceForSender(bech32Address))
+	if err != nil || code != http.StatusOK {
+		return 0, createHTTPStatusError(code, err)
+	}
+
+	response := &data.LastPoolNonceForSenderResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return 0, err
+	}
+	if response.Error != "" {
+		return 0, errors.New(response.Error)
+	}
+
+	return response.Data.Nonce, nil
+}
+
+// GetTransactionsPoolNonceGapsForSender returns the nonce ranges missing from the transactions pool of a sender. The
+// transactions with nonces after a gap can not be executed until the gap is filled
+func (ep *proxy) GetTransactionsPoolNonceGapsForSender(ctx context.Context, address sdkCore.AddressHandler) ([]data.NonceGap, error) {
+	bech32Address, err := ep.checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetTransactionsPoolNonceGapsForSender(bech32Address))
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.TransactionsPoolNonceGapsForSenderResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	gaps := response.Data.NonceGaps.Gaps
+	if gaps == nil {
+		gaps = make([]data.NonceGap, 0)
+	}
+
+	return gaps, nil
+}
+
 // GetLatestHyperBlockNonce retrieves the latest hyper block (metachain) nonce from the network
 func (ep *proxy) GetLatestHyperBlockNonce(ctx context.Context) (uint64, error) {
 	response, err := ep.GetNetworkStatus(ctx, core.MetachainShardId)
@@ -406,14 +581,16 @@ func (ep *proxy) GetLatestHyperBlockNonce(ctx context.Context) (uint64, error) {
 	return response.Nonce, nil
 }
 
-// GetHyperBlockByNonce retrieves a hyper block's info by nonce from the network
+// GetHyperBlockByNonce retrieves a hyper block's info by nonce from the network. The fetched hyper block is cached
+// both by its nonce and by its hash
 func (ep *proxy) GetHyperBlockByNonce(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
 	endpoint := ep.endpointProvider.GetHyperBlockByNonce(nonce)
 
 	return ep.getHyperBlock(ctx, endpoint)
 }
 
-// GetHyperBlockByHash retrieves a hyper block's info by hash from the network
+// GetHyperBlockByHash retrieves a hyper block's info by hash from the network. The fetched hyper block is cached both
+// by its nonce and by its hash
 func (ep *proxy) GetHyperBlockByHash(ctx context.Context, hash string) (*data.HyperBlock, error) {
 	endpoint := ep.endpointProvider.GetHyperBlockByHash(hash)
 
@@ -421,13 +598,19 @@ func (ep *proxy) GetHyperBlockByHash(ctx context.Context, hash string) (*data.Hy
 }
 
 func (ep *proxy) getHyperBlock(ctx context.Context, endpoint string) (*data.HyperBlock, error) {
-	buff, code, err := ep.GetHTTP(ctx, endpoint)
-	if err != nil || code != http.StatusOK {
-		return nil, createHTTPStatusError(code, err)
+	// the final hyper blocks are cached as immutable responses, the other ones only if their class has a TTL
+	buff, isCached := ep.responseCache.get(endpoint, true)
+	if !isCached {
+		var code int
+		var err error
+		buff, code, err = ep.GetHTTP(ctx, endpoint)
+		if err != nil || code != http.StatusOK {
+			return nil, createHTTPStatusError(code, err)
+		}
 	}
 
 	response := &data.HyperBlockResponse{}
-	err = json.Unmarshal(buff, response)
+	err := json.Unmarshal(buff, response)
 	if err != nil {
 		return nil, err
 	}
@@ -435,32 +618,47 @@ func (ep *proxy) getHyperBlock(ctx context.Context, endpoint string) (*data.Hype
 		return nil, errors.New(response.Error)
 	}
 
+	if !isCached {
+		ep.cacheHyperBlock(ctx, &response.Data.HyperBlock, buff)
+	}
+
 	return &response.Data.HyperBlock, nil
 }
 
+// cacheHyperBlock caches the hyper block by its nonce and by its hash. The transactions statuses of a hyper block can
+// still change until it is final, so only the final hyper blocks are cached as immutable responses
+func (ep *proxy) cacheHyperBlock(ctx context.Context, hyperBlock *data.HyperBlock, buff []byte) {
+	isFinal := ep.isFinalBlock(ctx, core.MetachainShardId, hyperBlock.Nonce)
+
+	ep.responseCache.put(ep.endpointProvider.GetHyperBlockByNonce(hyperBlock.Nonce), buff, isFinal)
+	if len(hyperBlock.Hash) > 0 {
+		ep.responseCache.put(ep.endpointProvider.GetHyperBlockByHash(hyperBlock.Hash), buff, isFinal)
+	}
+}
+
 // GetRawBlockByHash retrieves a raw block by hash from the network
 func (ep *proxy) GetRawBlockByHash(ctx context.Context, shardId uint32, hash string) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetRawBlockByHash(shardId, hash)
 
-	return ep.getRawBlock(ctx, endpoint)
+	return ep.getRawBlock(ctx, endpoint, true)
 }
 
 // GetRawBlockByNonce retrieves a raw block by hash from the network
 func (ep *proxy) GetRawBlockByNonce(ctx context.Context, shardId uint32, nonce uint64) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetRawBlockByNonce(shardId, nonce)
 
-	return ep.getRawBlock(ctx, endpoint)
+	return ep.getRawBlock(ctx, endpoint, false)
 }
 
 // GetRawStartOfEpochMetaBlock retrieves a raw block by hash from the network
 func (ep *proxy) GetRawStartOfEpochMetaBlock(ctx context.Context, epoch uint32) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetRawStartOfEpochMetaBlock(epoch)
 
-	return ep.getRawBlock(ctx, endpoint)
+	return ep.getRawBlock(ctx, endpoint, true)
 }
 
-func (ep *proxy) getRawBlock(ctx context.Context, endpoint string) ([]byte, error) {
-	buff, code, err := ep.GetHTTP(ctx, endpoint)
+func (ep *proxy) getRawBlock(ctx context.Context, endpoint string, isImmutable bool) ([]byte, error) {
+	buff, code, err := ep.getCachedHTTP(ctx, endpoint, isImmutable)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -485,7 +683,7 @@ func (ep *proxy) GetRawMiniBlockByHash(ctx context.Context, shardId uint32, hash
 }
 
 func (ep *proxy) getRawMiniBlock(ctx context.Context, endpoint string) ([]byte, error) {
-	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	buff, code, err := ep.getCachedHTTP(ctx, endpoint, true)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -514,7 +712,7 @@ func (ep *proxy) GetNonceAtEpochStart(ctx context.Context, shardId uint32) (uint
 
 // GetRatingsConfig retrieves the ratings configuration from the proxy
 func (ep *proxy) GetRatingsConfig(ctx context.Context) (*data.RatingsConfig, error) {
-	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetRatingsConfig())
+	buff, code, err := ep.getCachedHTTP(ctx, ep.endpointProvider.GetRatingsConfig(), false)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -533,7 +731,7 @@ func (ep *proxy) GetRatingsConfig(ctx context.Context) (*data.RatingsConfig, err
 
 // GetEnableEpochsConfig retrieves the ratings configuration from the proxy
 func (ep *proxy) GetEnableEpochsConfig(ctx context.Context) (*data.EnableEpochsConfig, error) {
-	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetEnableEpochsConfig())
+	buff, code, err := ep.getCachedHTTP(ctx, ep.endpointProvider.GetEnableEpochsConfig(), false)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -552,7 +750,7 @@ func (ep *proxy) GetEnableEpochsConfig(ctx context.Context) (*data.EnableEpochsC
 
 // GetGenesisNodesPubKeys retrieves genesis nodes configuration from proxy
 func (ep *proxy) GetGenesisNodesPubKeys(ctx context.Context) (*data.GenesisNodes, error) {
-	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetGenesisNodesConfig())
+	buff, code, err := ep.getCachedHTTP(ctx, ep.endpointProvider.GetGenesisNodesConfig(), true)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
 	}
@@ -593,7 +791,7 @@ func (ep *proxy) GetDCDTTokenData(
 	ctx context.Context,
 	address sdkCore.AddressHandler,
 	tokenIdentifier string,
-	queryOptions api.AccountQueryOptions, // TODO: provide AccountQueryOptions on all accounts-related getters
+	queryOptions api.AccountQueryOptions,
 ) (*data.DCDTFungibleTokenData, error) {
 	if check.IfNil(address) {
 		return nil, ErrNilAddress
@@ -622,6 +820,9 @@ func (ep *proxy) GetDCDTTokenData(
 	if response.Error != "" {
 		return nil, errors.New(response.Error)
 	}
+	if response.Data.TokenData != nil {
+		response.Data.TokenData.BlockInfo = response.Data.BlockInfo
+	}
 
 	return response.Data.TokenData, nil
 }
@@ -632,7 +833,7 @@ func (ep *proxy) GetNFTTokenData(
 	address sdkCore.AddressHandler,
 	tokenIdentifier string,
 	nonce uint64,
-	queryOptions api.AccountQueryOptions, // TODO: provide AccountQueryOptions on all accounts-related getters
+	queryOptions api.AccountQueryOptions,
 ) (*data.DCDTNFTTokenData, error) {
 	if check.IfNil(address) {
 		return nil, ErrNilAddress
@@ -661,12 +862,161 @@ func (ep *proxy) GetNFTTokenData(
 	if response.Error != "" {
 		return nil, errors.New(response.Error)
 	}
+	if response.Data.TokenData != nil {
+		response.Data.TokenData.BlockInfo = response.Data.BlockInfo
+	}
 
 	return response.Data.TokenData, nil
 }
 
-// GetGuardianData retrieves guardian data from proxy
-func (ep *proxy) GetGuardianData(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error) {
+// GetAllDCDTTokens returns all the fungible tokens held by the address, sorted by token identifier. The node returns
+// the whole list in a single response
+func (ep *proxy) GetAllDCDTTokens(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) ([]*data.DCDTFungibleTokenData, error) {
+	tokens, blockInfo, err := ep.getAllTokens(ctx, address, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	fungibleTokens := make([]*data.DCDTFungibleTokenData, 0, len(tokens))
+	for _, token := range tokens {
+		if token.Nonce != 0 {
+			continue
+		}
+
+		fungibleTokens = append(fungibleTokens, &data.DCDTFungibleTokenData{
+			TokenIdentifier: token.TokenIdentifier,
+			Balance:         token.Balance,
+			Properties:      token.Properties,
+			BlockInfo:       blockInfo,
+		})
+	}
+
+	return fungibleTokens, nil
+}
+
+// GetAllNFTTokens returns all the NFT, SFT and MetaDCDT tokens held by the address, sorted by token identifier. The
+// node returns the whole list in a single response
+func (ep *proxy) GetAllNFTTokens(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) ([]*data.DCDTNFTTokenData, error) {
+	tokens, _, err := ep.getAllTokens(ctx, address, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	nftTokens := make([]*data.DCDTNFTTokenData, 0, len(tokens))
+	for _, token := range tokens {
+		if token.Nonce == 0 {
+			continue
+		}
+
+		nftTokens = append(nftTokens, token)
+	}
+
+	return nftTokens, nil
+}
+
+func (ep *proxy) getAllTokens(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) ([]*data.DCDTNFTTokenData, api.BlockInfo, error) {
+	bech32Address, err := ep.checkAccountAddress(address)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	endpoint := ep.endpointProvider.GetAllDCDTTokens(bech32Address)
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, api.BlockInfo{}, createHTTPStatusError(code, err)
+	}
+
+	response := &data.AllDCDTTokensResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+	if response.Error != "" {
+		return nil, api.BlockInfo{}, errors.New(response.Error)
+	}
+
+	tokens := make([]*data.DCDTNFTTokenData, 0, len(response.Data.Tokens))
+	for key, token := range response.Data.Tokens {
+		if token == nil {
+			continue
+		}
+		if len(token.TokenIdentifier) == 0 {
+			token.TokenIdentifier = key
+		}
+
+		token.BlockInfo = response.Data.BlockInfo
+		tokens = append(tokens, token)
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		if tokens[i].TokenIdentifier == tokens[j].TokenIdentifier {
+			return tokens[i].Nonce < tokens[j].Nonce
+		}
+
+		return tokens[i].TokenIdentifier < tokens[j].TokenIdentifier
+	})
+
+	return tokens, response.Data.BlockInfo, nil
+}
+
+// GetDCDTRoles returns all the special roles held by the address, indexed by token identifier
+func (ep *proxy) GetDCDTRoles(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.DCDTRoles, error) {
+	bech32Address, err := ep.checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetDCDTRoles(bech32Address)
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.DCDTRolesResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	roles := &data.DCDTRoles{
+		Roles:     response.Data.Roles,
+		BlockInfo: response.Data.BlockInfo,
+	}
+	if roles.Roles == nil {
+		roles.Roles = make(map[string][]string)
+	}
+
+	return roles, nil
+}
+
+// GetGuardianData retrieves guardian data from proxy. The query options can be used to read the guardian data at a
+// given block
+func (ep *proxy) GetGuardianData(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.GuardianData, error) {
 	if check.IfNil(address) {
 		return nil, ErrNilAddress
 	}
@@ -678,12 +1028,13 @@ func (ep *proxy) GetGuardianData(ctx context.Context, address sdkCore.AddressHan
 		return nil, err
 	}
 
-	err = ep.checkFinalState(ctx, bech32Address)
+	err = ep.checkFinalState(ctx, bech32Address, queryOptions)
 	if err != nil {
 		return nil, err
 	}
 
 	endpoint := ep.endpointProvider.GetGuardianData(bech32Address)
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
 	buff, code, err := ep.GetHTTP(ctx, endpoint)
 	if err != nil || code != http.StatusOK {
 		return nil, createHTTPStatusError(code, err)
@@ -698,11 +1049,23 @@ func (ep *proxy) GetGuardianData(ctx context.Context, address sdkCore.AddressHan
 		return nil, errors.New(response.Error)
 	}
 
-	return response.Data.GuardianData, nil
+	guardianData := &data.GuardianData{
+		BlockInfo: response.Data.BlockInfo,
+	}
+	if response.Data.GuardianData != nil {
+		guardianData.GuardianData = *response.Data.GuardianData
+	}
+
+	return guardianData, nil
 }
 
-// IsDataTrieMigrated returns true if the data trie of the given account is migrated
-func (ep *proxy) IsDataTrieMigrated(ctx context.Context, address sdkCore.AddressHandler) (bool, error) {
+// IsDataTrieMigrated returns true if the data trie of the given account is migrated. The query options can be used to
+// check the account state at a given block
+func (ep *proxy) IsDataTrieMigrated(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (bool, error) {
 	if check.IfNil(address) {
 		return false, ErrNilAddress
 	}
@@ -712,7 +1075,14 @@ func (ep *proxy) IsDataTrieMigrated(ctx context.Context, address sdkCore.Address
 		return false, err
 	}
 
-	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.IsDataTrieMigrated(bech32Address))
+	err = ep.checkFinalState(ctx, bech32Address, queryOptions)
+	if err != nil {
+		return false, err
+	}
+
+	endpoint := ep.endpointProvider.IsDataTrieMigrated(bech32Address)
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
 	if err != nil || code != http.StatusOK {
 		return false, createHTTPStatusError(code, err)
 	}
@@ -734,6 +1104,317 @@ func (ep *proxy) IsDataTrieMigrated(ctx context.Context, address sdkCore.Address
 	return isMigrated, nil
 }
 
+// GetAccountStorageValue returns the value stored under the provided key in the account's storage. The storage keys
+// of the smart contracts' mappers can be created with the builders.StorageKeyBuilder
+func (ep *proxy) GetAccountStorageValue(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	key []byte,
+	queryOptions api.AccountQueryOptions,
+) (*data.AccountStorageValue, error) {
+	if len(key) == 0 {
+		return nil, ErrEmptyStorageKey
+	}
+
+	bech32Address, err := ep.checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetAccountStorageValue(bech32Address, hex.EncodeToString(key))
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.AccountStorageValueResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	value, err := hex.DecodeString(response.Data.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.AccountStorageValue{
+		Key:       key,
+		Value:     value,
+		BlockInfo: response.Data.BlockInfo,
+	}, nil
+}
+
+// GetAccountStorageKeys returns all the key-value pairs from the account's storage
+func (ep *proxy) GetAccountStorageKeys(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	queryOptions api.AccountQueryOptions,
+) (*data.AccountStorage, error) {
+	bech32Address, err := ep.checkAccountAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := ep.endpointProvider.GetAccountStorageKeys(bech32Address)
+	endpoint = sdkCore.BuildUrlWithAccountQueryOptions(endpoint, queryOptions)
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.AccountStorageKeysResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	storage := &data.AccountStorage{
+		Pairs:     make(map[string][]byte, len(response.Data.Pairs)),
+		BlockInfo: response.Data.BlockInfo,
+	}
+	for hexKey, hexValue := range response.Data.Pairs {
+		key, errDecode := hex.DecodeString(hexKey)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for key %s", errDecode, hexKey)
+		}
+		value, errDecode := hex.DecodeString(hexValue)
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for the value of key %s", errDecode, hexKey)
+		}
+
+		storage.Pairs[string(key)] = value
+	}
+
+	return storage, nil
+}
+
+func (ep *proxy) checkAccountAddress(address sdkCore.AddressHandler) (string, error) {
+	if check.IfNil(address) {
+		return "", ErrNilAddress
+	}
+	if !address.IsValid() {
+		return "", ErrInvalidAddress
+	}
+
+	return address.AddressAsBech32String()
+}
+
+// BlockQueryOptions holds the optional parts of a shard block that can be requested
+type BlockQueryOptions struct {
+	// WithTransactions includes the transactions of the block's miniblocks
+	WithTransactions bool
+	// WithLogs includes the logs of the transactions. It has effect only along with WithTransactions
+	WithLogs bool
+}
+
+func (options BlockQueryOptions) isFullBlock() bool {
+	return options.WithTransactions && options.WithLogs
+}
+
+func (options BlockQueryOptions) queryParams() string {
+	if options.isFullBlock() {
+		return withTxsAndLogs
+	}
+	if options.WithTransactions {
+		return withTxs
+	}
+
+	return ""
+}
+
+// cacheKey returns the key under which the block is cached. The blocks with transactions and logs share the keys
+// used by the logs filtering, while the partial blocks have the options appended to the key
+func (options BlockQueryOptions) cacheKey(shardID uint32, nonce uint64) []byte {
+	cacheKey := createBlockCacheKey(shardID, nonce)
+	if options.isFullBlock() {
+		return cacheKey
+	}
+	if options.WithTransactions {
+		return append(cacheKey, 1)
+	}
+
+	return append(cacheKey, 0)
+}
+
+// GetBlockByNonce retrieves the block of a shard by its nonce. The options select if the transactions and their logs
+// are included. The blocks are served from the block data cache when available and only the final blocks are cached.
+func (ep *proxy) GetBlockByNonce(ctx context.Context, shardID uint32, nonce uint64, options BlockQueryOptions) (*api.Block, error) {
+	block, found := ep.getCachedBlock(shardID, nonce, options)
+	if found {
+		return block, nil
+	}
+
+	endpoint := ep.endpointProvider.GetBlockByNonce(shardID, nonce) + options.queryParams()
+
+	return ep.fetchBlock(ctx, shardID, endpoint, options)
+}
+
+// GetBlockByHash retrieves the block of a shard by its hex encoded hash. The options select if the transactions and
+// their logs are included. The fetched block, if final, is stored in the block data cache, so it will be served from the
+// cache when requested by nonce.
+func (ep *proxy) GetBlockByHash(ctx context.Context, shardID uint32, hash string, options BlockQueryOptions) (*api.Block, error) {
+	if len(hash) == 0 {
+		return nil, ErrEmptyBlockHash
+	}
+
+	endpoint := ep.endpointProvider.GetBlockByHash(shardID, hash) + options.queryParams()
+
+	return ep.fetchBlock(ctx, shardID, endpoint, options)
+}
+
+func (ep *proxy) getCachedBlock(shardID uint32, nonce uint64, options BlockQueryOptions) (*api.Block, bool) {
+	block, found := ep.getCachedBlockByKey(options.cacheKey(shardID, nonce))
+	if found || options.isFullBlock() {
+		return block, found
+	}
+
+	// a cached full block can serve any partial request
+	block, found = ep.getCachedBlockByKey(createBlockCacheKey(shardID, nonce))
+	if !found {
+		return nil, false
+	}
+
+	for _, miniBlock := range block.MiniBlocks {
+		if !options.WithTransactions {
+			miniBlock.Transactions = nil
+			continue
+		}
+		for _, tx := range miniBlock.Transactions {
+			tx.Logs = nil
+		}
+	}
+
+	return block, true
+}
+
+func (ep *proxy) getCachedBlockByKey(cacheKey []byte) (*api.Block, bool) {
+	cachedResponse, found := ep.filterQueryBlockCacher.Get(cacheKey)
+	if !found {
+		return nil, false
+	}
+	buff, ok := cachedResponse.([]byte)
+	if !ok {
+		return nil, false
+	}
+
+	response := &data.BlockResponse{}
+	err := json.Unmarshal(buff, response)
+	if err != nil || response.Data.Block == nil {
+		return nil, false
+	}
+
+	return response.Data.Block, true
+}
+
+func (ep *proxy) fetchBlock(ctx context.Context, shardID uint32, endpoint string, options BlockQueryOptions) (*api.Block, error) {
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err != nil || code != http.StatusOK {
+		return nil, createHTTPStatusError(code, err)
+	}
+
+	response := &data.BlockResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+	if response.Data.Block == nil {
+		return nil, ErrNilBlock
+	}
+
+	ep.putFinalBlock(ctx, shardID, response.Data.Block.Nonce, options.cacheKey(shardID, response.Data.Block.Nonce), buff)
+
+	return response.Data.Block, nil
+}
+
+// putFinalBlock stores the block bytes in the block data cache only if the block is final, since the blocks above the
+// final nonce can still be reverted
+func (ep *proxy) putFinalBlock(ctx context.Context, shardID uint32, nonce uint64, cacheKey []byte, buff []byte) {
+	if len(buff) == 0 || !ep.isFinalBlock(ctx, shardID, nonce) {
+		return
+	}
+
+	ep.filterQueryBlockCacher.Put(cacheKey, buff, len(buff))
+}
+
+// isFinalBlock returns true if the block nonce is at most the shard's nonce minus the allowed delta to final
+func (ep *proxy) isFinalBlock(ctx context.Context, shardID uint32, nonce uint64) bool {
+	finalNonce, found := ep.getFinalNonce(ctx, shardID, nonce)
+
+	return found && nonce <= finalNonce
+}
+
+// getFinalNonce returns the final nonce of the shard. The latest final nonce of each shard is remembered, so the network
+// status is fetched only if the remembered one is below the provided nonce. Returns false if the final nonce is unknown
+func (ep *proxy) getFinalNonce(ctx context.Context, shardID uint32, nonce uint64) (uint64, bool) {
+	finalNonce, found := ep.getRememberedFinalNonce(shardID)
+	if found && nonce <= finalNonce {
+		return finalNonce, true
+	}
+
+	status, err := ep.GetNetworkStatus(ctx, shardID)
+	if err != nil {
+		log.Debug("proxy.getFinalNonce: can not get the network status, the blocks will not be cached",
+			"shard", shardID, "nonce", nonce, "error", err)
+		return finalNonce, found
+	}
+
+	return ep.computeFinalNonce(ctx, shardID, status.Nonce, nonce)
+}
+
+// computeFinalNonce returns the final nonce of the shard, derived from its provided latest nonce, if the remembered one
+// is below the provided nonce. Meant to be called once for a range of blocks ending with the provided nonce, instead of
+// checking each block of the range. Returns false if the final nonce is unknown
+func (ep *proxy) computeFinalNonce(ctx context.Context, shardID uint32, latestNonce uint64, nonce uint64) (uint64, bool) {
+	finalNonce, found := ep.getRememberedFinalNonce(shardID)
+	if found && nonce <= finalNonce {
+		return finalNonce, true
+	}
+
+	allowedDeltaToFinal := uint64(sdkCore.MinAllowedDeltaToFinal)
+	if ep.allowedDeltaToFinal > sdkCore.MinAllowedDeltaToFinal {
+		allowedDeltaToFinal = uint64(ep.allowedDeltaToFinal)
+	}
+
+	err := ep.finalityProvider.CheckShardFinalization(ctx, shardID, allowedDeltaToFinal)
+	if err != nil {
+		log.Debug("proxy.computeFinalNonce: shard is not final, the blocks will not be cached",
+			"shard", shardID, "nonce", nonce, "error", err)
+		return finalNonce, found
+	}
+	if latestNonce < allowedDeltaToFinal {
+		return finalNonce, found
+	}
+
+	ep.mutFinalNonces.Lock()
+	defer ep.mutFinalNonces.Unlock()
+
+	if latestNonce-allowedDeltaToFinal > ep.finalNonces[shardID] {
+		ep.finalNonces[shardID] = latestNonce - allowedDeltaToFinal
+	}
+
+	return ep.finalNonces[shardID], true
+}
+
+func (ep *proxy) getRememberedFinalNonce(shardID uint32) (uint64, bool) {
+	ep.mutFinalNonces.RLock()
+	defer ep.mutFinalNonces.RUnlock()
+
+	finalNonce, found := ep.finalNonces[shardID]
+
+	return finalNonce, found
+}
+
 // GetBlockBytesByNonce retrieves bytes of a block with its transactions and logs by nonce
 func (ep *proxy) getBlockBytesByNonceWithTxsAndLogs(ctx context.Context, shardID uint32, nonce uint64) ([]byte, error) {
 	endpoint := ep.endpointProvider.GetBlockByNonce(shardID, nonce)
@@ -756,26 +1437,93 @@ func (ep *proxy) getBlockBytesByHashWithTxsAndLogs(ctx context.Context, shardID
 	return buff, nil
 }
 
-// FilterLogs retrieves logs from the network and filters them based on the provided filter
+// FilterLogs retrieves logs from the network and filters them based on the provided filter. If the filter addresses
+// belong to multiple shards, or all shards are requested, the logs are gathered from each involved shard and returned
+// in a deterministic order: shard, block nonce, transaction index and event index. The same block range is applied on
+// each queried shard, with the end of the range capped to the shard's latest block. The shards whose latest block is
+// below the start of the range do not return any events.
 func (ep *proxy) FilterLogs(ctx context.Context, filter *sdkCore.FilterQuery) ([]*transaction.Events, error) {
-	shardID, err := ep.computeShardId(ctx, filter)
+	taggedEvents, err := ep.FilterTaggedLogs(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
+	matchingEvents := make([]*transaction.Events, 0, len(taggedEvents))
+	for _, taggedEvent := range taggedEvents {
+		matchingEvents = append(matchingEvents, taggedEvent.Event)
+	}
+
+	return matchingEvents, nil
+}
+
+// FilterTaggedLogs works as FilterLogs, but each returned event is tagged with the shard, block and transaction
+// that generated it
+func (ep *proxy) FilterTaggedLogs(ctx context.Context, filter *sdkCore.FilterQuery) ([]*data.TaggedEvent, error) {
+	shardIDs, err := ep.computeShardIDs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(shardIDs) > 1 && filter.BlockHash != nil {
+		return nil, ErrBlockHashWithMultipleShards
+	}
+
+	results := make([][]*data.TaggedEvent, len(shardIDs))
+	errs := make([]error, len(shardIDs))
+	var wg sync.WaitGroup
+	wg.Add(len(shardIDs))
+	for idx, shardID := range shardIDs {
+		go func(index int, shard uint32) {
+			defer wg.Done()
+
+			results[index], errs[index] = ep.filterShardLogs(ctx, filter, shard, len(shardIDs) > 1)
+		}(idx, shardID)
+	}
+	wg.Wait()
+
+	matchingEvents := make([]*data.TaggedEvent, 0)
+	for idx := range shardIDs {
+		if errs[idx] != nil {
+			return nil, errs[idx]
+		}
+
+		matchingEvents = append(matchingEvents, results[idx]...)
+	}
+
+	sortTaggedEvents(matchingEvents)
+
+	return matchingEvents, nil
+}
+
+func (ep *proxy) filterShardLogs(
+	ctx context.Context,
+	filter *sdkCore.FilterQuery,
+	shardID uint32,
+	capToLatestBlock bool,
+) ([]*data.TaggedEvent, error) {
 	status, err := ep.GetNetworkStatus(ctx, shardID)
 	if err != nil {
 		return nil, err
 	}
 
+	if capToLatestBlock {
+		if filter.FromBlock.HasValue && filter.FromBlock.Value > status.Nonce {
+			// the shard did not reach the start of the range yet
+			return make([]*data.TaggedEvent, 0), nil
+		}
+		filter = capFilterToLatestBlock(filter, status.Nonce)
+	}
+
 	fromBlock, toBlock, err := ep.computeFromToBlocksForFilter(ctx, filter, shardID, status.Nonce)
 	if err != nil {
 		return nil, err
 	}
 
-	matchingEvents := make([]*transaction.Events, 0, toBlock-fromBlock+1)
+	finalNonce, hasFinalNonce := ep.computeFinalNonce(ctx, shardID, status.Nonce, toBlock)
+
+	matchingEvents := make([]*data.TaggedEvent, 0, toBlock-fromBlock+1)
 	for blockNum := fromBlock; blockNum <= toBlock; blockNum++ {
-		blockLogs, err := ep.getLogsFromBlock(ctx, shardID, blockNum, filter)
+		isFinal := hasFinalNonce && blockNum <= finalNonce
+		blockLogs, err := ep.getLogsFromBlock(ctx, shardID, blockNum, filter, isFinal)
 		if err != nil {
 			return nil, err
 		}
@@ -786,6 +1534,81 @@ func (ep *proxy) FilterLogs(ctx context.Context, filter *sdkCore.FilterQuery) ([
 	return matchingEvents, nil
 }
 
+// capFilterToLatestBlock returns a copy of the filter with the end of the range capped to the provided latest block, as
+// the shards queried together can have different latest blocks
+func capFilterToLatestBlock(filter *sdkCore.FilterQuery, latestBlock uint64) *sdkCore.FilterQuery {
+	if !filter.ToBlock.HasValue || filter.ToBlock.Value <= latestBlock {
+		return filter
+	}
+
+	shardFilter := *filter
+	shardFilter.ToBlock.Value = latestBlock
+
+	return &shardFilter
+}
+
+func sortTaggedEvents(events []*data.TaggedEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].ShardID != events[j].ShardID {
+			return events[i].ShardID < events[j].ShardID
+		}
+		if events[i].BlockNonce != events[j].BlockNonce {
+			return events[i].BlockNonce < events[j].BlockNonce
+		}
+		if events[i].TxIndex != events[j].TxIndex {
+			return events[i].TxIndex < events[j].TxIndex
+		}
+
+		return events[i].EventIndex < events[j].EventIndex
+	})
+}
+
+// computeShardIDs returns the sorted list of shards that should be queried for the provided filter
+func (ep *proxy) computeShardIDs(ctx context.Context, filter *sdkCore.FilterQuery) ([]uint32, error) {
+	if filter.AllShards {
+		networkConfig, err := ep.GetNetworkConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		shardIDs := make([]uint32, 0, networkConfig.NumShardsWithoutMeta+1)
+		for shardID := uint32(0); shardID < networkConfig.NumShardsWithoutMeta; shardID++ {
+			shardIDs = append(shardIDs, shardID)
+		}
+
+		return append(shardIDs, core.MetachainShardId), nil
+	}
+
+	if len(filter.Addresses) == 0 || filter.ShardID.HasValue {
+		shardID, err := ep.computeShardId(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		return []uint32{shardID}, nil
+	}
+
+	uniqueShardIDs := make(map[uint32]struct{})
+	for _, address := range filter.Addresses {
+		shardID, err := ep.GetShardOfAddress(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+
+		uniqueShardIDs[shardID] = struct{}{}
+	}
+
+	shardIDs := make([]uint32, 0, len(uniqueShardIDs))
+	for shardID := range uniqueShardIDs {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs, nil
+}
+
 func (ep *proxy) computeShardId(ctx context.Context, filter *sdkCore.FilterQuery) (uint32, error) {
 	if len(filter.Addresses) != 0 {
 		shardIdFromAddresses, err := ep.computeShardIdFromAddresses(ctx, filter.Addresses)
@@ -843,6 +1666,18 @@ func (ep *proxy) computeFromToBlocksForFilter(ctx context.Context, filter *sdkCo
 }
 
 func resolveBlockRange(filter *sdkCore.FilterQuery, latestBlock uint64) (uint64, uint64, error) {
+	fromBlock, toBlock, err := resolveUnboundedBlockRange(filter, latestBlock)
+	if err != nil {
+		return 0, 0, err
+	}
+	if toBlock-fromBlock > MaximumBlocksDelta {
+		return 0, 0, ErrInvalidBlockRange
+	}
+
+	return fromBlock, toBlock, nil
+}
+
+func resolveUnboundedBlockRange(filter *sdkCore.FilterQuery, latestBlock uint64) (uint64, uint64, error) {
 	var genesisBlock uint64 = 0
 
 	if filter.ToBlock.HasValue && filter.ToBlock.Value > latestBlock {
@@ -851,31 +1686,26 @@ func resolveBlockRange(filter *sdkCore.FilterQuery, latestBlock uint64) (uint64,
 
 	// Check if both fromBlock and toBlock are set
 	if filter.FromBlock.HasValue && filter.ToBlock.HasValue {
-		if filter.ToBlock.Value-filter.FromBlock.Value <= MaximumBlocksDelta {
-			return filter.FromBlock.Value, filter.ToBlock.Value, nil
+		if filter.FromBlock.Value > filter.ToBlock.Value {
+			return 0, 0, ErrInvalidBlockRange
 		}
-		return 0, 0, errors.New("invalid block range: too many blocks to process")
+		return filter.FromBlock.Value, filter.ToBlock.Value, nil
 	}
 
 	// Check if only fromBlock is set
 	if filter.FromBlock.HasValue {
-		toBlock := latestBlock // Set toBlock to latestBlock
-		if toBlock-filter.FromBlock.Value <= MaximumBlocksDelta {
-			return filter.FromBlock.Value, toBlock, nil
+		if filter.FromBlock.Value > latestBlock {
+			return 0, 0, ErrInvalidBlockRange
 		}
-		return 0, 0, errors.New("invalid block range: too many blocks to process")
+		return filter.FromBlock.Value, latestBlock, nil
 	}
 
 	// Check if only toBlock is set
 	if filter.ToBlock.HasValue {
-		fromBlock := genesisBlock // Set fromBlock to genesisBlock
-		if filter.ToBlock.Value-fromBlock <= MaximumBlocksDelta {
-			return fromBlock, filter.ToBlock.Value, nil
-		}
-		return 0, 0, errors.New("invalid block range: too many blocks to process")
+		return genesisBlock, filter.ToBlock.Value, nil
 	}
 
-	return 0, 0, errors.New("no block range specified")
+	return 0, 0, ErrNoBlockRangeProvided
 }
 
 // getBlockNumberByHash retrieves the block number associated with the given block hash
@@ -893,18 +1723,20 @@ func (ep *proxy) getBlockNumberByHash(ctx context.Context, shardID uint32, block
 	blockNonce := response.Data.Block.Nonce
 
 	// Cache the raw response bytes
-	if len(buff) > 0 {
-		cacheKey := make([]byte, 8)
-		binary.BigEndian.PutUint64(cacheKey, blockNonce)
-		ep.filterQueryBlockCacher.Put(cacheKey, buff, len(buff))
-	}
+	ep.putFinalBlock(ctx, shardID, blockNonce, createBlockCacheKey(shardID, blockNonce), buff)
 
 	return blockNonce, nil
 }
 
-// getLogsFromBlock retrieves logs from a specific block and filters them
-func (ep *proxy) getLogsFromBlock(ctx context.Context, shardID uint32, blockNum uint64, filter *sdkCore.FilterQuery) ([]*transaction.Events, error) {
-	buff, err := getBlockBytesByNonce(ctx, ep, shardID, blockNum)
+// getLogsFromBlock retrieves logs from a specific block and filters them. The block is cached only if it is final
+func (ep *proxy) getLogsFromBlock(
+	ctx context.Context,
+	shardID uint32,
+	blockNum uint64,
+	filter *sdkCore.FilterQuery,
+	isFinal bool,
+) ([]*data.TaggedEvent, error) {
+	buff, err := getBlockBytesByNonce(ctx, ep, shardID, blockNum, isFinal)
 	if err != nil {
 		return nil, err
 	}
@@ -918,13 +1750,11 @@ func (ep *proxy) getLogsFromBlock(ctx context.Context, shardID uint32, blockNum
 		return nil, errors.New(response.Error)
 	}
 
-	return extractMatchingEvents(response, filter), nil
+	return extractMatchingEvents(response, filter, shardID), nil
 }
 
-func getBlockBytesByNonce(ctx context.Context, ep *proxy, shardID uint32, nonce uint64) ([]byte, error) {
-	cacheKey := make([]byte, 8)
-	binary.BigEndian.PutUint64(cacheKey, nonce)
-
+func getBlockBytesByNonce(ctx context.Context, ep *proxy, shardID uint32, nonce uint64, isFinal bool) ([]byte, error) {
+	cacheKey := createBlockCacheKey(shardID, nonce)
 	cachedResponse, found := ep.filterQueryBlockCacher.Get(cacheKey)
 	if found {
 		cachedBuff, ok := cachedResponse.([]byte)
@@ -939,22 +1769,53 @@ func getBlockBytesByNonce(ctx context.Context, ep *proxy, shardID uint32, nonce
 	}
 
 	// Cache the raw response bytes
-	ep.filterQueryBlockCacher.Put(cacheKey, buff, len(buff))
+	if isFinal && len(buff) > 0 {
+		ep.filterQueryBlockCacher.Put(cacheKey, buff, len(buff))
+	}
 
 	return buff, nil
 }
 
-func extractMatchingEvents(response data.BlockResponse, filter *sdkCore.FilterQuery) []*transaction.Events {
-	var matchingEvents []*transaction.Events
-	for _, miniblock := range response.Data.Block.MiniBlocks {
+// createBlockCacheKey creates the key of a cached block: the shard ID followed by the nonce, both big endian encoded
+func createBlockCacheKey(shardID uint32, nonce uint64) []byte {
+	cacheKey := make([]byte, 12)
+	binary.BigEndian.PutUint32(cacheKey, shardID)
+	binary.BigEndian.PutUint64(cacheKey[4:], nonce)
+
+	return cacheKey
+}
+
+func extractMatchingEvents(response data.BlockResponse, filter *sdkCore.FilterQuery, shardID uint32) []*data.TaggedEvent {
+	var matchingEvents []*data.TaggedEvent
+	block := response.Data.Block
+	if block == nil {
+		return matchingEvents
+	}
+
+	txIndex := -1
+	for _, miniblock := range block.MiniBlocks {
 		for _, tx := range miniblock.Transactions {
+			txIndex++
 			if tx.Logs == nil {
 				continue
 			}
-			for _, event := range tx.Logs.Events {
-				if matchesFilter(filter, event) {
-					matchingEvents = append(matchingEvents, event)
+			if !containsHash(filter.TxHashes, tx.Hash) {
+				continue
+			}
+			for eventIndex, event := range tx.Logs.Events {
+				if !matchesFilter(filter, event) {
+					continue
 				}
+
+				matchingEvents = append(matchingEvents, &data.TaggedEvent{
+					Event:      event,
+					ShardID:    shardID,
+					BlockNonce: block.Nonce,
+					BlockHash:  block.Hash,
+					TxHash:     tx.Hash,
+					TxIndex:    txIndex,
+					EventIndex: eventIndex,
+				})
 			}
 		}
 	}
@@ -967,20 +1828,42 @@ func matchesFilter(filter *sdkCore.FilterQuery, event *transaction.Events) bool
 		return false
 	}
 
+	// Check if the event's identifier matches any of the filter identifiers (if set)
+	if !contains(filter.Identifiers, event.Identifier) {
+		return false
+	}
+
 	// Check if the event's topics match the filter topics
 	if len(filter.Topics) > 0 && !topicsMatch(filter.Topics, event.Topics) {
 		return false
 	}
 
+	// Check if the event's topics match the filter topic alternatives
+	if len(filter.TopicAlternatives) > 0 && !topicAlternativesMatch(filter.TopicAlternatives, event.Topics) {
+		return false
+	}
+
 	return true
 }
 
-func contains(addresses []string, address string) bool {
-	if len(addresses) == 0 {
+func contains(values []string, value string) bool {
+	if len(values) == 0 {
 		return true
 	}
-	for _, a := range addresses {
-		if a == address {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHash(hashes []string, hash string) bool {
+	if len(hashes) == 0 {
+		return true
+	}
+	for _, h := range hashes {
+		if strings.EqualFold(h, hash) {
 			return true
 		}
 	}
@@ -1001,6 +1884,60 @@ func topicsMatch(filterTopics [][]byte, eventTopics [][]byte) bool {
 	return true
 }
 
+func topicAlternativesMatch(filterTopics [][][]byte, eventTopics [][]byte) bool {
+	if len(filterTopics) > len(eventTopics) {
+		return false
+	}
+
+	for i, alternatives := range filterTopics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		if !containsTopic(alternatives, eventTopics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func containsTopic(alternatives [][]byte, topic []byte) bool {
+	for _, alternative := range alternatives {
+		if bytes.Equal(alternative, topic) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getCachedHTTP returns the cached response of the endpoint, if any, otherwise does the GET request and caches its
+// response if it was successful
+func (ep *proxy) getCachedHTTP(ctx context.Context, endpoint string, isImmutable bool) ([]byte, int, error) {
+	buff, found := ep.responseCache.get(endpoint, isImmutable)
+	if found {
+		return buff, http.StatusOK, nil
+	}
+
+	buff, code, err := ep.GetHTTP(ctx, endpoint)
+	if err == nil && code == http.StatusOK {
+		ep.responseCache.put(endpoint, buff, isImmutable)
+	}
+
+	return buff, code, err
+}
+
+// InvalidateCachedResponses removes the cached responses of the provided endpoint classes, or all the cached
+// responses if no class is provided
+func (ep *proxy) InvalidateCachedResponses(classes ...sdkHttp.EndpointClass) {
+	ep.responseCache.invalidate(classes...)
+}
+
+// ResponseCacheStats returns the response cache counters of each endpoint class
+func (ep *proxy) ResponseCacheStats() map[sdkHttp.EndpointClass]ResponseCacheStats {
+	return ep.responseCache.statistics()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ep *proxy) IsInterfaceNil() bool {
 	return ep == nil
@@ -10,8 +10,10 @@ import (
 
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -25,6 +27,8 @@ import (
 	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
 	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
 	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/serde"
+	"github.com/TerraDharitri/drt-go-sdk/storage"
 	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -260,15 +264,17 @@ func TestGetAccount(t *testing.T) {
 				return response, err
 			}
 
-			account := data.AccountResponse{
-				Data: struct {
-					Account *data.Account `json:"account"`
-				}{
-					Account: &data.Account{
-						Nonce:   37,
-						Balance: "38",
-					},
-				},
+			account := data.AccountResponse{}
+			account.Data.Account = &data.Account{
+				Nonce:   37,
+				Balance: "38",
+			}
+			if req.URL.Query().Has(sdkCore.UrlParameterBlockNonce) {
+				account.Data.BlockInfo = api.BlockInfo{
+					Nonce:    36,
+					Hash:     "block hash",
+					RootHash: "root hash",
+				}
 			}
 			accountBytes, _ := json.Marshal(account)
 			atomic.AddUint32(&numAccountQueries, 1)
@@ -291,7 +297,7 @@ func TestGetAccount(t *testing.T) {
 	t.Run("nil address should error", func(t *testing.T) {
 		t.Parallel()
 
-		response, errGet := proxyInstance.GetAccount(context.Background(), nil)
+		response, errGet := proxyInstance.GetAccount(context.Background(), nil, api.AccountQueryOptions{})
 		require.Equal(t, ErrNilAddress, errGet)
 		require.Nil(t, response)
 	})
@@ -299,7 +305,7 @@ func TestGetAccount(t *testing.T) {
 		t.Parallel()
 
 		invalidAddress := data.NewAddressFromBytes([]byte("invalid address"))
-		response, errGet := proxyInstance.GetAccount(context.Background(), invalidAddress)
+		response, errGet := proxyInstance.GetAccount(context.Background(), invalidAddress, api.AccountQueryOptions{})
 		require.Equal(t, ErrInvalidAddress, errGet)
 		require.Nil(t, response)
 	})
@@ -310,7 +316,7 @@ func TestGetAccount(t *testing.T) {
 			},
 		}
 
-		account, errGet := proxyInstance.GetAccount(context.Background(), address)
+		account, errGet := proxyInstance.GetAccount(context.Background(), address, api.AccountQueryOptions{})
 		assert.Nil(t, account)
 		assert.True(t, errors.Is(errGet, expectedErr))
 		assert.Equal(t, uint32(0), atomic.LoadUint32(&numAccountQueries))
@@ -324,13 +330,27 @@ func TestGetAccount(t *testing.T) {
 			},
 		}
 
-		account, errGet := proxyInstance.GetAccount(context.Background(), address)
+		account, errGet := proxyInstance.GetAccount(context.Background(), address, api.AccountQueryOptions{})
 		assert.NotNil(t, account)
 		assert.Equal(t, uint64(37), account.Nonce)
 		assert.Nil(t, errGet)
 		assert.Equal(t, uint32(1), atomic.LoadUint32(&numAccountQueries))
 		assert.Equal(t, uint32(1), atomic.LoadUint32(&finalityCheckCalled))
 	})
+	t.Run("historical query should skip the finality check and return the block info", func(t *testing.T) {
+		proxyInstance.finalityProvider = &testsCommon.FinalityProviderStub{
+			CheckShardFinalizationCalled: func(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error {
+				return expectedErr
+			},
+		}
+
+		account, errGet := proxyInstance.GetAccount(context.Background(), address, api.AccountQueryOptions{
+			BlockNonce: core.OptionalUint64{Value: 36, HasValue: true},
+		})
+		require.Nil(t, errGet)
+		assert.Equal(t, uint64(37), account.Nonce)
+		assert.Equal(t, api.BlockInfo{Nonce: 36, Hash: "block hash", RootHash: "root hash"}, account.BlockInfo)
+	})
 }
 
 func TestProxy_GetNetworkEconomics(t *testing.T) {
@@ -380,6 +400,182 @@ func TestProxy_RequestTransactionCost(t *testing.T) {
 	}, txCost)
 }
 
+func TestProxy_SimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:    1,
+		Value:    "50",
+		Receiver: "drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya",
+		Sender:   "drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya",
+		Data:     []byte("hello"),
+		ChainID:  "1",
+		Version:  1,
+	}
+	createProxy := func(responseBytes []byte, checkSignature string) *proxy {
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, "/transaction/simulate", req.URL.Path)
+				assert.Equal(t, checkSignature, req.URL.Query().Get("checkSignature"))
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		return ep
+	}
+
+	t.Run("error response should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep := createProxy([]byte(`{"error":"transaction generation failed"}`), "true")
+		simulation, err := ep.SimulateTransaction(context.Background(), tx, true)
+		assert.Nil(t, simulation)
+		assert.Equal(t, "transaction generation failed", err.Error())
+	})
+	t.Run("intra-shard transaction", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"result":{"status":"success","hash":"aabb",` +
+			`"scResults":{"ccdd":{"hash":"ccdd","nonce":2,"data":"@6f6b"}},` +
+			`"logs":{"address":"drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya","events":[{"identifier":"completedTxEvent"}]}}},` +
+			`"error":"","code":"successful"}`)
+		ep := createProxy(responseBytes, "false")
+		simulation, err := ep.SimulateTransaction(context.Background(), tx, false)
+		require.Nil(t, err)
+		assert.False(t, simulation.IsCrossShard())
+		assert.True(t, simulation.IsSuccessful())
+		assert.Equal(t, transaction.TxStatusSuccess, simulation.Status)
+		assert.Equal(t, "aabb", simulation.Hash)
+		assert.Equal(t, "@6f6b", simulation.ScResults["ccdd"].Data)
+		assert.Equal(t, "completedTxEvent", simulation.Logs.Events[0].Identifier)
+		assert.Equal(t, 1, len(simulation.ShardResults()))
+	})
+	t.Run("cross-shard transaction failing on the receiver shard", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"result":{` +
+			`"senderShard":{"status":"pending","hash":"aabb"},` +
+			`"receiverShard":{"status":"fail","failReason":"insufficient funds","hash":"aabb"}}},` +
+			`"error":"","code":"successful"}`)
+		ep := createProxy(responseBytes, "true")
+		simulation, err := ep.SimulateTransaction(context.Background(), tx, true)
+		require.Nil(t, err)
+		assert.True(t, simulation.IsCrossShard())
+		assert.False(t, simulation.IsSuccessful())
+		assert.Equal(t, "insufficient funds", simulation.GetFailReason())
+		assert.Equal(t, transaction.TxStatusPending, simulation.SenderShard.Status)
+		assert.Equal(t, 2, len(simulation.ShardResults()))
+	})
+}
+
+func TestProxy_TransactionsPoolForSender(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String("drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya")
+	createProxy := func(responseBytes []byte, expectedQuery url.Values) *proxy {
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/transaction/pool", req.URL.Path)
+				assert.Equal(t, expectedQuery, req.URL.Query())
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		return ep
+	}
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(nil)))
+		transactions, err := ep.GetTransactionsPoolForSender(context.Background(), nil)
+		assert.Nil(t, transactions)
+		assert.Equal(t, ErrNilAddress, err)
+
+		nonce, err := ep.GetLastPoolNonceForSender(context.Background(), nil)
+		assert.Zero(t, nonce)
+		assert.Equal(t, ErrNilAddress, err)
+
+		gaps, err := ep.GetTransactionsPoolNonceGapsForSender(context.Background(), nil)
+		assert.Nil(t, gaps)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("error response should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"error":"no transaction in pool"}`))))
+		nonce, err := ep.GetLastPoolNonceForSender(context.Background(), address)
+		assert.Zero(t, nonce)
+		assert.Equal(t, "no transaction in pool", err.Error())
+	})
+	t.Run("transactions for sender", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"txPool":{"transactions":[` +
+			`{"txFields":{"hash":"aa","nonce":5,"sender":"drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya","gaslimit":50000,"gasprice":1000000000,"data":"aGVsbG8=","value":"1"}},` +
+			`{"txFields":{"hash":"bb","nonce":6}}]}},"code":"successful"}`)
+		ep := createProxy(responseBytes, url.Values{
+			"by-sender": []string{"drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya"},
+			"fields":    []string{"hash,nonce,sender,receiver,gaslimit,gasprice,data,value"},
+		})
+		transactions, err := ep.GetTransactionsPoolForSender(context.Background(), address)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(transactions))
+		assert.Equal(t, &data.PoolTransaction{
+			Hash:     "aa",
+			Nonce:    5,
+			Sender:   "drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya",
+			GasLimit: 50000,
+			GasPrice: 1000000000,
+			Data:     []byte("hello"),
+			Value:    "1",
+		}, transactions[0])
+		assert.Equal(t, uint64(6), transactions[1].Nonce)
+	})
+	t.Run("last nonce for sender", func(t *testing.T) {
+		t.Parallel()
+
+		ep := createProxy([]byte(`{"data":{"nonce":37},"code":"successful"}`), url.Values{
+			"by-sender":  []string{"drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya"},
+			"last-nonce": []string{"true"},
+		})
+		nonce, err := ep.GetLastPoolNonceForSender(context.Background(), address)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(37), nonce)
+	})
+	t.Run("nonce gaps for sender", func(t *testing.T) {
+		t.Parallel()
+
+		expectedQuery := url.Values{
+			"by-sender":  []string{"drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya"},
+			"nonce-gaps": []string{"true"},
+		}
+		responseBytes := []byte(`{"data":{"nonceGaps":{"sender":"drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya",` +
+			`"gaps":[{"from":3,"to":5},{"from":8,"to":8}]}},"code":"successful"}`)
+		ep := createProxy(responseBytes, expectedQuery)
+		gaps, err := ep.GetTransactionsPoolNonceGapsForSender(context.Background(), address)
+		require.Nil(t, err)
+		assert.Equal(t, []data.NonceGap{{From: 3, To: 5}, {From: 8, To: 8}}, gaps)
+
+		ep = createProxy([]byte(`{"data":{"nonceGaps":{"sender":"drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya"}},"code":"successful"}`), expectedQuery)
+		gaps, err = ep.GetTransactionsPoolNonceGapsForSender(context.Background(), address)
+		require.Nil(t, err)
+		assert.Empty(t, gaps)
+		assert.NotNil(t, gaps)
+	})
+}
+
 func TestProxy_GetTransactionInfoWithResults(t *testing.T) {
 	t.Parallel()
 
@@ -408,7 +604,7 @@ func TestProxy_ExecuteVmQuery(t *testing.T) {
 			Address:    "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
 			FuncName:   "version",
 			CallerAddr: "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
-		})
+		}, api.AccountQueryOptions{})
 		require.Nil(t, err)
 		require.Equal(t, "0.5.5", string(response.Data.ReturnData[0]))
 	})
@@ -432,7 +628,7 @@ func TestProxy_ExecuteVmQuery(t *testing.T) {
 			Address:    "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
 			FuncName:   "version",
 			CallerAddr: "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
-		})
+		}, api.AccountQueryOptions{})
 
 		assert.NotNil(t, err)
 		assert.True(t, strings.Contains(err.Error(), "shardID 2 is stuck"))
@@ -458,7 +654,7 @@ func TestProxy_ExecuteVmQuery(t *testing.T) {
 			Address:    "invalid",
 			FuncName:   "version",
 			CallerAddr: "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
-		})
+		}, api.AccountQueryOptions{})
 
 		assert.NotNil(t, err)
 		assert.True(t, strings.Contains(err.Error(), "invalid bech32 string length 7"))
@@ -488,12 +684,40 @@ func TestProxy_ExecuteVmQuery(t *testing.T) {
 			Address:    "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
 			FuncName:   "version",
 			CallerAddr: "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
-		})
+		}, api.AccountQueryOptions{})
 
 		assert.True(t, wasHandled)
 		require.Nil(t, err)
 		require.Equal(t, "0.5.5", string(response.Data.ReturnData[0]))
 	})
+	t.Run("historical query should send the options and return the block info", func(t *testing.T) {
+		historicalResponseBytes := []byte(`{"data":{"data":{"returnData":["MC41LjQ="],"returnCode":"ok"},"blockInfo":{"nonce":100,"hash":"aa","rootHash":"bb"}},"code":"successful"}`)
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "aa", req.URL.Query().Get(sdkCore.UrlParameterBlockHash))
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(historicalResponseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.FinalityCheck = true
+		ep, _ := NewProxy(args)
+
+		response, err := ep.ExecuteVMQuery(context.Background(), &data.VmValueRequest{
+			Address:    "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
+			FuncName:   "version",
+			CallerAddr: "drt1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q8vqld4",
+		}, api.AccountQueryOptions{
+			BlockHash: []byte{0xaa},
+		})
+
+		require.Nil(t, err)
+		require.Equal(t, "0.5.4", string(response.Data.ReturnData[0]))
+		require.Equal(t, api.BlockInfo{Nonce: 100, Hash: "aa", RootHash: "bb"}, response.BlockInfo)
+	})
 }
 
 func TestProxy_GetRawBlockByHash(t *testing.T) {
@@ -814,6 +1038,7 @@ func TestNumbatProxy_GetDCDTTokenData(t *testing.T) {
 		response := &data.DCDTFungibleResponse{
 			Data: struct {
 				TokenData *data.DCDTFungibleTokenData `json:"tokenData"`
+				BlockInfo api.BlockInfo               `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -841,6 +1066,7 @@ func TestNumbatProxy_GetDCDTTokenData(t *testing.T) {
 		response := &data.DCDTFungibleResponse{
 			Data: struct {
 				TokenData *data.DCDTFungibleTokenData `json:"tokenData"`
+				BlockInfo api.BlockInfo               `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -968,6 +1194,7 @@ func TestNumbatProxy_GetNFTTokenData(t *testing.T) {
 		response := &data.DCDTNFTResponse{
 			Data: struct {
 				TokenData *data.DCDTNFTTokenData `json:"tokenData"`
+				BlockInfo api.BlockInfo          `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -1002,6 +1229,7 @@ func TestNumbatProxy_GetNFTTokenData(t *testing.T) {
 		response := &data.DCDTNFTResponse{
 			Data: struct {
 				TokenData *data.DCDTNFTTokenData `json:"tokenData"`
+				BlockInfo api.BlockInfo          `json:"blockInfo"`
 			}{
 				TokenData: responseTokenData,
 			},
@@ -1040,7 +1268,7 @@ func TestProxy_GetGuardianData(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		response, err := ep.GetGuardianData(context.Background(), nil)
+		response, err := ep.GetGuardianData(context.Background(), nil, api.AccountQueryOptions{})
 		require.Equal(t, err, ErrNilAddress)
 		require.Nil(t, response)
 	})
@@ -1052,7 +1280,7 @@ func TestProxy_GetGuardianData(t *testing.T) {
 		ep, _ := NewProxy(args)
 
 		address := data.NewAddressFromBytes([]byte("invalid address"))
-		response, err := ep.GetGuardianData(context.Background(), address)
+		response, err := ep.GetGuardianData(context.Background(), address, api.AccountQueryOptions{})
 		require.Equal(t, err, ErrInvalidAddress)
 		require.Nil(t, response)
 	})
@@ -1070,24 +1298,32 @@ func TestProxy_GetGuardianData(t *testing.T) {
 			},
 			Guarded: false,
 		}
-		guardianDataResponse := &data.GuardianDataResponse{
-			Data: struct {
-				GuardianData *api.GuardianData `json:"guardianData"`
-			}{
-				GuardianData: expectedGuardianData,
-			},
-		}
+		guardianDataResponse := &data.GuardianDataResponse{}
+		guardianDataResponse.Data.GuardianData = expectedGuardianData
+		guardianDataResponse.Data.BlockInfo = api.BlockInfo{Nonce: 38}
 		guardianDataResponseBytes, _ := json.Marshal(guardianDataResponse)
 
-		httpClient := createMockClientRespondingBytes(guardianDataResponseBytes)
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "38", req.URL.Query().Get(sdkCore.UrlParameterBlockNonce))
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(guardianDataResponseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
 		address, _ := data.NewAddressFromBech32String("drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw")
-		response, err := ep.GetGuardianData(context.Background(), address)
+		response, err := ep.GetGuardianData(context.Background(), address, api.AccountQueryOptions{
+			BlockNonce: core.OptionalUint64{Value: 38, HasValue: true},
+		})
 		require.Nil(t, err)
 
-		require.Equal(t, expectedGuardianData, response)
+		require.Equal(t, *expectedGuardianData, response.GuardianData)
+		require.Equal(t, api.BlockInfo{Nonce: 38}, response.BlockInfo)
 	})
 }
 
@@ -1103,7 +1339,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), nil)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), nil, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.Equal(t, ErrNilAddress, err)
 	})
@@ -1116,7 +1352,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 
 		invalidAddress := data.NewAddressFromBytes([]byte("invalid"))
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), invalidAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), invalidAddress, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.True(t, strings.Contains(err.Error(), "wrong size when encoding address"))
 	})
@@ -1127,7 +1363,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.ErrorIs(t, err, expectedErr)
 	})
@@ -1138,7 +1374,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.ErrorIs(t, err, ErrHTTPStatusCodeIsNotOK)
 	})
@@ -1149,7 +1385,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.NotNil(t, err)
 	})
@@ -1165,7 +1401,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.NotNil(t, err)
 		assert.Equal(t, expectedErr.Error(), err.Error())
@@ -1185,7 +1421,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.Contains(t, err.Error(), "isMigrated key not found in response map")
 	})
@@ -1204,7 +1440,7 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
 		assert.True(t, isMigrated)
 		assert.Nil(t, err)
 	})
@@ -1223,10 +1459,65 @@ func TestProxy_IsDataTrieMigrated(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
-		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress)
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
 		assert.False(t, isMigrated)
 		assert.Nil(t, err)
 	})
+	t.Run("with query options should work", func(t *testing.T) {
+		t.Parallel()
+
+		response := &data.IsDataTrieMigratedResponse{
+			Data: map[string]bool{"isMigrated": true},
+		}
+		responseBytes, _ := json.Marshal(response)
+		expectedSuffix := "?blockHash=626c6f636b2068617368&blockNonce=3838&blockRootHash=626c6f636b20726f6f742068617368&hintEpoch=3939&onFinalBlock=true&onStartOfEpoch=3737"
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.True(t, strings.HasSuffix(req.URL.String(), expectedSuffix))
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.FinalityCheck = true
+		ep, _ := NewProxy(args)
+		ep.finalityProvider = &testsCommon.FinalityProviderStub{
+			CheckShardFinalizationCalled: func(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error {
+				assert.Fail(t, "historical queries should not check the finalization status")
+				return expectedErr
+			},
+		}
+
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, testQueryOptions)
+		assert.True(t, isMigrated)
+		assert.Nil(t, err)
+	})
+	t.Run("not final shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				response, _, err := handleRequestNetworkConfigAndStatus(req, 3, 9170526, 9170526)
+				return response, err
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.FinalityCheck = true
+		ep, _ := NewProxy(args)
+		ep.finalityProvider = &testsCommon.FinalityProviderStub{
+			CheckShardFinalizationCalled: func(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error {
+				return expectedErr
+			},
+		}
+
+		isMigrated, err := ep.IsDataTrieMigrated(context.Background(), validAddress, api.AccountQueryOptions{})
+		assert.False(t, isMigrated)
+		assert.Equal(t, expectedErr, err)
+	})
 }
 
 func TestProxy_FilterLogs(t *testing.T) {
@@ -1339,7 +1630,7 @@ func TestProxy_FilterLogs(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
-	t.Run("should fail for addresses from different shards", func(t *testing.T) {
+	t.Run("addresses from different shards on an observer should error", func(t *testing.T) {
 
 		invalidFilter := &sdkCore.FilterQuery{
 			FromBlock: core.OptionalUint64{Value: 21000005, HasValue: true},
@@ -1363,8 +1654,9 @@ func TestProxy_FilterLogs(t *testing.T) {
 		args := createMockArgsProxy(httpClient)
 		ep, _ := NewProxy(args)
 
+		// an observer can only serve the shard it belongs to
 		res, err := ep.FilterLogs(context.Background(), invalidFilter)
-		assert.Equal(t, ErrAddressesFromDifferentShards, err)
+		assert.True(t, errors.Is(err, ErrShardIDMismatch))
 		assert.Nil(t, res)
 	})
 
@@ -1599,3 +1891,807 @@ func TestProxy_FilterLogs(t *testing.T) {
 		assert.Equal(t, len(res2[6].Topics), 1)
 	})
 }
+
+func TestMatchesFilter(t *testing.T) {
+	t.Parallel()
+
+	topicA := []byte("A")
+	topicB := []byte("B")
+	topicC := []byte("C")
+	event := &transaction.Events{
+		Address:    "address",
+		Identifier: "DCDTTransfer",
+		Topics:     [][]byte{topicA, topicB},
+	}
+
+	testCases := []struct {
+		name     string
+		filter   *sdkCore.FilterQuery
+		expected bool
+	}{
+		{name: "empty filter", filter: &sdkCore.FilterQuery{}, expected: true},
+		{name: "matching address", filter: &sdkCore.FilterQuery{Addresses: []string{"other", "address"}}, expected: true},
+		{name: "not matching address", filter: &sdkCore.FilterQuery{Addresses: []string{"other"}}, expected: false},
+		{name: "matching identifier", filter: &sdkCore.FilterQuery{Identifiers: []string{"DCDTTransfer", "writeLog"}}, expected: true},
+		{name: "not matching identifier", filter: &sdkCore.FilterQuery{Identifiers: []string{"writeLog"}}, expected: false},
+		{name: "matching topics prefix", filter: &sdkCore.FilterQuery{Topics: [][]byte{topicA}}, expected: true},
+		{name: "not matching topics prefix", filter: &sdkCore.FilterQuery{Topics: [][]byte{topicB}}, expected: false},
+		{name: "empty topic is not a wildcard", filter: &sdkCore.FilterQuery{Topics: [][]byte{nil, topicB}}, expected: false},
+		{name: "too many topics", filter: &sdkCore.FilterQuery{Topics: [][]byte{topicA, topicB, topicC}}, expected: false},
+		{name: "matching alternatives", filter: &sdkCore.FilterQuery{TopicAlternatives: [][][]byte{{topicC, topicA}, {topicB}}}, expected: true},
+		{name: "alternatives with wildcard", filter: &sdkCore.FilterQuery{TopicAlternatives: [][][]byte{{}, {topicC, topicB}}}, expected: true},
+		{name: "not matching alternatives", filter: &sdkCore.FilterQuery{TopicAlternatives: [][][]byte{{topicA}, {topicA, topicC}}}, expected: false},
+		{name: "too many alternatives", filter: &sdkCore.FilterQuery{TopicAlternatives: [][][]byte{{}, {}, {}}}, expected: false},
+		{
+			name: "all criteria matching",
+			filter: &sdkCore.FilterQuery{
+				Addresses:         []string{"address"},
+				Identifiers:       []string{"DCDTTransfer"},
+				Topics:            [][]byte{topicA},
+				TopicAlternatives: [][][]byte{{}, {topicB}},
+			},
+			expected: true,
+		},
+		{
+			name: "one criterion not matching",
+			filter: &sdkCore.FilterQuery{
+				Addresses:         []string{"address"},
+				Identifiers:       []string{"DCDTTransfer"},
+				TopicAlternatives: [][][]byte{{topicB}},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		testCase := tc
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, matchesFilter(testCase.filter, event))
+		})
+	}
+}
+
+func TestExtractMatchingEvents_ByTxHash(t *testing.T) {
+	t.Parallel()
+
+	response := data.BlockResponse{
+		Data: data.BlockDataResponse{
+			Block: &api.Block{
+				MiniBlocks: []*api.MiniBlock{
+					{
+						Transactions: []*transaction.ApiTransactionResult{
+							{
+								Hash: "aa01",
+								Logs: &transaction.ApiLogs{Events: []*transaction.Events{{Identifier: "first"}}},
+							},
+							{
+								Hash: "bb02",
+								Logs: &transaction.ApiLogs{Events: []*transaction.Events{{Identifier: "second"}}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	events := extractMatchingEvents(response, &sdkCore.FilterQuery{}, 0)
+	require.Equal(t, 2, len(events))
+
+	events = extractMatchingEvents(response, &sdkCore.FilterQuery{TxHashes: []string{"BB02"}}, 0)
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, "second", events[0].Event.Identifier)
+	assert.Equal(t, "bb02", events[0].TxHash)
+	assert.Equal(t, 1, events[0].TxIndex)
+
+	events = extractMatchingEvents(data.BlockResponse{}, &sdkCore.FilterQuery{}, 0)
+	assert.Empty(t, events)
+}
+
+func createMultiShardLogsMockClient(numShards uint32, latestNonce uint64, addressShard0 string, addressShard1 string) *mockHTTPClient {
+	return &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			var response interface{}
+			path := req.URL.Path
+			shardID := uint32(0)
+			nonce := uint64(0)
+			switch {
+			case path == "/network/config":
+				networkConfigResponse := data.NetworkConfigResponse{}
+				networkConfigResponse.Data.Config = &data.NetworkConfig{NumShardsWithoutMeta: numShards}
+				response = networkConfigResponse
+			case strings.HasPrefix(path, "/network/status/"):
+				_, _ = fmt.Sscanf(path, "/network/status/%d", &shardID)
+				networkStatusResponse := data.NetworkStatusResponse{}
+				networkStatusResponse.Data.Status = &data.NetworkStatus{Nonce: latestNonce, ShardID: shardID}
+				response = networkStatusResponse
+			case strings.HasPrefix(path, "/block/"):
+				_, _ = fmt.Sscanf(path, "/block/%d/by-nonce/%d", &shardID, &nonce)
+				response = data.BlockResponse{
+					Data: data.BlockDataResponse{
+						Block: &api.Block{
+							Nonce: nonce,
+							Hash:  fmt.Sprintf("hash-%d-%d", shardID, nonce),
+							MiniBlocks: []*api.MiniBlock{
+								{
+									Transactions: []*transaction.ApiTransactionResult{
+										{
+											Hash: fmt.Sprintf("tx-%d-%d-0", shardID, nonce),
+											Logs: &transaction.ApiLogs{Events: []*transaction.Events{
+												{Address: addressShard0, Identifier: "first"},
+											}},
+										},
+										{
+											Hash: fmt.Sprintf("tx-%d-%d-1", shardID, nonce),
+											Logs: &transaction.ApiLogs{Events: []*transaction.Events{
+												{Address: addressShard1, Identifier: "second"},
+												{Address: addressShard0, Identifier: "third"},
+											}},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			default:
+				return nil, fmt.Errorf("unexpected request %s", req.URL.String())
+			}
+
+			buff, _ := json.Marshal(response)
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewReader(buff)),
+				StatusCode: http.StatusOK,
+			}, nil
+		},
+	}
+}
+
+func TestProxy_FilterTaggedLogs(t *testing.T) {
+	t.Parallel()
+
+	addressShard0 := "drt1d7y4a8wtykxnxxjhywzk0q5tkey4g9z6rhalefw6syr779kh77yqsn73h6"
+	addressShard1 := "drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya"
+
+	createProxy := func() *proxy {
+		args := createMockArgsProxy(createMultiShardLogsMockClient(3, 100, addressShard0, addressShard1))
+		args.EntityType = sdkCore.Proxy
+		ep, _ := NewProxy(args)
+
+		return ep
+	}
+
+	t.Run("addresses from different shards should query each shard", func(t *testing.T) {
+		t.Parallel()
+
+		filter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 10, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 11, HasValue: true},
+			Addresses: []string{addressShard1, addressShard0},
+		}
+
+		events, err := createProxy().FilterTaggedLogs(context.Background(), filter)
+		require.Nil(t, err)
+		require.Equal(t, 12, len(events))
+
+		expectedOrder := []struct {
+			shardID    uint32
+			nonce      uint64
+			txIndex    int
+			eventIndex int
+		}{
+			{0, 10, 0, 0}, {0, 10, 1, 0}, {0, 10, 1, 1},
+			{0, 11, 0, 0}, {0, 11, 1, 0}, {0, 11, 1, 1},
+			{1, 10, 0, 0}, {1, 10, 1, 0}, {1, 10, 1, 1},
+			{1, 11, 0, 0}, {1, 11, 1, 0}, {1, 11, 1, 1},
+		}
+		for idx, expected := range expectedOrder {
+			assert.Equal(t, expected.shardID, events[idx].ShardID)
+			assert.Equal(t, expected.nonce, events[idx].BlockNonce)
+			assert.Equal(t, expected.txIndex, events[idx].TxIndex)
+			assert.Equal(t, expected.eventIndex, events[idx].EventIndex)
+			assert.Equal(t, fmt.Sprintf("hash-%d-%d", expected.shardID, expected.nonce), events[idx].BlockHash)
+			assert.Equal(t, fmt.Sprintf("tx-%d-%d-%d", expected.shardID, expected.nonce, expected.txIndex), events[idx].TxHash)
+		}
+		assert.Equal(t, "third", events[2].Event.Identifier)
+	})
+	t.Run("all shards should include the metachain", func(t *testing.T) {
+		t.Parallel()
+
+		filter := &sdkCore.FilterQuery{
+			FromBlock:   core.OptionalUint64{Value: 10, HasValue: true},
+			ToBlock:     core.OptionalUint64{Value: 10, HasValue: true},
+			AllShards:   true,
+			Identifiers: []string{"second"},
+		}
+
+		events, err := createProxy().FilterTaggedLogs(context.Background(), filter)
+		require.Nil(t, err)
+		require.Equal(t, 4, len(events))
+		assert.Equal(t, uint32(0), events[0].ShardID)
+		assert.Equal(t, uint32(1), events[1].ShardID)
+		assert.Equal(t, uint32(2), events[2].ShardID)
+		assert.Equal(t, core.MetachainShardId, events[3].ShardID)
+
+		untaggedEvents, err := createProxy().FilterLogs(context.Background(), filter)
+		require.Nil(t, err)
+		require.Equal(t, 4, len(untaggedEvents))
+		assert.Equal(t, events[3].Event, untaggedEvents[3])
+	})
+	t.Run("cached blocks should not collide across shards", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProxy(createMultiShardLogsMockClient(3, 100, addressShard0, addressShard1))
+		args.EntityType = sdkCore.Proxy
+		args.FilterQueryBlockCacher = storage.NewMapCacher()
+		ep, _ := NewProxy(args)
+
+		filter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 10, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 10, HasValue: true},
+			AllShards: true,
+		}
+
+		for i := 0; i < 2; i++ {
+			events, err := ep.FilterTaggedLogs(context.Background(), filter)
+			require.Nil(t, err)
+			require.Equal(t, 12, len(events))
+			for _, event := range events {
+				assert.Equal(t, fmt.Sprintf("hash-%d-10", event.ShardID), event.BlockHash)
+			}
+		}
+	})
+	t.Run("the finality should be checked once for the whole range", func(t *testing.T) {
+		t.Parallel()
+
+		requestedPaths := make(map[string]int)
+		mutRequestedPaths := sync.Mutex{}
+		multiShardClient := createMultiShardLogsMockClient(3, 100, addressShard0, addressShard1)
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				mutRequestedPaths.Lock()
+				requestedPaths[req.URL.Path]++
+				mutRequestedPaths.Unlock()
+
+				return multiShardClient.Do(req)
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.EntityType = sdkCore.Proxy
+		args.FilterQueryBlockCacher = storage.NewMapCacher()
+		ep, _ := NewProxy(args)
+		numFinalityChecks := uint32(0)
+		ep.finalityProvider = &testsCommon.FinalityProviderStub{
+			CheckShardFinalizationCalled: func(ctx context.Context, targetShardID uint32, maxNoncesDelta uint64) error {
+				atomic.AddUint32(&numFinalityChecks, 1)
+				return nil
+			},
+		}
+
+		filter := &sdkCore.FilterQuery{
+			FromBlock: core.OptionalUint64{Value: 10, HasValue: true},
+			ToBlock:   core.OptionalUint64{Value: 29, HasValue: true},
+			ShardID:   core.OptionalUint32{Value: 0, HasValue: true},
+		}
+		for i := 0; i < 2; i++ {
+			events, err := ep.FilterTaggedLogs(context.Background(), filter)
+			require.Nil(t, err)
+			require.Equal(t, 60, len(events))
+		}
+
+		// the second call is served from the cache, the remembered final nonce covering the whole range
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numFinalityChecks))
+		assert.Equal(t, 2, requestedPaths["/network/status/0"])
+		assert.Equal(t, 1, requestedPaths["/block/0/by-nonce/10"])
+		assert.Equal(t, 1, requestedPaths["/block/0/by-nonce/29"])
+	})
+	t.Run("the range should be capped to the latest block of each shard", func(t *testing.T) {
+		t.Parallel()
+
+		shardsLatestNonces := map[string]uint64{
+			"/network/status/1": 10,
+			"/network/status/2": 8,
+		}
+		multiShardClient := createMultiShardLogsMockClient(3, 100, addressShard0, addressShard1)
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				latestNonce, found := shardsLatestNonces[req.URL.Path]
+				if !found {
+					return multiShardClient.Do(req)
+				}
+
+				networkStatusResponse := data.NetworkStatusResponse{}
+				networkStatusResponse.Data.Status = &data.NetworkStatus{Nonce: latestNonce}
+				buff, _ := json.Marshal(networkStatusResponse)
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(buff)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.EntityType = sdkCore.Proxy
+		ep, _ := NewProxy(args)
+
+		filter := &sdkCore.FilterQuery{
+			FromBlock:   core.OptionalUint64{Value: 10, HasValue: true},
+			ToBlock:     core.OptionalUint64{Value: 11, HasValue: true},
+			AllShards:   true,
+			Identifiers: []string{"first"},
+		}
+
+		events, err := ep.FilterTaggedLogs(context.Background(), filter)
+		require.Nil(t, err)
+		require.Equal(t, 5, len(events))
+		assert.Equal(t, "hash-0-10", events[0].BlockHash)
+		assert.Equal(t, "hash-0-11", events[1].BlockHash)
+		assert.Equal(t, "hash-1-10", events[2].BlockHash)
+		assert.Equal(t, core.MetachainShardId, events[3].ShardID)
+		assert.Equal(t, uint64(10), events[3].BlockNonce)
+		assert.Equal(t, uint64(11), events[4].BlockNonce)
+	})
+	t.Run("block hash with multiple shards should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter := &sdkCore.FilterQuery{
+			BlockHash: []byte("hash"),
+			AllShards: true,
+		}
+
+		events, err := createProxy().FilterTaggedLogs(context.Background(), filter)
+		assert.Nil(t, events)
+		assert.Equal(t, ErrBlockHashWithMultipleShards, err)
+	})
+}
+
+func TestProxy_GetAccountStorageValue(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String("drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw")
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(nil)))
+		value, err := ep.GetAccountStorageValue(context.Background(), address, nil, api.AccountQueryOptions{})
+		assert.Nil(t, value)
+		assert.Equal(t, ErrEmptyStorageKey, err)
+	})
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(nil)))
+		value, err := ep.GetAccountStorageValue(context.Background(), nil, []byte("key"), api.AccountQueryOptions{})
+		assert.Nil(t, value)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("invalid hex value should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"data":{"value":"zz"},"code":"successful"}`))))
+		value, err := ep.GetAccountStorageValue(context.Background(), address, []byte("key"), api.AccountQueryOptions{})
+		assert.Nil(t, value)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/address/drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw/key/6b6579", req.URL.Path)
+				assert.Equal(t, "10", req.URL.Query().Get(sdkCore.UrlParameterBlockNonce))
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"data":{"value":"0100","blockInfo":{"nonce":10}},"code":"successful"}`))),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		value, err := ep.GetAccountStorageValue(context.Background(), address, []byte("key"), api.AccountQueryOptions{
+			BlockNonce: core.OptionalUint64{Value: 10, HasValue: true},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, &data.AccountStorageValue{
+			Key:       []byte("key"),
+			Value:     []byte{1, 0},
+			BlockInfo: api.BlockInfo{Nonce: 10},
+		}, value)
+
+		decoded := uint64(0)
+		err = serde.NewDeserializer().DecodeTopEncoded(&decoded, value.Value)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(256), decoded)
+	})
+}
+
+func TestProxy_GetAccountStorageKeys(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String("drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw")
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(nil)))
+		storage, err := ep.GetAccountStorageKeys(context.Background(), data.NewAddressFromBytes(nil), api.AccountQueryOptions{})
+		assert.Nil(t, storage)
+		assert.Equal(t, ErrInvalidAddress, err)
+	})
+	t.Run("invalid hex key should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"data":{"pairs":{"zz":"01"}},"code":"successful"}`))))
+		storage, err := ep.GetAccountStorageKeys(context.Background(), address, api.AccountQueryOptions{})
+		assert.Nil(t, storage)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/address/drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw/keys", req.URL.Path)
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader([]byte(`{"data":{"pairs":{"6f776e6572":"01","6b6579":""},"blockInfo":{"nonce":11}},"code":"successful"}`))),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		storage, err := ep.GetAccountStorageKeys(context.Background(), address, api.AccountQueryOptions{})
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(storage.Pairs))
+		assert.Equal(t, []byte{1}, storage.Value([]byte("owner")))
+		assert.Empty(t, storage.Value([]byte("key")))
+		assert.Nil(t, storage.Value([]byte("missing")))
+		assert.Equal(t, uint64(11), storage.BlockInfo.Nonce)
+	})
+}
+
+func TestProxy_GetAllTokens(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String("drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw")
+	responseBytes := []byte(`{"data":{"dcdts":{` +
+		`"TKN-001122":{"tokenIdentifier":"TKN-001122","balance":"10"},` +
+		`"NFT-001122-02":{"tokenIdentifier":"NFT-001122-02","balance":"1","nonce":2,"name":"second"},` +
+		`"ABC-001122":{"tokenIdentifier":"ABC-001122","balance":"20","properties":"00"},` +
+		`"NFT-001122-01":{"tokenIdentifier":"NFT-001122-01","balance":"1","nonce":1,"name":"first"}` +
+		`},"blockInfo":{"nonce":7}},"code":"successful"}`)
+	createProxy := func() *proxy {
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/address/drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw/dcdt", req.URL.Path)
+				assert.Equal(t, "true", req.URL.Query().Get(sdkCore.UrlParameterOnFinalBlock))
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(responseBytes)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		return ep
+	}
+	queryOptions := api.AccountQueryOptions{OnFinalBlock: true}
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokens, err := createProxy().GetAllDCDTTokens(context.Background(), nil, queryOptions)
+		assert.Nil(t, tokens)
+		assert.Equal(t, ErrNilAddress, err)
+
+		nfts, err := createProxy().GetAllNFTTokens(context.Background(), nil, queryOptions)
+		assert.Nil(t, nfts)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("fungible tokens", func(t *testing.T) {
+		t.Parallel()
+
+		tokens, err := createProxy().GetAllDCDTTokens(context.Background(), address, queryOptions)
+		require.Nil(t, err)
+		assert.Equal(t, []*data.DCDTFungibleTokenData{
+			{TokenIdentifier: "ABC-001122", Balance: "20", Properties: "00", BlockInfo: api.BlockInfo{Nonce: 7}},
+			{TokenIdentifier: "TKN-001122", Balance: "10", BlockInfo: api.BlockInfo{Nonce: 7}},
+		}, tokens)
+	})
+	t.Run("non-fungible tokens", func(t *testing.T) {
+		t.Parallel()
+
+		nfts, err := createProxy().GetAllNFTTokens(context.Background(), address, queryOptions)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(nfts))
+		assert.Equal(t, "first", nfts[0].Name)
+		assert.Equal(t, uint64(1), nfts[0].Nonce)
+		assert.Equal(t, "second", nfts[1].Name)
+		assert.Equal(t, uint64(7), nfts[1].BlockInfo.Nonce)
+	})
+}
+
+func TestProxy_GetDCDTRoles(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String("drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw")
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(nil)))
+		roles, err := ep.GetDCDTRoles(context.Background(), data.NewAddressFromBytes(nil), api.AccountQueryOptions{})
+		assert.Nil(t, roles)
+		assert.Equal(t, ErrInvalidAddress, err)
+	})
+	t.Run("response error should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"error":"account not found"}`))))
+		roles, err := ep.GetDCDTRoles(context.Background(), address, api.AccountQueryOptions{})
+		assert.Nil(t, roles)
+		assert.Equal(t, "account not found", err.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		responseBytes := []byte(`{"data":{"roles":{"TKN-001122":["DCDTRoleLocalMint","DCDTRoleLocalBurn"]},"blockInfo":{"nonce":3}},"code":"successful"}`)
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes(responseBytes)))
+		roles, err := ep.GetDCDTRoles(context.Background(), address, api.AccountQueryOptions{})
+		require.Nil(t, err)
+		assert.True(t, roles.HasRole("TKN-001122", "DCDTRoleLocalBurn"))
+		assert.False(t, roles.HasRole("TKN-001122", "DCDTRoleNFTCreate"))
+		assert.False(t, roles.HasRole("ABC-001122", "DCDTRoleLocalMint"))
+		assert.Equal(t, uint64(3), roles.BlockInfo.Nonce)
+	})
+}
+
+func TestProxy_GetBlock(t *testing.T) {
+	t.Parallel()
+
+	responseBytes := []byte(`{"data":{"block":{"nonce":7,"hash":"aabb","shard":1,"miniBlocks":[{"hash":"mb",` +
+		`"transactions":[{"hash":"cc","logs":{"address":"drt1addr","events":[{"identifier":"transfer"}]}}]}]}},"code":"successful"}`)
+	createProxyWithLatestNonce := func(requestedURIs *[]string, latestNonce uint64) *proxy {
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				*requestedURIs = append(*requestedURIs, req.URL.RequestURI())
+
+				buff := responseBytes
+				if req.URL.Path == "/"+getNodeStatusEndpoint {
+					nodeStatusResponse := data.NodeStatusResponse{}
+					nodeStatusResponse.Data.Status = &data.NetworkStatus{Nonce: latestNonce, ShardID: 1}
+					buff, _ = json.Marshal(nodeStatusResponse)
+				}
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(buff)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		args := createMockArgsProxy(httpClient)
+		args.FilterQueryBlockCacher = storage.NewMapCacher()
+		ep, _ := NewProxy(args)
+
+		return ep
+	}
+	createProxy := func(requestedURIs *[]string) *proxy {
+		return createProxyWithLatestNonce(requestedURIs, 100)
+	}
+	fullBlockOptions := BlockQueryOptions{WithTransactions: true, WithLogs: true}
+
+	t.Run("empty hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURIs := make([]string, 0)
+		block, err := createProxy(&requestedURIs).GetBlockByHash(context.Background(), 1, "", fullBlockOptions)
+		assert.Nil(t, block)
+		assert.Equal(t, ErrEmptyBlockHash, err)
+		assert.Empty(t, requestedURIs)
+	})
+	t.Run("error response should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"error":"block not found"}`))))
+		block, err := ep.GetBlockByNonce(context.Background(), 1, 7, fullBlockOptions)
+		assert.Nil(t, block)
+		assert.Equal(t, "block not found", err.Error())
+	})
+	t.Run("missing block should error", func(t *testing.T) {
+		t.Parallel()
+
+		ep, _ := NewProxy(createMockArgsProxy(createMockClientRespondingBytes([]byte(`{"data":{},"code":"successful"}`))))
+		block, err := ep.GetBlockByHash(context.Background(), 1, "aabb", fullBlockOptions)
+		assert.Nil(t, block)
+		assert.Equal(t, ErrNilBlock, err)
+	})
+	t.Run("the options should be requested and the blocks cached", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURIs := make([]string, 0)
+		ep := createProxy(&requestedURIs)
+		for i := 0; i < 2; i++ {
+			_, err := ep.GetBlockByNonce(context.Background(), 1, 7, BlockQueryOptions{})
+			require.Nil(t, err)
+			_, err = ep.GetBlockByNonce(context.Background(), 1, 7, BlockQueryOptions{WithTransactions: true})
+			require.Nil(t, err)
+			block, err := ep.GetBlockByNonce(context.Background(), 1, 7, fullBlockOptions)
+			require.Nil(t, err)
+			assert.Equal(t, "transfer", block.MiniBlocks[0].Transactions[0].Logs.Events[0].Identifier)
+		}
+
+		assert.Equal(t, []string{
+			"/block/by-nonce/7",
+			"/node/status",
+			"/block/by-nonce/7?withTxs=true",
+			"/block/by-nonce/7?withTxs=true&withLogs=true",
+		}, requestedURIs)
+	})
+	t.Run("blocks above the final nonce should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURIs := make([]string, 0)
+		ep := createProxyWithLatestNonce(&requestedURIs, 7)
+		for i := 0; i < 2; i++ {
+			block, err := ep.GetBlockByNonce(context.Background(), 1, 7, fullBlockOptions)
+			require.Nil(t, err)
+			assert.Equal(t, uint64(7), block.Nonce)
+		}
+
+		assert.Equal(t, []string{
+			"/block/by-nonce/7?withTxs=true&withLogs=true",
+			"/node/status",
+			"/block/by-nonce/7?withTxs=true&withLogs=true",
+			"/node/status",
+		}, requestedURIs)
+	})
+	t.Run("partial blocks should be served from a cached full block", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURIs := make([]string, 0)
+		ep := createProxy(&requestedURIs)
+		block, err := ep.GetBlockByHash(context.Background(), 1, "aabb", fullBlockOptions)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), block.Nonce)
+
+		block, err = ep.GetBlockByNonce(context.Background(), 1, 7, BlockQueryOptions{WithTransactions: true})
+		require.Nil(t, err)
+		assert.Equal(t, "cc", block.MiniBlocks[0].Transactions[0].Hash)
+		assert.Nil(t, block.MiniBlocks[0].Transactions[0].Logs)
+
+		block, err = ep.GetBlockByNonce(context.Background(), 1, 7, BlockQueryOptions{WithLogs: true})
+		require.Nil(t, err)
+		assert.Equal(t, "mb", block.MiniBlocks[0].Hash)
+		assert.Nil(t, block.MiniBlocks[0].Transactions)
+
+		block, err = ep.GetBlockByNonce(context.Background(), 1, 7, fullBlockOptions)
+		require.Nil(t, err)
+		assert.NotNil(t, block.MiniBlocks[0].Transactions[0].Logs)
+
+		assert.Equal(t, []string{"/block/by-hash/aabb?withTxs=true&withLogs=true", "/node/status"}, requestedURIs)
+	})
+}
+
+func TestProxy_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("network data should be cached until expired or invalidated", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := 0
+		httpClient := createMockClientRespondingBytes([]byte(`{"data":{"config":{"drt_min_gas_price":1000000000}},"code":"successful"}`))
+		doCalled := httpClient.doCalled
+		httpClient.doCalled = func(req *http.Request) (*http.Response, error) {
+			numRequests++
+			return doCalled(req)
+		}
+		args := createMockArgsProxy(httpClient)
+		args.ResponseCacheConfig.TTLs = map[sdkHttp.EndpointClass]time.Duration{
+			sdkHttp.EndpointClassNetwork: time.Minute,
+		}
+		ep, _ := NewProxy(args)
+
+		for i := 0; i < 3; i++ {
+			_, err := ep.GetRatingsConfig(context.Background())
+			require.Nil(t, err)
+			_, err = ep.GetEnableEpochsConfig(context.Background())
+			require.Nil(t, err)
+		}
+		assert.Equal(t, 2, numRequests)
+		assert.Equal(t, ResponseCacheStats{Hits: 4, Misses: 2, Entries: 2}, ep.ResponseCacheStats()[sdkHttp.EndpointClassNetwork])
+
+		ep.InvalidateCachedResponses(sdkHttp.EndpointClassNetwork)
+		_, err := ep.GetRatingsConfig(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, 3, numRequests)
+	})
+	t.Run("network data should not be cached by default", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := 0
+		httpClient := createMockClientRespondingBytes([]byte(`{"data":{"config":{"drt_min_gas_price":1000000000}},"code":"successful"}`))
+		doCalled := httpClient.doCalled
+		httpClient.doCalled = func(req *http.Request) (*http.Response, error) {
+			numRequests++
+			return doCalled(req)
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		for i := 0; i < 3; i++ {
+			_, err := ep.GetRatingsConfig(context.Background())
+			require.Nil(t, err)
+		}
+		assert.Equal(t, 3, numRequests)
+		assert.Empty(t, ep.ResponseCacheStats())
+	})
+	createHyperBlockProxy := func(requestedURIs *[]string, latestMetaNonce uint64) *proxy {
+		httpClient := &mockHTTPClient{
+			doCalled: func(req *http.Request) (*http.Response, error) {
+				*requestedURIs = append(*requestedURIs, req.URL.RequestURI())
+
+				buff := []byte(`{"data":{"hyperblock":{"nonce":7,"hash":"aabb"}},"code":"successful"}`)
+				if req.URL.Path == "/"+getNodeStatusEndpoint {
+					nodeStatusResponse := data.NodeStatusResponse{}
+					nodeStatusResponse.Data.Status = &data.NetworkStatus{Nonce: latestMetaNonce, ShardID: core.MetachainShardId}
+					buff, _ = json.Marshal(nodeStatusResponse)
+				}
+
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(buff)),
+					StatusCode: http.StatusOK,
+				}, nil
+			},
+		}
+		ep, _ := NewProxy(createMockArgsProxy(httpClient))
+
+		return ep
+	}
+	t.Run("final hyper block should be cached by nonce and by hash", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURIs := make([]string, 0)
+		ep := createHyperBlockProxy(&requestedURIs, 100)
+
+		block, err := ep.GetHyperBlockByNonce(context.Background(), 7)
+		require.Nil(t, err)
+		assert.Equal(t, "aabb", block.Hash)
+		_, err = ep.GetHyperBlockByNonce(context.Background(), 7)
+		require.Nil(t, err)
+
+		block, err = ep.GetHyperBlockByHash(context.Background(), "aabb")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(7), block.Nonce)
+
+		assert.Equal(t, []string{"/hyperblock/by-nonce/7", "/node/status"}, requestedURIs)
+	})
+	t.Run("hyper block above the final nonce should not be cached by default", func(t *testing.T) {
+		t.Parallel()
+
+		requestedURIs := make([]string, 0)
+		ep := createHyperBlockProxy(&requestedURIs, 7)
+
+		_, err := ep.GetHyperBlockByHash(context.Background(), "aabb")
+		require.Nil(t, err)
+		_, err = ep.GetHyperBlockByNonce(context.Background(), 7)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{
+			"/hyperblock/by-hash/aabb",
+			"/node/status",
+			"/hyperblock/by-nonce/7",
+			"/node/status",
+		}, requestedURIs)
+	})
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsProxy(createMockClientRespondingBytes(nil))
+		args.ResponseCacheConfig.MaxEntries = -1
+		ep, err := NewProxy(args)
+		assert.Nil(t, ep)
+		assert.True(t, errors.Is(err, ErrInvalidResponseCacheMaxEntries))
+	})
+}
@@ -0,0 +1,240 @@
+package blockchain
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
+	"github.com/TerraDharitri/drt-go-sdk/core/metrics"
+)
+
+const defaultResponseCacheMaxEntries = 1000
+
+// ResponseCacheConfig holds the settings of the cache used for the read-only proxy responses
+type ResponseCacheConfig struct {
+	// TTLs holds the expiration time of the cached responses of each endpoint class. The responses of the classes
+	// without a TTL are not cached, except for the immutable ones, such as the final hyper blocks and the raw blocks
+	// fetched by hash, which never expire. No class has a TTL by default: the network economics, ratings and enable
+	// epochs configs are cached only if a TTL is set for the network class
+	TTLs map[sdkHttp.EndpointClass]time.Duration
+	// MaxEntries is the maximum number of cached responses. The least recently used ones are evicted first.
+	// Defaults to 1000
+	MaxEntries int
+}
+
+// ResponseCacheStats holds the counters of the response cache for an endpoint class
+type ResponseCacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Entries   int
+}
+
+type responseCacheEntry struct {
+	endpoint  string
+	class     sdkHttp.EndpointClass
+	data      []byte
+	expiresAt time.Time
+}
+
+// responseCache keeps the successful responses of the read-only endpoints, with a time to live per endpoint class.
+// This struct is concurrent safe.
+type responseCache struct {
+	ttls           map[sdkHttp.EndpointClass]time.Duration
+	maxEntries     int
+	metricsHandler sdkCore.MetricsHandler
+	nowHandler     func() time.Time
+
+	mut     sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	stats   map[sdkHttp.EndpointClass]*ResponseCacheStats
+}
+
+func newResponseCache(config ResponseCacheConfig, metricsHandler sdkCore.MetricsHandler) (*responseCache, error) {
+	err := checkResponseCacheConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ttls := config.TTLs
+	if ttls == nil {
+		ttls = make(map[sdkHttp.EndpointClass]time.Duration)
+	}
+	maxEntries := config.MaxEntries
+	if maxEntries == 0 {
+		maxEntries = defaultResponseCacheMaxEntries
+	}
+	if check.IfNil(metricsHandler) {
+		metricsHandler = &metrics.DisabledMetricsHandler{}
+	}
+
+	return &responseCache{
+		ttls:           ttls,
+		maxEntries:     maxEntries,
+		metricsHandler: metricsHandler,
+		nowHandler:     time.Now,
+		entries:        make(map[string]*list.Element),
+		lru:            list.New(),
+		stats:          make(map[sdkHttp.EndpointClass]*ResponseCacheStats),
+	}, nil
+}
+
+func checkResponseCacheConfig(config ResponseCacheConfig) error {
+	for class, ttl := range config.TTLs {
+		if ttl < 0 {
+			return fmt.Errorf("%w, provided: %v for the %s endpoint class", ErrInvalidResponseCacheTTL, ttl, class)
+		}
+	}
+	if config.MaxEntries < 0 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidResponseCacheMaxEntries, config.MaxEntries)
+	}
+
+	return nil
+}
+
+// get returns the cached response of the endpoint. Returns false if the endpoint's responses are not cacheable or if
+// the cached response is missing or expired
+func (cache *responseCache) get(endpoint string, isImmutable bool) ([]byte, bool) {
+	class := sdkHttp.ClassifyEndpoint(endpoint)
+	if !cache.isCacheable(class, isImmutable) {
+		return nil, false
+	}
+
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	stats := cache.getStats(class)
+	element, found := cache.entries[endpoint]
+	if found {
+		entry := element.Value.(*responseCacheEntry)
+		if entry.expiresAt.IsZero() || cache.nowHandler().Before(entry.expiresAt) {
+			cache.lru.MoveToFront(element)
+			stats.Hits++
+			cache.metricsHandler.ObserveCacheLookup(string(class), true)
+
+			return entry.data, true
+		}
+
+		cache.removeElement(element)
+	}
+
+	stats.Misses++
+	cache.metricsHandler.ObserveCacheLookup(string(class), false)
+
+	return nil, false
+}
+
+// put caches the response of the endpoint if it is a successful one. The immutable responses never expire
+func (cache *responseCache) put(endpoint string, response []byte, isImmutable bool) {
+	class := sdkHttp.ClassifyEndpoint(endpoint)
+	if !cache.isCacheable(class, isImmutable) || !isSuccessfulResponse(response) {
+		return
+	}
+
+	entry := &responseCacheEntry{
+		endpoint: endpoint,
+		class:    class,
+		data:     response,
+	}
+	if !isImmutable {
+		entry.expiresAt = cache.nowHandler().Add(cache.ttls[class])
+	}
+
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	element, found := cache.entries[endpoint]
+	if found {
+		cache.removeElement(element)
+	}
+
+	cache.entries[endpoint] = cache.lru.PushFront(entry)
+	cache.getStats(class).Entries++
+
+	for cache.lru.Len() > cache.maxEntries {
+		oldest := cache.lru.Back()
+		cache.getStats(oldest.Value.(*responseCacheEntry).class).Evictions++
+		cache.removeElement(oldest)
+	}
+}
+
+func (cache *responseCache) isCacheable(class sdkHttp.EndpointClass, isImmutable bool) bool {
+	return isImmutable || cache.ttls[class] > 0
+}
+
+// isSuccessfulResponse returns true if the response does not carry an error message
+func isSuccessfulResponse(response []byte) bool {
+	envelope := struct {
+		Error string `json:"error"`
+	}{}
+	err := json.Unmarshal(response, &envelope)
+
+	return err == nil && len(envelope.Error) == 0
+}
+
+// invalidate removes the cached responses of the provided endpoint classes, or all of them if no class is provided
+func (cache *responseCache) invalidate(classes ...sdkHttp.EndpointClass) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	if len(classes) == 0 {
+		cache.entries = make(map[string]*list.Element)
+		cache.lru.Init()
+		for _, stats := range cache.stats {
+			stats.Entries = 0
+		}
+
+		return
+	}
+
+	classesToRemove := make(map[sdkHttp.EndpointClass]struct{}, len(classes))
+	for _, class := range classes {
+		classesToRemove[class] = struct{}{}
+	}
+
+	for element := cache.lru.Front(); element != nil; {
+		next := element.Next()
+		if _, found := classesToRemove[element.Value.(*responseCacheEntry).class]; found {
+			cache.removeElement(element)
+		}
+		element = next
+	}
+}
+
+// getStats should be called under mutex protection
+func (cache *responseCache) getStats(class sdkHttp.EndpointClass) *ResponseCacheStats {
+	stats, found := cache.stats[class]
+	if !found {
+		stats = &ResponseCacheStats{}
+		cache.stats[class] = stats
+	}
+
+	return stats
+}
+
+// removeElement should be called under mutex protection
+func (cache *responseCache) removeElement(element *list.Element) {
+	entry := element.Value.(*responseCacheEntry)
+	cache.lru.Remove(element)
+	delete(cache.entries, entry.endpoint)
+	cache.getStats(entry.class).Entries--
+}
+
+// statistics returns the counters of each endpoint class that was used so far
+func (cache *responseCache) statistics() map[sdkHttp.EndpointClass]ResponseCacheStats {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	statistics := make(map[sdkHttp.EndpointClass]ResponseCacheStats, len(cache.stats))
+	for class, stats := range cache.stats {
+		statistics[class] = *stats
+	}
+
+	return statistics
+}
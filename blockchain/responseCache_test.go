@@ -0,0 +1,201 @@
+package blockchain
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var successfulResponse = []byte(`{"data":{},"error":"","code":"successful"}`)
+
+var testResponseCacheTTLs = map[sdkHttp.EndpointClass]time.Duration{
+	sdkHttp.EndpointClassNetwork: time.Minute,
+}
+
+func createResponseCacheWithTime(t *testing.T, config ResponseCacheConfig, now *time.Time) *responseCache {
+	if config.TTLs == nil {
+		config.TTLs = testResponseCacheTTLs
+	}
+	cache, err := newResponseCache(config, nil)
+	require.Nil(t, err)
+	cache.nowHandler = func() time.Time {
+		return *now
+	}
+
+	return cache
+}
+
+func TestNewResponseCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("negative TTL should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := newResponseCache(ResponseCacheConfig{
+			TTLs: map[sdkHttp.EndpointClass]time.Duration{
+				sdkHttp.EndpointClassBlocks: -time.Second,
+			},
+		}, nil)
+		assert.Nil(t, cache)
+		assert.True(t, errors.Is(err, ErrInvalidResponseCacheTTL))
+	})
+	t.Run("negative max entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := newResponseCache(ResponseCacheConfig{MaxEntries: -1}, nil)
+		assert.Nil(t, cache)
+		assert.True(t, errors.Is(err, ErrInvalidResponseCacheMaxEntries))
+	})
+	t.Run("should apply the defaults", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := newResponseCache(ResponseCacheConfig{}, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, defaultResponseCacheMaxEntries, cache.maxEntries)
+		assert.Empty(t, cache.ttls)
+	})
+}
+
+func TestResponseCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("mutable responses should expire", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		cache := createResponseCacheWithTime(t, ResponseCacheConfig{}, &now)
+
+		cache.put("network/economics", successfulResponse, false)
+		response, found := cache.get("network/economics", false)
+		assert.True(t, found)
+		assert.Equal(t, successfulResponse, response)
+
+		now = now.Add(time.Minute)
+		_, found = cache.get("network/economics", false)
+		assert.False(t, found)
+
+		stats := cache.statistics()[sdkHttp.EndpointClassNetwork]
+		assert.Equal(t, ResponseCacheStats{Hits: 1, Misses: 1, Entries: 0}, stats)
+	})
+	t.Run("immutable responses should never expire", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		cache := createResponseCacheWithTime(t, ResponseCacheConfig{}, &now)
+
+		cache.put("hyperblock/by-hash/aabb", successfulResponse, true)
+		now = now.Add(time.Hour * 24 * 365)
+		response, found := cache.get("hyperblock/by-hash/aabb", true)
+		assert.True(t, found)
+		assert.Equal(t, successfulResponse, response)
+	})
+	t.Run("classes without TTL should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		cache := createResponseCacheWithTime(t, ResponseCacheConfig{}, &now)
+
+		cache.put("hyperblock/by-nonce/7", successfulResponse, false)
+		_, found := cache.get("hyperblock/by-nonce/7", false)
+		assert.False(t, found)
+		assert.Empty(t, cache.statistics())
+	})
+	t.Run("mutable responses should not be cached by default", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := newResponseCache(ResponseCacheConfig{}, nil)
+
+		cache.put("network/economics", successfulResponse, false)
+		_, found := cache.get("network/economics", false)
+		assert.False(t, found)
+
+		cache.put("hyperblock/by-hash/aabb", successfulResponse, true)
+		_, found = cache.get("hyperblock/by-hash/aabb", true)
+		assert.True(t, found)
+	})
+	t.Run("error responses should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		cache := createResponseCacheWithTime(t, ResponseCacheConfig{}, &now)
+
+		cache.put("network/economics", []byte(`{"data":null,"error":"internal error","code":"internal_issue"}`), false)
+		cache.put("network/ratings", []byte("not a json"), false)
+		_, found := cache.get("network/economics", false)
+		assert.False(t, found)
+		_, found = cache.get("network/ratings", false)
+		assert.False(t, found)
+	})
+	t.Run("least recently used responses should be evicted", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		cache := createResponseCacheWithTime(t, ResponseCacheConfig{MaxEntries: 2}, &now)
+
+		cache.put("hyperblock/by-hash/01", successfulResponse, true)
+		cache.put("hyperblock/by-hash/02", successfulResponse, true)
+		_, _ = cache.get("hyperblock/by-hash/01", true)
+		cache.put("hyperblock/by-hash/03", successfulResponse, true)
+
+		_, found := cache.get("hyperblock/by-hash/01", true)
+		assert.True(t, found)
+		_, found = cache.get("hyperblock/by-hash/02", true)
+		assert.False(t, found)
+		_, found = cache.get("hyperblock/by-hash/03", true)
+		assert.True(t, found)
+
+		stats := cache.statistics()[sdkHttp.EndpointClassBlocks]
+		assert.Equal(t, ResponseCacheStats{Hits: 3, Misses: 1, Evictions: 1, Entries: 2}, stats)
+	})
+	t.Run("should report the lookups", func(t *testing.T) {
+		t.Parallel()
+
+		lookups := make([]string, 0)
+		cache, _ := newResponseCache(ResponseCacheConfig{TTLs: testResponseCacheTTLs}, &testsCommon.MetricsHandlerStub{
+			ObserveCacheLookupCalled: func(class string, isHit bool) {
+				lookups = append(lookups, fmt.Sprintf("%s %v", class, isHit))
+			},
+		})
+
+		_, _ = cache.get("network/economics", false)
+		cache.put("network/economics", successfulResponse, false)
+		_, _ = cache.get("network/economics", false)
+		assert.Equal(t, []string{"network false", "network true"}, lookups)
+	})
+}
+
+func TestResponseCache_Invalidate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cache := createResponseCacheWithTime(t, ResponseCacheConfig{}, &now)
+	fillCache := func() {
+		cache.put("network/economics", successfulResponse, false)
+		cache.put("network/genesis-nodes", successfulResponse, true)
+		cache.put("hyperblock/by-hash/aabb", successfulResponse, true)
+	}
+
+	fillCache()
+	cache.invalidate(sdkHttp.EndpointClassNetwork)
+	_, found := cache.get("network/economics", false)
+	assert.False(t, found)
+	_, found = cache.get("network/genesis-nodes", true)
+	assert.False(t, found)
+	_, found = cache.get("hyperblock/by-hash/aabb", true)
+	assert.True(t, found)
+
+	fillCache()
+	cache.invalidate()
+	_, found = cache.get("hyperblock/by-hash/aabb", true)
+	assert.False(t, found)
+	assert.Equal(t, 0, cache.lru.Len())
+	for _, stats := range cache.statistics() {
+		assert.Equal(t, 0, stats.Entries)
+	}
+}
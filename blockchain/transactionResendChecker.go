@@ -0,0 +1,110 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+type sendResponseCreator func(txHashes []string) ([]byte, error)
+
+// withSendTransactionsResendChecker attaches a resend checker to the context used when broadcasting the provided
+// transactions. Before a retry, the checker looks up the transactions by their hashes: if none of them is known by the
+// network, the request can be sent again; if all of them are known, the response is built from the computed hashes;
+// otherwise the request is not retried. If the hashes can not be computed (e.g. unsigned transactions), the request
+// will not be retried at all.
+func (ep *proxy) withSendTransactionsResendChecker(
+	ctx context.Context,
+	txs []*transaction.FrontendTransaction,
+	responseCreator sendResponseCreator,
+) context.Context {
+	txHashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txHash, err := builders.ComputeTransactionHash(tx)
+		if err != nil {
+			log.Debug("proxy: can not compute the transaction hash, the send request will not be retried", "error", err)
+			return ctx
+		}
+
+		txHashes = append(txHashes, hex.EncodeToString(txHash))
+	}
+
+	return sdkHttp.WithResendChecker(ctx, func(ctx context.Context) (bool, []byte, error) {
+		return ep.checkTransactionsResend(ctx, txHashes, responseCreator)
+	})
+}
+
+func (ep *proxy) checkTransactionsResend(
+	ctx context.Context,
+	txHashes []string,
+	responseCreator sendResponseCreator,
+) (bool, []byte, error) {
+	numKnown := 0
+	for _, txHash := range txHashes {
+		isKnown, err := ep.isTransactionKnown(ctx, txHash)
+		if err != nil {
+			return false, nil, err
+		}
+		if isKnown {
+			numKnown++
+		}
+	}
+
+	switch numKnown {
+	case 0:
+		return true, nil, nil
+	case len(txHashes):
+		response, err := responseCreator(txHashes)
+		return false, response, err
+	default:
+		log.Debug("proxy: some of the transactions were already sent, the send request will not be retried",
+			"num known", numKnown, "num transactions", len(txHashes))
+		return false, nil, nil
+	}
+}
+
+func (ep *proxy) isTransactionKnown(ctx context.Context, txHash string) (bool, error) {
+	buff, code, err := ep.GetHTTP(ctx, ep.endpointProvider.GetTransactionStatus(txHash))
+	if err != nil || code >= http.StatusInternalServerError {
+		return false, createHTTPStatusError(code, err)
+	}
+	if code != http.StatusOK {
+		return false, nil
+	}
+
+	response := &data.TransactionStatus{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return false, err
+	}
+
+	return len(response.Data.Status) > 0, nil
+}
+
+func createSendTransactionResponse(txHashes []string) ([]byte, error) {
+	response := &data.SendTransactionResponse{
+		Code: "successful",
+	}
+	response.Data.TxHash = txHashes[0]
+
+	return json.Marshal(response)
+}
+
+func createSendTransactionsResponse(txHashes []string) ([]byte, error) {
+	response := &data.SendTransactionsResponse{
+		Code: "successful",
+	}
+	response.Data.NumOfSentTxs = len(txHashes)
+	response.Data.TxsHashes = make(map[int]string, len(txHashes))
+	for idx, txHash := range txHashes {
+		response.Data.TxsHashes[idx] = txHash
+	}
+
+	return json.Marshal(response)
+}
@@ -0,0 +1,154 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	sdkHttp "github.com/TerraDharitri/drt-go-sdk/core/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockSignedTransaction(nonce uint64) *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Nonce:     nonce,
+		Value:     "50",
+		Receiver:  "drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya",
+		Sender:    "drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya",
+		GasPrice:  1000000000,
+		GasLimit:  50000,
+		ChainID:   "1",
+		Version:   1,
+		Signature: hex.EncodeToString(bytes.Repeat([]byte{1}, 64)),
+	}
+}
+
+func createRetryingArgsProxy(t *testing.T, httpClient *mockHTTPClient) ArgsProxy {
+	retryPolicy, err := sdkHttp.NewExponentialBackoffRetryPolicy(sdkHttp.ArgsExponentialBackoffRetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        time.Millisecond,
+		BackoffMultiplier: 1,
+	})
+	require.Nil(t, err)
+
+	args := createMockArgsProxy(httpClient)
+	args.RetryPolicy = retryPolicy
+
+	return args
+}
+
+func createSendTransactionMockClient(statusResponse []byte, statusCode int, numSendCalls *int32) *mockHTTPClient {
+	return &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/status") {
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(statusResponse)),
+					StatusCode: statusCode,
+				}, nil
+			}
+
+			atomic.AddInt32(numSendCalls, 1)
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+				StatusCode: http.StatusBadGateway,
+			}, nil
+		},
+	}
+}
+
+func TestProxy_SendTransactionWithRetries(t *testing.T) {
+	t.Parallel()
+
+	tx := createMockSignedTransaction(1)
+	txHash, err := builders.ComputeTransactionHash(tx)
+	require.Nil(t, err)
+	hexTxHash := hex.EncodeToString(txHash)
+
+	t.Run("unknown transaction should be sent again", func(t *testing.T) {
+		t.Parallel()
+
+		numSendCalls := int32(0)
+		httpClient := createSendTransactionMockClient([]byte(`{"error":"transaction not found"}`), http.StatusNotFound, &numSendCalls)
+		ep, _ := NewProxy(createRetryingArgsProxy(t, httpClient))
+
+		_, err := ep.SendTransaction(context.Background(), tx)
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numSendCalls))
+	})
+	t.Run("known transaction should not be sent again", func(t *testing.T) {
+		t.Parallel()
+
+		numSendCalls := int32(0)
+		httpClient := createSendTransactionMockClient([]byte(`{"data":{"status":"pending"},"code":"successful"}`), http.StatusOK, &numSendCalls)
+		ep, _ := NewProxy(createRetryingArgsProxy(t, httpClient))
+
+		hash, err := ep.SendTransaction(context.Background(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, hexTxHash, hash)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numSendCalls))
+
+		hashes, err := ep.SendTransactions(context.Background(), []*transaction.FrontendTransaction{tx, createMockSignedTransaction(2)})
+		assert.Nil(t, err)
+		require.Equal(t, 2, len(hashes))
+		assert.Equal(t, hexTxHash, hashes[0])
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numSendCalls))
+	})
+	t.Run("status check failure should not send the transaction again", func(t *testing.T) {
+		t.Parallel()
+
+		numSendCalls := int32(0)
+		httpClient := createSendTransactionMockClient(nil, http.StatusInternalServerError, &numSendCalls)
+		ep, _ := NewProxy(createRetryingArgsProxy(t, httpClient))
+
+		_, err := ep.SendTransaction(context.Background(), tx)
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numSendCalls))
+	})
+	t.Run("unsigned transaction should not be sent again", func(t *testing.T) {
+		t.Parallel()
+
+		numSendCalls := int32(0)
+		httpClient := createSendTransactionMockClient([]byte(`{"error":"transaction not found"}`), http.StatusNotFound, &numSendCalls)
+		ep, _ := NewProxy(createRetryingArgsProxy(t, httpClient))
+
+		unsignedTx := createMockSignedTransaction(1)
+		unsignedTx.Signature = ""
+		_, err := ep.SendTransaction(context.Background(), unsignedTx)
+		assert.NotNil(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numSendCalls))
+	})
+}
+
+func TestProxy_RequestTransactionCostShouldRetry(t *testing.T) {
+	t.Parallel()
+
+	numCalls := int32(0)
+	httpClient := &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			if atomic.AddInt32(&numCalls, 1) == 1 {
+				return &http.Response{
+					Body:       io.NopCloser(bytes.NewReader(nil)),
+					StatusCode: http.StatusServiceUnavailable,
+				}, nil
+			}
+
+			return createMockClientRespondingBytes([]byte(`{"data":{"txGasUnits":50000},"code":"successful"}`)).Do(req)
+		},
+	}
+	ep, _ := NewProxy(createRetryingArgsProxy(t, httpClient))
+
+	txCost, err := ep.RequestTransactionCost(context.Background(), createMockSignedTransaction(1))
+	require.Nil(t, err)
+	assert.Equal(t, uint64(50000), txCost.TxCost)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	"github.com/TerraDharitri/drt-go-sdk/builders"
 	"github.com/TerraDharitri/drt-go-sdk/data"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
@@ -50,7 +51,7 @@ func (dataGetter *vmQueryGetter) ExecuteQueryReturningBytes(ctx context.Context,
 		return nil, ErrNilRequest
 	}
 
-	response, err := dataGetter.proxy.ExecuteVMQuery(ctx, request)
+	response, err := dataGetter.proxy.ExecuteVMQuery(ctx, request, api.AccountQueryOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -140,6 +141,36 @@ func parseUInt64FromByteSlice(bytes []byte) (uint64, error) {
 	return num.Uint64(), nil
 }
 
+// ExecuteQueryReturningDecoded will try to execute the provided query and return the outputs decoded by the
+// provided decoder
+func (dataGetter *vmQueryGetter) ExecuteQueryReturningDecoded(
+	ctx context.Context,
+	request *data.VmValueRequest,
+	decoder OutputsDecoder,
+) ([]interface{}, error) {
+	if check.IfNil(decoder) {
+		return nil, ErrNilOutputsDecoder
+	}
+
+	response, err := dataGetter.ExecuteQueryReturningBytes(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := decoder.DecodeOutputs(request.FuncName, response)
+	if err != nil {
+		return nil, NewQueryResponseError(
+			internalError,
+			err.Error(),
+			request.FuncName,
+			request.Address,
+			request.Args...,
+		)
+	}
+
+	return results, nil
+}
+
 // ExecuteQueryFromBuilder will try to execute the provided query and return the result as slice of byte slices
 func (dataGetter *vmQueryGetter) ExecuteQueryFromBuilder(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error) {
 	vmValuesRequest, err := builder.ToVmValueRequest()
@@ -170,6 +201,21 @@ func (dataGetter *vmQueryGetter) ExecuteQueryBoolFromBuilder(ctx context.Context
 	return dataGetter.ExecuteQueryReturningBool(ctx, vmValuesRequest)
 }
 
+// ExecuteQueryDecodedFromBuilder will try to execute the provided query and return the outputs decoded by the
+// provided decoder
+func (dataGetter *vmQueryGetter) ExecuteQueryDecodedFromBuilder(
+	ctx context.Context,
+	builder builders.VMQueryBuilder,
+	decoder OutputsDecoder,
+) ([]interface{}, error) {
+	vmValuesRequest, err := builder.ToVmValueRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	return dataGetter.ExecuteQueryReturningDecoded(ctx, vmValuesRequest, decoder)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dataGetter *vmQueryGetter) IsInterfaceNil() bool {
 	return dataGetter == nil
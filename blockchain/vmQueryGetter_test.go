@@ -344,3 +344,85 @@ func TestNewVmQueryGetter_executeQueryWithErroredBuilder(t *testing.T) {
 	assert.True(t, errors.Is(err, builders.ErrInvalidValue))
 	assert.True(t, strings.Contains(err.Error(), "builder.ArgBytes"))
 }
+
+func TestNewVmQueryGetter_ExecuteQueryReturningDecoded(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil decoder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVmQueryGetter()
+		dg, _ := NewVmQueryGetter(args)
+
+		result, err := dg.ExecuteQueryReturningDecoded(context.Background(), &data.VmValueRequest{}, nil)
+		assert.Nil(t, result)
+		assert.Equal(t, ErrNilOutputsDecoder, err)
+	})
+	t.Run("query errors should be propagated", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVmQueryGetter()
+		dg, _ := NewVmQueryGetter(args)
+
+		result, err := dg.ExecuteQueryReturningDecoded(context.Background(), nil, &testsCommon.OutputsDecoderStub{})
+		assert.Nil(t, result)
+		assert.Equal(t, ErrNilRequest, err)
+	})
+	t.Run("decoder errors should be returned as query response errors", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVmQueryGetter()
+		args.Proxy = createMockProxy([][]byte{{1}})
+		dg, _ := NewVmQueryGetter(args)
+		decoder := &testsCommon.OutputsDecoderStub{
+			DecodeOutputsCalled: func(function string, returnData [][]byte) ([]interface{}, error) {
+				return nil, errors.New("decode error")
+			},
+		}
+
+		request := &data.VmValueRequest{
+			Address:  testSCAddressBech32,
+			FuncName: calledFunction,
+			Args:     calledArgs,
+		}
+		result, err := dg.ExecuteQueryReturningDecoded(context.Background(), request, decoder)
+		assert.Nil(t, result)
+		expectedErr := NewQueryResponseError(internalError, "decode error", calledFunction, testSCAddressBech32, calledArgs...)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVmQueryGetter()
+		args.Proxy = createMockProxy([][]byte{{1}, {2}})
+		dg, _ := NewVmQueryGetter(args)
+		decoder := &testsCommon.OutputsDecoderStub{
+			DecodeOutputsCalled: func(function string, returnData [][]byte) ([]interface{}, error) {
+				assert.Equal(t, calledFunction, function)
+				assert.Equal(t, [][]byte{{1}, {2}}, returnData)
+
+				return []interface{}{uint8(1), uint8(2)}, nil
+			},
+		}
+
+		result, err := dg.ExecuteQueryReturningDecoded(context.Background(), &data.VmValueRequest{FuncName: calledFunction}, decoder)
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{uint8(1), uint8(2)}, result)
+
+		builder := builders.NewVMQueryBuilder().Function(calledFunction)
+		result, err = dg.ExecuteQueryDecodedFromBuilder(context.Background(), builder, decoder)
+		assert.Nil(t, err)
+		assert.Equal(t, []interface{}{uint8(1), uint8(2)}, result)
+	})
+	t.Run("errored builder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVmQueryGetter()
+		dg, _ := NewVmQueryGetter(args)
+
+		builder := builders.NewVMQueryBuilder().ArgBytes(nil)
+		result, err := dg.ExecuteQueryDecodedFromBuilder(context.Background(), builder, &testsCommon.OutputsDecoderStub{})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, builders.ErrInvalidValue))
+	})
+}
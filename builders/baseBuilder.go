@@ -95,3 +95,26 @@ func (builder *baseBuilder) addArgBytes(bytes []byte) {
 
 	builder.addBytes(bytes)
 }
+
+// addArgsEncoded adds the arguments as returned by the encoder. The encoded arguments are added as they are, as the
+// empty arguments are valid top level encoded values
+func (builder *baseBuilder) addArgsEncoded(encoder ArgumentsEncoder, function string, values ...interface{}) {
+	if builder.err != nil {
+		return
+	}
+
+	if check.IfNil(encoder) {
+		builder.err = fmt.Errorf("%w in builder.ArgsEncoded", ErrNilArgumentsEncoder)
+		return
+	}
+
+	args, err := encoder.EncodeArguments(function, values...)
+	if err != nil {
+		builder.err = fmt.Errorf("%w in builder.ArgsEncoded for function %s", err, function)
+		return
+	}
+
+	for _, arg := range args {
+		builder.args = append(builder.args, hex.EncodeToString(arg))
+	}
+}
@@ -0,0 +1,156 @@
+package builders
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/blake2b"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const (
+	constructorFunction        = "init"
+	upgradeConstructorFunction = "upgrade"
+	upgradeContractFunction    = "upgradeContract"
+)
+
+// WasmVirtualMachine is the VM type of the contracts executed by the Wasm VM
+var WasmVirtualMachine = []byte{5, 0}
+
+var wasmMagicBytes = []byte{0, 'a', 's', 'm'}
+
+var codeHasher = blake2b.NewBlake2b()
+
+// LoadWasmCode reads the contract code from the provided .wasm file
+func LoadWasmCode(filename string) ([]byte, error) {
+	code, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(code, wasmMagicBytes) {
+		return nil, fmt.Errorf("%w, provided file: %s", ErrInvalidWasmCode, filename)
+	}
+
+	return code, nil
+}
+
+// ComputeCodeHash returns the hash of the provided contract code, as it is stored by the network in the contract's
+// account
+func ComputeCodeHash(code []byte) []byte {
+	return codeHasher.Compute(string(code))
+}
+
+// CheckCodeHash returns an error if the hash of the provided contract code does not match the expected hash
+func CheckCodeHash(code []byte, expectedHash []byte) error {
+	codeHash := ComputeCodeHash(code)
+	if !bytes.Equal(codeHash, expectedHash) {
+		return fmt.Errorf("%w, computed: %s, expected: %s",
+			ErrCodeHashMismatch, hex.EncodeToString(codeHash), hex.EncodeToString(expectedHash))
+	}
+
+	return nil
+}
+
+// baseContractBuilder holds the fields common to the contract deploy and upgrade builders
+type baseContractBuilder struct {
+	*baseBuilder
+	code             []byte
+	expectedCodeHash []byte
+	codeMetadata     vmcommon.CodeMetadata
+	value            *big.Int
+	gasLimit         uint64
+	senderAccount    *data.Account
+	networkConfig    *data.NetworkConfig
+}
+
+func newBaseContractBuilder() *baseContractBuilder {
+	return &baseContractBuilder{
+		baseBuilder: &baseBuilder{},
+		value:       big.NewInt(0),
+	}
+}
+
+func (builder *baseContractBuilder) setCodeFromFile(filename string) {
+	if builder.err != nil {
+		return
+	}
+
+	builder.code, builder.err = LoadWasmCode(filename)
+}
+
+func (builder *baseContractBuilder) setValue(value *big.Int) {
+	if builder.err != nil {
+		return
+	}
+
+	if value == nil {
+		builder.err = fmt.Errorf("%w in builder.SetValue", ErrNilValue)
+		return
+	}
+	if value.Sign() < 0 {
+		builder.err = fmt.Errorf("%w in builder.SetValue, provided: %s", ErrInvalidValue, value.String())
+		return
+	}
+
+	builder.value = big.NewInt(0).Set(value)
+}
+
+func (builder *baseContractBuilder) checkBeforeBuild() error {
+	if builder.err != nil {
+		return builder.err
+	}
+	if len(builder.code) == 0 {
+		return ErrEmptyCode
+	}
+	if len(builder.expectedCodeHash) > 0 {
+		err := CheckCodeHash(builder.code, builder.expectedCodeHash)
+		if err != nil {
+			return err
+		}
+	}
+	if builder.senderAccount == nil {
+		return ErrNilSenderAccount
+	}
+	if builder.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+	if builder.gasLimit == 0 {
+		return ErrInvalidGasLimit
+	}
+
+	return nil
+}
+
+// buildTransaction creates the unsigned transaction having the provided data and the arguments added on the builder
+func (builder *baseContractBuilder) buildTransaction(receiver string, dataPrefix string) *transaction.FrontendTransaction {
+	txData := dataPrefix
+	for _, arg := range builder.args {
+		txData += dataSeparator + arg
+	}
+
+	return &transaction.FrontendTransaction{
+		Nonce:    builder.senderAccount.Nonce,
+		Value:    builder.value.String(),
+		Receiver: receiver,
+		Sender:   builder.senderAccount.Address,
+		GasPrice: builder.networkConfig.MinGasPrice,
+		GasLimit: builder.gasLimit,
+		Data:     []byte(txData),
+		ChainID:  builder.networkConfig.ChainID,
+		Version:  builder.networkConfig.MinTransactionVersion,
+	}
+}
+
+func (builder *baseContractBuilder) codeMetadataHex() string {
+	return hex.EncodeToString(builder.codeMetadata.ToBytes())
+}
+
+func systemContractDeployReceiver() (string, error) {
+	return data.NewAddressFromBytes(make([]byte, core.AddressBytesLen)).AddressAsBech32String()
+}
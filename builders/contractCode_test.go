@@ -0,0 +1,57 @@
+package builders
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWasmFile = "testdata/empty.wasm"
+
+func TestLoadWasmCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := LoadWasmCode("testdata/missing.wasm")
+		assert.Nil(t, code)
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("not a wasm file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "contract.wasm")
+		err := os.WriteFile(filename, []byte("not wasm"), os.ModePerm)
+		require.Nil(t, err)
+
+		code, err := LoadWasmCode(filename)
+		assert.Nil(t, code)
+		assert.True(t, errors.Is(err, ErrInvalidWasmCode))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		code, err := LoadWasmCode(testWasmFile)
+		assert.Nil(t, err)
+		assert.Equal(t, "0061736d01000000", hex.EncodeToString(code))
+	})
+}
+
+func TestCheckCodeHash(t *testing.T) {
+	t.Parallel()
+
+	code, _ := LoadWasmCode(testWasmFile)
+	codeHash := ComputeCodeHash(code)
+	assert.Len(t, codeHash, 32)
+	assert.Nil(t, CheckCodeHash(code, codeHash))
+
+	err := CheckCodeHash(append(code, 0), codeHash)
+	assert.True(t, errors.Is(err, ErrCodeHashMismatch))
+	assert.Contains(t, err.Error(), hex.EncodeToString(codeHash))
+}
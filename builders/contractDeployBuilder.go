@@ -0,0 +1,182 @@
+package builders
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+type contractDeployBuilder struct {
+	*baseContractBuilder
+	vmType          []byte
+	addressComputer ContractAddressComputer
+}
+
+// NewContractDeployBuilder creates a new contract deploy transaction builder. The address computer is used to predict
+// the address of the deployed contract
+func NewContractDeployBuilder(addressComputer ContractAddressComputer) (*contractDeployBuilder, error) {
+	if check.IfNil(addressComputer) {
+		return nil, ErrNilContractAddressComputer
+	}
+
+	return &contractDeployBuilder{
+		baseContractBuilder: newBaseContractBuilder(),
+		vmType:              WasmVirtualMachine,
+		addressComputer:     addressComputer,
+	}, nil
+}
+
+// SetCode sets the contract code
+func (builder *contractDeployBuilder) SetCode(code []byte) *contractDeployBuilder {
+	builder.code = code
+
+	return builder
+}
+
+// SetCodeFromFile loads the contract code from the provided .wasm file
+func (builder *contractDeployBuilder) SetCodeFromFile(filename string) *contractDeployBuilder {
+	builder.setCodeFromFile(filename)
+
+	return builder
+}
+
+// SetExpectedCodeHash sets the hash the contract code should have. If set, the build fails on a mismatch
+func (builder *contractDeployBuilder) SetExpectedCodeHash(codeHash []byte) *contractDeployBuilder {
+	builder.expectedCodeHash = codeHash
+
+	return builder
+}
+
+// SetVMType sets the VM type of the contract. Defaults to the Wasm VM type
+func (builder *contractDeployBuilder) SetVMType(vmType []byte) *contractDeployBuilder {
+	builder.vmType = vmType
+
+	return builder
+}
+
+// SetCodeMetadata sets the code metadata flags of the contract
+func (builder *contractDeployBuilder) SetCodeMetadata(codeMetadata vmcommon.CodeMetadata) *contractDeployBuilder {
+	builder.codeMetadata = codeMetadata
+
+	return builder
+}
+
+// SetValue sets the value sent to the contract at deploy time
+func (builder *contractDeployBuilder) SetValue(value *big.Int) *contractDeployBuilder {
+	builder.setValue(value)
+
+	return builder
+}
+
+// SetGasLimit sets the gas limit of the transaction
+func (builder *contractDeployBuilder) SetGasLimit(gasLimit uint64) *contractDeployBuilder {
+	builder.gasLimit = gasLimit
+
+	return builder
+}
+
+// SetSenderAccount sets the account deploying the contract. Its nonce is used for the transaction and for the
+// predicted contract address
+func (builder *contractDeployBuilder) SetSenderAccount(account *data.Account) *contractDeployBuilder {
+	builder.senderAccount = account
+
+	return builder
+}
+
+// SetNetworkConfig sets the network config
+func (builder *contractDeployBuilder) SetNetworkConfig(config *data.NetworkConfig) *contractDeployBuilder {
+	builder.networkConfig = config
+
+	return builder
+}
+
+// ArgHexString adds the provided hex string to the init arguments list
+func (builder *contractDeployBuilder) ArgHexString(hexed string) *contractDeployBuilder {
+	builder.addArgHexString(hexed)
+
+	return builder
+}
+
+// ArgAddress adds the provided address to the init arguments list
+func (builder *contractDeployBuilder) ArgAddress(address core.AddressHandler) *contractDeployBuilder {
+	builder.addArgAddress(address)
+
+	return builder
+}
+
+// ArgBigInt adds the provided value to the init arguments list
+func (builder *contractDeployBuilder) ArgBigInt(value *big.Int) *contractDeployBuilder {
+	builder.addArgBigInt(value)
+
+	return builder
+}
+
+// ArgInt64 adds the provided value to the init arguments list
+func (builder *contractDeployBuilder) ArgInt64(value int64) *contractDeployBuilder {
+	builder.addArgInt64(value)
+
+	return builder
+}
+
+// ArgBytes adds the provided bytes to the init arguments list
+func (builder *contractDeployBuilder) ArgBytes(bytes []byte) *contractDeployBuilder {
+	builder.addArgBytes(bytes)
+
+	return builder
+}
+
+// ArgsEncoded adds the init arguments as encoded by the provided encoder for the contract's constructor
+func (builder *contractDeployBuilder) ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) *contractDeployBuilder {
+	builder.addArgsEncoded(encoder, constructorFunction, values...)
+
+	return builder
+}
+
+// Build builds the deploy transaction and returns it along with the predicted contract address. The address can only
+// be predicted for the Wasm VM type, so no address is returned for the other VM types.
+// The returned transaction will not be signed
+func (builder *contractDeployBuilder) Build() (*transaction.FrontendTransaction, core.AddressHandler, error) {
+	err := builder.checkBeforeBuild()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(builder.vmType) == 0 {
+		return nil, nil, fmt.Errorf("%w, empty VM type", ErrInvalidValue)
+	}
+
+	contractAddress, err := builder.computeContractAddress()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	receiver, err := systemContractDeployReceiver()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataPrefix := hex.EncodeToString(builder.code) + dataSeparator +
+		hex.EncodeToString(builder.vmType) + dataSeparator +
+		builder.codeMetadataHex()
+
+	return builder.buildTransaction(receiver, dataPrefix), contractAddress, nil
+}
+
+func (builder *contractDeployBuilder) computeContractAddress() (core.AddressHandler, error) {
+	if !bytes.Equal(builder.vmType, WasmVirtualMachine) {
+		return nil, nil
+	}
+
+	sender, err := data.NewAddressFromBech32String(builder.senderAccount.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.addressComputer.ComputeWasmVMScAddress(sender, builder.senderAccount.Nonce)
+}
@@ -0,0 +1,193 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testOwnerAddress    = "drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw"
+	testContractAddress = "drt1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqeyzkqc"
+)
+
+func createTestContractAddress(t *testing.T) core.AddressHandler {
+	address, err := data.NewAddressFromBech32String(testContractAddress)
+	require.Nil(t, err)
+
+	return address
+}
+
+func createContractTestNetworkConfig() *data.NetworkConfig {
+	return &data.NetworkConfig{
+		ChainID:               "T",
+		MinGasLimit:           50000,
+		GasPerDataByte:        1500,
+		MinGasPrice:           1000000000,
+		MinTransactionVersion: 2,
+	}
+}
+
+func createContractDeployBuilder(t *testing.T) *contractDeployBuilder {
+	builder, err := NewContractDeployBuilder(&testsCommon.ContractAddressComputerStub{
+		ComputeWasmVMScAddressCalled: func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+			bech32Address, _ := address.AddressAsBech32String()
+			assert.Equal(t, testOwnerAddress, bech32Address)
+			assert.Equal(t, uint64(7), nonce)
+
+			return data.NewAddressFromBech32String(testContractAddress)
+		},
+	})
+	require.Nil(t, err)
+
+	return builder.
+		SetSenderAccount(&data.Account{Address: testOwnerAddress, Nonce: 7}).
+		SetNetworkConfig(createContractTestNetworkConfig()).
+		SetGasLimit(60000000)
+}
+
+func TestNewContractDeployBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder, err := NewContractDeployBuilder(nil)
+	assert.Nil(t, builder)
+	assert.Equal(t, ErrNilContractAddressComputer, err)
+
+	builder, err = NewContractDeployBuilder(&testsCommon.ContractAddressComputerStub{})
+	assert.NotNil(t, builder)
+	assert.Nil(t, err)
+}
+
+func TestContractDeployBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createContractDeployBuilder(t)
+		_, _, err := builder.Build()
+		assert.Equal(t, ErrEmptyCode, err)
+
+		builder = createContractDeployBuilder(t).SetCodeFromFile(testWasmFile).SetSenderAccount(nil)
+		_, _, err = builder.Build()
+		assert.Equal(t, ErrNilSenderAccount, err)
+
+		builder = createContractDeployBuilder(t).SetCodeFromFile(testWasmFile).SetNetworkConfig(nil)
+		_, _, err = builder.Build()
+		assert.Equal(t, ErrNilNetworkConfig, err)
+
+		builder = createContractDeployBuilder(t).SetCodeFromFile(testWasmFile).SetGasLimit(0)
+		_, _, err = builder.Build()
+		assert.Equal(t, ErrInvalidGasLimit, err)
+
+		builder = createContractDeployBuilder(t).SetCodeFromFile(testWasmFile).SetVMType(nil)
+		_, _, err = builder.Build()
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("invalid setters should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder := createContractDeployBuilder(t).SetCodeFromFile("testdata/missing.wasm")
+		_, _, err := builder.Build()
+		assert.NotNil(t, err)
+
+		builder = createContractDeployBuilder(t).SetCodeFromFile(testWasmFile).SetValue(big.NewInt(-1))
+		_, _, err = builder.Build()
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		builder = createContractDeployBuilder(t).SetCodeFromFile(testWasmFile).ArgHexString("z")
+		_, _, err = builder.Build()
+		assert.NotNil(t, err)
+
+		builder = createContractDeployBuilder(t).SetCodeFromFile(testWasmFile).SetExpectedCodeHash([]byte("wrong"))
+		_, _, err = builder.Build()
+		assert.True(t, errors.Is(err, ErrCodeHashMismatch))
+	})
+	t.Run("address computer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		builder, _ := NewContractDeployBuilder(&testsCommon.ContractAddressComputerStub{
+			ComputeWasmVMScAddressCalled: func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+				return nil, expectedErr
+			},
+		})
+		builder.
+			SetCodeFromFile(testWasmFile).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress}).
+			SetNetworkConfig(createContractTestNetworkConfig()).
+			SetGasLimit(60000000)
+		tx, contractAddress, err := builder.Build()
+		assert.Nil(t, tx)
+		assert.Nil(t, contractAddress)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("other VM type should not predict the address", func(t *testing.T) {
+		t.Parallel()
+
+		builder, _ := NewContractDeployBuilder(&testsCommon.ContractAddressComputerStub{
+			ComputeWasmVMScAddressCalled: func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+				assert.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		})
+		builder.
+			SetCodeFromFile(testWasmFile).
+			SetVMType([]byte{7, 0}).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress, Nonce: 7}).
+			SetNetworkConfig(createContractTestNetworkConfig()).
+			SetGasLimit(60000000)
+		tx, contractAddress, err := builder.Build()
+		require.Nil(t, err)
+		assert.Nil(t, contractAddress)
+		assert.Equal(t, "0061736d01000000@0700@0000", string(tx.Data))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		code, _ := LoadWasmCode(testWasmFile)
+		encoder := &testsCommon.ArgumentsEncoderStub{
+			EncodeArgumentsCalled: func(function string, values ...interface{}) ([][]byte, error) {
+				assert.Equal(t, "init", function)
+				assert.Equal(t, []interface{}{5}, values)
+
+				return [][]byte{{5}, {}}, nil
+			},
+		}
+		builder := createContractDeployBuilder(t).
+			SetCodeFromFile(testWasmFile).
+			SetExpectedCodeHash(ComputeCodeHash(code)).
+			SetCodeMetadata(vmcommon.CodeMetadata{
+				Upgradeable: true,
+				Readable:    true,
+				Payable:     true,
+				PayableBySC: true,
+			}).
+			SetValue(big.NewInt(100)).
+			ArgBigInt(big.NewInt(10)).
+			ArgsEncoded(encoder, 5)
+
+		tx, contractAddress, err := builder.Build()
+		require.Nil(t, err)
+
+		bech32Address, _ := contractAddress.AddressAsBech32String()
+		assert.Equal(t, testContractAddress, bech32Address)
+		assert.Equal(t, "0061736d01000000@0500@0506@0a@05@", string(tx.Data))
+		assert.Equal(t, "drt1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq85hk5z", tx.Receiver)
+		assert.Equal(t, testOwnerAddress, tx.Sender)
+		assert.Equal(t, uint64(7), tx.Nonce)
+		assert.Equal(t, "100", tx.Value)
+		assert.Equal(t, uint64(60000000), tx.GasLimit)
+		assert.Equal(t, uint64(1000000000), tx.GasPrice)
+		assert.Equal(t, "T", tx.ChainID)
+		assert.Equal(t, uint32(2), tx.Version)
+		assert.Empty(t, tx.Signature)
+	})
+}
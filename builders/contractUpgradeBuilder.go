@@ -0,0 +1,153 @@
+package builders
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+type contractUpgradeBuilder struct {
+	*baseContractBuilder
+	contractAddress core.AddressHandler
+}
+
+// NewContractUpgradeBuilder creates a new contract upgrade transaction builder
+func NewContractUpgradeBuilder() *contractUpgradeBuilder {
+	return &contractUpgradeBuilder{
+		baseContractBuilder: newBaseContractBuilder(),
+	}
+}
+
+// SetContractAddress sets the address of the upgraded contract
+func (builder *contractUpgradeBuilder) SetContractAddress(address core.AddressHandler) *contractUpgradeBuilder {
+	builder.contractAddress = address
+
+	return builder
+}
+
+// SetCode sets the new contract code
+func (builder *contractUpgradeBuilder) SetCode(code []byte) *contractUpgradeBuilder {
+	builder.code = code
+
+	return builder
+}
+
+// SetCodeFromFile loads the new contract code from the provided .wasm file
+func (builder *contractUpgradeBuilder) SetCodeFromFile(filename string) *contractUpgradeBuilder {
+	builder.setCodeFromFile(filename)
+
+	return builder
+}
+
+// SetExpectedCodeHash sets the hash the new contract code should have. If set, the build fails on a mismatch
+func (builder *contractUpgradeBuilder) SetExpectedCodeHash(codeHash []byte) *contractUpgradeBuilder {
+	builder.expectedCodeHash = codeHash
+
+	return builder
+}
+
+// SetCodeMetadata sets the code metadata flags of the upgraded contract
+func (builder *contractUpgradeBuilder) SetCodeMetadata(codeMetadata vmcommon.CodeMetadata) *contractUpgradeBuilder {
+	builder.codeMetadata = codeMetadata
+
+	return builder
+}
+
+// SetValue sets the value sent to the contract at upgrade time
+func (builder *contractUpgradeBuilder) SetValue(value *big.Int) *contractUpgradeBuilder {
+	builder.setValue(value)
+
+	return builder
+}
+
+// SetGasLimit sets the gas limit of the transaction
+func (builder *contractUpgradeBuilder) SetGasLimit(gasLimit uint64) *contractUpgradeBuilder {
+	builder.gasLimit = gasLimit
+
+	return builder
+}
+
+// SetSenderAccount sets the account upgrading the contract, usually the contract's owner
+func (builder *contractUpgradeBuilder) SetSenderAccount(account *data.Account) *contractUpgradeBuilder {
+	builder.senderAccount = account
+
+	return builder
+}
+
+// SetNetworkConfig sets the network config
+func (builder *contractUpgradeBuilder) SetNetworkConfig(config *data.NetworkConfig) *contractUpgradeBuilder {
+	builder.networkConfig = config
+
+	return builder
+}
+
+// ArgHexString adds the provided hex string to the upgrade arguments list
+func (builder *contractUpgradeBuilder) ArgHexString(hexed string) *contractUpgradeBuilder {
+	builder.addArgHexString(hexed)
+
+	return builder
+}
+
+// ArgAddress adds the provided address to the upgrade arguments list
+func (builder *contractUpgradeBuilder) ArgAddress(address core.AddressHandler) *contractUpgradeBuilder {
+	builder.addArgAddress(address)
+
+	return builder
+}
+
+// ArgBigInt adds the provided value to the upgrade arguments list
+func (builder *contractUpgradeBuilder) ArgBigInt(value *big.Int) *contractUpgradeBuilder {
+	builder.addArgBigInt(value)
+
+	return builder
+}
+
+// ArgInt64 adds the provided value to the upgrade arguments list
+func (builder *contractUpgradeBuilder) ArgInt64(value int64) *contractUpgradeBuilder {
+	builder.addArgInt64(value)
+
+	return builder
+}
+
+// ArgBytes adds the provided bytes to the upgrade arguments list
+func (builder *contractUpgradeBuilder) ArgBytes(bytes []byte) *contractUpgradeBuilder {
+	builder.addArgBytes(bytes)
+
+	return builder
+}
+
+// ArgsEncoded adds the upgrade arguments as encoded by the provided encoder for the contract's upgrade constructor
+func (builder *contractUpgradeBuilder) ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) *contractUpgradeBuilder {
+	builder.addArgsEncoded(encoder, upgradeConstructorFunction, values...)
+
+	return builder
+}
+
+// Build builds the upgrade transaction
+// The returned transaction will not be signed
+func (builder *contractUpgradeBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := builder.checkBeforeBuild()
+	if err != nil {
+		return nil, err
+	}
+
+	err = builder.checkAddress(builder.contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := builder.contractAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	dataPrefix := upgradeContractFunction + dataSeparator +
+		hex.EncodeToString(builder.code) + dataSeparator +
+		builder.codeMetadataHex()
+
+	return builder.buildTransaction(receiver, dataPrefix), nil
+}
@@ -0,0 +1,79 @@
+package builders
+
+import (
+	"errors"
+	"testing"
+
+	vmcommon "github.com/TerraDharitri/drt-go-chain-vm-common"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createContractUpgradeBuilder(t *testing.T) *contractUpgradeBuilder {
+	contractAddress, err := data.NewAddressFromBech32String(testContractAddress)
+	require.Nil(t, err)
+
+	return NewContractUpgradeBuilder().
+		SetContractAddress(contractAddress).
+		SetCodeFromFile(testWasmFile).
+		SetSenderAccount(&data.Account{Address: testOwnerAddress, Nonce: 8}).
+		SetNetworkConfig(createContractTestNetworkConfig()).
+		SetGasLimit(50000000)
+}
+
+func TestContractUpgradeBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractUpgradeBuilder(t).SetContractAddress(nil).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrNilAddress))
+	})
+	t.Run("missing code should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createContractUpgradeBuilder(t).SetCode(nil).Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrEmptyCode, err)
+	})
+	t.Run("encoder error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		encoder := &testsCommon.ArgumentsEncoderStub{
+			EncodeArgumentsCalled: func(function string, values ...interface{}) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		tx, err := createContractUpgradeBuilder(t).ArgsEncoded(encoder).Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		encoder := &testsCommon.ArgumentsEncoderStub{
+			EncodeArgumentsCalled: func(function string, values ...interface{}) ([][]byte, error) {
+				assert.Equal(t, "upgrade", function)
+
+				return [][]byte{{1, 2}}, nil
+			},
+		}
+		tx, err := createContractUpgradeBuilder(t).
+			SetCodeMetadata(vmcommon.CodeMetadata{Upgradeable: true}).
+			ArgsEncoded(encoder).
+			Build()
+		require.Nil(t, err)
+
+		assert.Equal(t, "upgradeContract@0061736d01000000@0100@0102", string(tx.Data))
+		assert.Equal(t, testContractAddress, tx.Receiver)
+		assert.Equal(t, testOwnerAddress, tx.Sender)
+		assert.Equal(t, uint64(8), tx.Nonce)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(50000000), tx.GasLimit)
+	})
+}
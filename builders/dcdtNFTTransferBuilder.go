@@ -0,0 +1,137 @@
+package builders
+
+import (
+	"math/big"
+
+	drtChainCore "github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+type dcdtNFTTransferBuilder struct {
+	*baseTokenTransferBuilder
+	transfer *TokenTransfer
+}
+
+// NewDCDTNFTTransferBuilder creates a new builder for NFT, SFT or meta token transfers, optionally followed by a
+// contract call
+func NewDCDTNFTTransferBuilder() *dcdtNFTTransferBuilder {
+	return &dcdtNFTTransferBuilder{
+		baseTokenTransferBuilder: newBaseTokenTransferBuilder(),
+	}
+}
+
+// SetToken sets the token collection, the nonce and the amount to be transferred
+func (builder *dcdtNFTTransferBuilder) SetToken(tokenIdentifier string, nonce uint64, amount *big.Int) *dcdtNFTTransferBuilder {
+	builder.transfer = &TokenTransfer{
+		TokenIdentifier: tokenIdentifier,
+		Nonce:           nonce,
+		Amount:          amount,
+	}
+
+	return builder
+}
+
+// SetReceiver sets the receiver of the tokens
+func (builder *dcdtNFTTransferBuilder) SetReceiver(address core.AddressHandler) *dcdtNFTTransferBuilder {
+	builder.receiver = address
+
+	return builder
+}
+
+// SetSenderAccount sets the account sending the tokens
+func (builder *dcdtNFTTransferBuilder) SetSenderAccount(account *data.Account) *dcdtNFTTransferBuilder {
+	builder.senderAccount = account
+
+	return builder
+}
+
+// SetNetworkConfig sets the network config
+func (builder *dcdtNFTTransferBuilder) SetNetworkConfig(config *data.NetworkConfig) *dcdtNFTTransferBuilder {
+	builder.networkConfig = config
+
+	return builder
+}
+
+// SetFunction sets the contract function called after the transfer
+func (builder *dcdtNFTTransferBuilder) SetFunction(function string) *dcdtNFTTransferBuilder {
+	builder.function = function
+
+	return builder
+}
+
+// SetExtraGasLimit sets the gas needed by the called contract function, added on top of the estimated gas limit
+func (builder *dcdtNFTTransferBuilder) SetExtraGasLimit(gasLimit uint64) *dcdtNFTTransferBuilder {
+	builder.extraGasLimit = gasLimit
+
+	return builder
+}
+
+// ArgHexString adds the provided hex string to the function arguments list
+func (builder *dcdtNFTTransferBuilder) ArgHexString(hexed string) *dcdtNFTTransferBuilder {
+	builder.addArgHexString(hexed)
+
+	return builder
+}
+
+// ArgAddress adds the provided address to the function arguments list
+func (builder *dcdtNFTTransferBuilder) ArgAddress(address core.AddressHandler) *dcdtNFTTransferBuilder {
+	builder.addArgAddress(address)
+
+	return builder
+}
+
+// ArgBigInt adds the provided value to the function arguments list
+func (builder *dcdtNFTTransferBuilder) ArgBigInt(value *big.Int) *dcdtNFTTransferBuilder {
+	builder.addArgBigInt(value)
+
+	return builder
+}
+
+// ArgInt64 adds the provided value to the function arguments list
+func (builder *dcdtNFTTransferBuilder) ArgInt64(value int64) *dcdtNFTTransferBuilder {
+	builder.addArgInt64(value)
+
+	return builder
+}
+
+// ArgBytes adds the provided bytes to the function arguments list
+func (builder *dcdtNFTTransferBuilder) ArgBytes(bytes []byte) *dcdtNFTTransferBuilder {
+	builder.addArgBytes(bytes)
+
+	return builder
+}
+
+// ArgsEncoded adds the function arguments as encoded by the provided encoder. The function should be set beforehand
+func (builder *dcdtNFTTransferBuilder) ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) *dcdtNFTTransferBuilder {
+	builder.addArgsEncoded(encoder, builder.function, values...)
+
+	return builder
+}
+
+// Build builds the NFT transfer transaction. As required by the protocol, the transaction is sent to the sender itself
+// and the real receiver is passed as argument
+// The returned transaction will not be signed
+func (builder *dcdtNFTTransferBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := builder.checkBeforeBuild()
+	if err != nil {
+		return nil, err
+	}
+	err = checkTokenTransfer(builder.transfer)
+	if err != nil {
+		return nil, err
+	}
+	if builder.transfer.Nonce == 0 {
+		return nil, ErrInvalidTokenNonce
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(drtChainCore.BuiltInFunctionDCDTNFTTransfer).
+		ArgBytes([]byte(builder.transfer.TokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(builder.transfer.Nonce)).
+		ArgBigInt(builder.transfer.Amount).
+		ArgAddress(builder.receiver)
+
+	return builder.buildTransaction(builder.senderAccount.Address, dataBuilder, GasLimitDCDTNFTTransfer+AdditionalGasForNFTTransfer)
+}
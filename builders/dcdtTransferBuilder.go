@@ -0,0 +1,134 @@
+package builders
+
+import (
+	"math/big"
+
+	drtChainCore "github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+type dcdtTransferBuilder struct {
+	*baseTokenTransferBuilder
+	transfer *TokenTransfer
+}
+
+// NewDCDTTransferBuilder creates a new builder for fungible token transfers, optionally followed by a contract call
+func NewDCDTTransferBuilder() *dcdtTransferBuilder {
+	return &dcdtTransferBuilder{
+		baseTokenTransferBuilder: newBaseTokenTransferBuilder(),
+	}
+}
+
+// SetToken sets the fungible token and the amount to be transferred
+func (builder *dcdtTransferBuilder) SetToken(tokenIdentifier string, amount *big.Int) *dcdtTransferBuilder {
+	builder.transfer = &TokenTransfer{
+		TokenIdentifier: tokenIdentifier,
+		Amount:          amount,
+	}
+
+	return builder
+}
+
+// SetReceiver sets the receiver of the tokens
+func (builder *dcdtTransferBuilder) SetReceiver(address core.AddressHandler) *dcdtTransferBuilder {
+	builder.receiver = address
+
+	return builder
+}
+
+// SetSenderAccount sets the account sending the tokens
+func (builder *dcdtTransferBuilder) SetSenderAccount(account *data.Account) *dcdtTransferBuilder {
+	builder.senderAccount = account
+
+	return builder
+}
+
+// SetNetworkConfig sets the network config
+func (builder *dcdtTransferBuilder) SetNetworkConfig(config *data.NetworkConfig) *dcdtTransferBuilder {
+	builder.networkConfig = config
+
+	return builder
+}
+
+// SetFunction sets the contract function called after the transfer
+func (builder *dcdtTransferBuilder) SetFunction(function string) *dcdtTransferBuilder {
+	builder.function = function
+
+	return builder
+}
+
+// SetExtraGasLimit sets the gas needed by the called contract function, added on top of the estimated gas limit
+func (builder *dcdtTransferBuilder) SetExtraGasLimit(gasLimit uint64) *dcdtTransferBuilder {
+	builder.extraGasLimit = gasLimit
+
+	return builder
+}
+
+// ArgHexString adds the provided hex string to the function arguments list
+func (builder *dcdtTransferBuilder) ArgHexString(hexed string) *dcdtTransferBuilder {
+	builder.addArgHexString(hexed)
+
+	return builder
+}
+
+// ArgAddress adds the provided address to the function arguments list
+func (builder *dcdtTransferBuilder) ArgAddress(address core.AddressHandler) *dcdtTransferBuilder {
+	builder.addArgAddress(address)
+
+	return builder
+}
+
+// ArgBigInt adds the provided value to the function arguments list
+func (builder *dcdtTransferBuilder) ArgBigInt(value *big.Int) *dcdtTransferBuilder {
+	builder.addArgBigInt(value)
+
+	return builder
+}
+
+// ArgInt64 adds the provided value to the function arguments list
+func (builder *dcdtTransferBuilder) ArgInt64(value int64) *dcdtTransferBuilder {
+	builder.addArgInt64(value)
+
+	return builder
+}
+
+// ArgBytes adds the provided bytes to the function arguments list
+func (builder *dcdtTransferBuilder) ArgBytes(bytes []byte) *dcdtTransferBuilder {
+	builder.addArgBytes(bytes)
+
+	return builder
+}
+
+// ArgsEncoded adds the function arguments as encoded by the provided encoder. The function should be set beforehand
+func (builder *dcdtTransferBuilder) ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) *dcdtTransferBuilder {
+	builder.addArgsEncoded(encoder, builder.function, values...)
+
+	return builder
+}
+
+// Build builds the fungible token transfer transaction, sent directly to the receiver
+// The returned transaction will not be signed
+func (builder *dcdtTransferBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := builder.checkBeforeBuild()
+	if err != nil {
+		return nil, err
+	}
+	err = checkTokenTransfer(builder.transfer)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := builder.receiver.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(drtChainCore.BuiltInFunctionDCDTTransfer).
+		ArgBytes([]byte(builder.transfer.TokenIdentifier)).
+		ArgBigInt(builder.transfer.Amount)
+
+	return builder.buildTransaction(receiver, dataBuilder, GasLimitDCDTTransfer+AdditionalGasForTokenTransfer)
+}
@@ -49,3 +49,42 @@ var ErrGuardianDoesNotMatch = errors.New("configured guardian does not match sig
 
 // ErrRelayerDoesNotMatch signals a mismatch between the configured relayer in tx and the signing relayer address
 var ErrRelayerDoesNotMatch = errors.New("configured relayer does not match signing relayer")
+
+// ErrNilArgumentsEncoder signals that a nil arguments encoder was provided
+var ErrNilArgumentsEncoder = errors.New("nil arguments encoder")
+
+// ErrNilContractAddressComputer signals that a nil contract address computer was provided
+var ErrNilContractAddressComputer = errors.New("nil contract address computer")
+
+// ErrEmptyCode signals that an empty contract code was provided
+var ErrEmptyCode = errors.New("empty contract code")
+
+// ErrInvalidWasmCode signals that the provided contract code is not a WebAssembly module
+var ErrInvalidWasmCode = errors.New("invalid wasm code")
+
+// ErrCodeHashMismatch signals that the hash of the contract code does not match the expected one
+var ErrCodeHashMismatch = errors.New("code hash mismatch")
+
+// ErrNilSenderAccount signals that a nil sender account was provided
+var ErrNilSenderAccount = errors.New("nil sender account")
+
+// ErrInvalidGasLimit signals that an invalid gas limit was provided
+var ErrInvalidGasLimit = errors.New("invalid gas limit")
+
+// ErrEmptyTokenIdentifier signals that an empty token identifier was provided
+var ErrEmptyTokenIdentifier = errors.New("empty token identifier")
+
+// ErrInvalidTokenNonce signals that an invalid token nonce was provided
+var ErrInvalidTokenNonce = errors.New("invalid token nonce")
+
+// ErrNoTokenTransfers signals that no token transfers were provided
+var ErrNoTokenTransfers = errors.New("no token transfers")
+
+// ErrMissingFunction signals that function arguments were provided without the function
+var ErrMissingFunction = errors.New("missing function")
+
+// ErrNoRoles signals that no special roles were provided
+var ErrNoRoles = errors.New("no roles")
+
+// ErrIssuedTokenNotFound signals that the identifier of the issued token was not found in the transaction outcome
+var ErrIssuedTokenNotFound = errors.New("issued token not found")
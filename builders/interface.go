@@ -19,6 +19,7 @@ type TxDataBuilder interface {
 	ArgInt64(value int64) TxDataBuilder
 	ArgBytes(bytes []byte) TxDataBuilder
 	ArgBytesList(list [][]byte) TxDataBuilder
+	ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) TxDataBuilder
 
 	ToDataString() (string, error)
 	ToDataBytes() ([]byte, error)
@@ -37,12 +38,50 @@ type VMQueryBuilder interface {
 	ArgBigInt(value *big.Int) VMQueryBuilder
 	ArgInt64(value int64) VMQueryBuilder
 	ArgBytes(bytes []byte) VMQueryBuilder
+	ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) VMQueryBuilder
 
 	ToVmValueRequest() (*data.VmValueRequest, error)
 
 	IsInterfaceNil() bool
 }
 
+// ArgumentsEncoder defines the behavior of a component able to encode typed values as the arguments of a smart
+// contract endpoint
+type ArgumentsEncoder interface {
+	EncodeArguments(function string, values ...interface{}) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// ContractAddressComputer defines the component able to compute the address of a contract deployed by the provided
+// owner with the provided nonce
+type ContractAddressComputer interface {
+	ComputeWasmVMScAddress(address core.AddressHandler, nonce uint64) (core.AddressHandler, error)
+	IsInterfaceNil() bool
+}
+
+// StorageKeyBuilder defines the behavior of a smart contract storage key builder
+type StorageKeyBuilder interface {
+	ArgAddress(address core.AddressHandler) StorageKeyBuilder
+	ArgBigUint(value *big.Int) StorageKeyBuilder
+	ArgUint32(value uint32) StorageKeyBuilder
+	ArgUint64(value uint64) StorageKeyBuilder
+	ArgBytes(bytes []byte) StorageKeyBuilder
+	ArgString(value string) StorageKeyBuilder
+	ArgRaw(bytes []byte) StorageKeyBuilder
+
+	ToBytes() ([]byte, error)
+	ToHexString() (string, error)
+	VecMapperLengthKey() ([]byte, error)
+	VecMapperItemKey(index uint32) ([]byte, error)
+	SetMapperInfoKey() ([]byte, error)
+	SetMapperNodeIDKey(nestedValue []byte) ([]byte, error)
+	SetMapperValueKey(nodeID uint32) ([]byte, error)
+	SetMapperNodeLinksKey(nodeID uint32) ([]byte, error)
+	MapMapperValueKey(nestedKey []byte) ([]byte, error)
+
+	IsInterfaceNil() bool
+}
+
 // Signer defines the method used by a struct used to create valid signatures
 type Signer interface {
 	SignMessage(msg []byte, privateKey crypto.PrivateKey) ([]byte, error)
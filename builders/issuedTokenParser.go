@@ -0,0 +1,110 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	drtChainCore "github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const (
+	okReturnCodeHex          = "6f6b"
+	tokenIdentifierSeparator = "-"
+)
+
+var issueEventIdentifiers = map[string]struct{}{
+	issueFungibleFunction:     {},
+	issueNonFungibleFunction:  {},
+	issueSemiFungibleFunction: {},
+	registerMetaDCDTFunction:  {},
+	"registerAndSetAllRoles":  {},
+}
+
+// ParseIssuedTokenIdentifier returns the identifier of the token issued by the provided transaction. The identifier is
+// searched in the issue events of the transaction logs and then in the smart contract results
+func ParseIssuedTokenIdentifier(outcome *data.TransactionOutcome) (string, error) {
+	if outcome == nil {
+		return "", fmt.Errorf("%w for the transaction outcome", ErrNilValue)
+	}
+
+	tokenIdentifier, found := parseIssuedTokenFromLogs(outcome.Logs)
+	if found {
+		return tokenIdentifier, nil
+	}
+
+	tokenIdentifier, found = parseIssuedTokenFromSCResults(outcome.ScResults)
+	if found {
+		return tokenIdentifier, nil
+	}
+
+	return "", fmt.Errorf("%w in transaction %s", ErrIssuedTokenNotFound, outcome.TxHash)
+}
+
+// parseIssuedTokenFromLogs searches the issue event emitted by the system DCDT contract. Its first topic is the
+// identifier of the new token
+func parseIssuedTokenFromLogs(logs *transaction.ApiLogs) (string, bool) {
+	if logs == nil {
+		return "", false
+	}
+
+	for _, event := range logs.Events {
+		if event == nil || len(event.Topics) == 0 {
+			continue
+		}
+		_, isIssueEvent := issueEventIdentifiers[event.Identifier]
+		if !isIssueEvent {
+			continue
+		}
+
+		tokenIdentifier := string(event.Topics[0])
+		if isTokenIdentifier(tokenIdentifier) {
+			return tokenIdentifier, true
+		}
+	}
+
+	return "", false
+}
+
+// parseIssuedTokenFromSCResults searches the smart contract results for either the transfer of the initial supply of a
+// fungible token (DCDTTransfer@<token>@<supply>) or the callback of a collection issue (@ok@<token>)
+func parseIssuedTokenFromSCResults(scResults []*transaction.ApiSmartContractResult) (string, bool) {
+	for _, scr := range scResults {
+		if scr == nil {
+			continue
+		}
+
+		parts := strings.Split(scr.Data, dataSeparator)
+		if len(parts) < 2 {
+			continue
+		}
+
+		hexTokenIdentifier := ""
+		switch {
+		case parts[0] == drtChainCore.BuiltInFunctionDCDTTransfer:
+			hexTokenIdentifier = parts[1]
+		case len(parts[0]) == 0 && parts[1] == okReturnCodeHex && len(parts) > 2:
+			hexTokenIdentifier = parts[2]
+		default:
+			continue
+		}
+
+		tokenIdentifier, err := hex.DecodeString(hexTokenIdentifier)
+		if err != nil {
+			continue
+		}
+		if isTokenIdentifier(string(tokenIdentifier)) {
+			return string(tokenIdentifier), true
+		}
+	}
+
+	return "", false
+}
+
+func isTokenIdentifier(identifier string) bool {
+	parts := strings.Split(identifier, tokenIdentifierSeparator)
+
+	return len(parts) == 2 && len(parts[0]) > 0 && len(parts[1]) > 0
+}
@@ -0,0 +1,80 @@
+package builders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIssuedTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil outcome should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokenIdentifier, err := ParseIssuedTokenIdentifier(nil)
+		assert.Empty(t, tokenIdentifier)
+		assert.True(t, errors.Is(err, ErrNilValue))
+	})
+	t.Run("missing token should error", func(t *testing.T) {
+		t.Parallel()
+
+		outcome := &data.TransactionOutcome{
+			TxHash: "hash",
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					nil,
+					{Identifier: "writeLog", Topics: [][]byte{[]byte(testTokenIdentifier)}},
+					{Identifier: "issue", Topics: [][]byte{[]byte("invalid")}},
+				},
+			},
+			ScResults: []*transaction.ApiSmartContractResult{
+				nil,
+				{Data: "@6f6b"},
+				{Data: "@6f6b@zz"},
+				{Data: "@04@" + hexTokenIdentifier},
+				{Data: "DCDTTransfer@696e76616c6964"},
+			},
+		}
+		tokenIdentifier, err := ParseIssuedTokenIdentifier(outcome)
+		assert.Empty(t, tokenIdentifier)
+		assert.True(t, errors.Is(err, ErrIssuedTokenNotFound))
+		assert.Contains(t, err.Error(), "hash")
+	})
+	t.Run("should find the token in the issue events", func(t *testing.T) {
+		t.Parallel()
+
+		for _, identifier := range []string{"issue", "issueNonFungible", "issueSemiFungible", "registerMetaDCDT"} {
+			outcome := &data.TransactionOutcome{
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						{Identifier: identifier, Topics: [][]byte{[]byte(testTokenIdentifier), []byte("MYTOKEN")}},
+					},
+				},
+			}
+			tokenIdentifier, err := ParseIssuedTokenIdentifier(outcome)
+			assert.Nil(t, err)
+			assert.Equal(t, testTokenIdentifier, tokenIdentifier)
+		}
+	})
+	t.Run("should find the token in the smart contract results", func(t *testing.T) {
+		t.Parallel()
+
+		outcome := &data.TransactionOutcome{
+			ScResults: []*transaction.ApiSmartContractResult{
+				{Data: "DCDTTransfer@" + hexTokenIdentifier + "@03e8"},
+			},
+		}
+		tokenIdentifier, err := ParseIssuedTokenIdentifier(outcome)
+		assert.Nil(t, err)
+		assert.Equal(t, testTokenIdentifier, tokenIdentifier)
+
+		outcome.ScResults = []*transaction.ApiSmartContractResult{{Data: "@6f6b@" + hexTokenIdentifier}}
+		tokenIdentifier, err = ParseIssuedTokenIdentifier(outcome)
+		assert.Nil(t, err)
+		assert.Equal(t, testTokenIdentifier, tokenIdentifier)
+	})
+}
@@ -0,0 +1,140 @@
+package builders
+
+import (
+	"math/big"
+
+	drtChainCore "github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+type multiDCDTNFTTransferBuilder struct {
+	*baseTokenTransferBuilder
+	transfers []*TokenTransfer
+}
+
+// NewMultiDCDTNFTTransferBuilder creates a new builder for transfers of multiple fungible or non-fungible tokens in
+// the same transaction, optionally followed by a contract call
+func NewMultiDCDTNFTTransferBuilder() *multiDCDTNFTTransferBuilder {
+	return &multiDCDTNFTTransferBuilder{
+		baseTokenTransferBuilder: newBaseTokenTransferBuilder(),
+	}
+}
+
+// AddTransfers adds the provided token transfers. A 0 nonce denotes a fungible token
+func (builder *multiDCDTNFTTransferBuilder) AddTransfers(transfers ...*TokenTransfer) *multiDCDTNFTTransferBuilder {
+	builder.transfers = append(builder.transfers, transfers...)
+
+	return builder
+}
+
+// SetReceiver sets the receiver of the tokens
+func (builder *multiDCDTNFTTransferBuilder) SetReceiver(address core.AddressHandler) *multiDCDTNFTTransferBuilder {
+	builder.receiver = address
+
+	return builder
+}
+
+// SetSenderAccount sets the account sending the tokens
+func (builder *multiDCDTNFTTransferBuilder) SetSenderAccount(account *data.Account) *multiDCDTNFTTransferBuilder {
+	builder.senderAccount = account
+
+	return builder
+}
+
+// SetNetworkConfig sets the network config
+func (builder *multiDCDTNFTTransferBuilder) SetNetworkConfig(config *data.NetworkConfig) *multiDCDTNFTTransferBuilder {
+	builder.networkConfig = config
+
+	return builder
+}
+
+// SetFunction sets the contract function called after the transfer
+func (builder *multiDCDTNFTTransferBuilder) SetFunction(function string) *multiDCDTNFTTransferBuilder {
+	builder.function = function
+
+	return builder
+}
+
+// SetExtraGasLimit sets the gas needed by the called contract function, added on top of the estimated gas limit
+func (builder *multiDCDTNFTTransferBuilder) SetExtraGasLimit(gasLimit uint64) *multiDCDTNFTTransferBuilder {
+	builder.extraGasLimit = gasLimit
+
+	return builder
+}
+
+// ArgHexString adds the provided hex string to the function arguments list
+func (builder *multiDCDTNFTTransferBuilder) ArgHexString(hexed string) *multiDCDTNFTTransferBuilder {
+	builder.addArgHexString(hexed)
+
+	return builder
+}
+
+// ArgAddress adds the provided address to the function arguments list
+func (builder *multiDCDTNFTTransferBuilder) ArgAddress(address core.AddressHandler) *multiDCDTNFTTransferBuilder {
+	builder.addArgAddress(address)
+
+	return builder
+}
+
+// ArgBigInt adds the provided value to the function arguments list
+func (builder *multiDCDTNFTTransferBuilder) ArgBigInt(value *big.Int) *multiDCDTNFTTransferBuilder {
+	builder.addArgBigInt(value)
+
+	return builder
+}
+
+// ArgInt64 adds the provided value to the function arguments list
+func (builder *multiDCDTNFTTransferBuilder) ArgInt64(value int64) *multiDCDTNFTTransferBuilder {
+	builder.addArgInt64(value)
+
+	return builder
+}
+
+// ArgBytes adds the provided bytes to the function arguments list
+func (builder *multiDCDTNFTTransferBuilder) ArgBytes(bytes []byte) *multiDCDTNFTTransferBuilder {
+	builder.addArgBytes(bytes)
+
+	return builder
+}
+
+// ArgsEncoded adds the function arguments as encoded by the provided encoder. The function should be set beforehand
+func (builder *multiDCDTNFTTransferBuilder) ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) *multiDCDTNFTTransferBuilder {
+	builder.addArgsEncoded(encoder, builder.function, values...)
+
+	return builder
+}
+
+// Build builds the multi token transfer transaction. As required by the protocol, the transaction is sent to the
+// sender itself and the real receiver is passed as argument
+// The returned transaction will not be signed
+func (builder *multiDCDTNFTTransferBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := builder.checkBeforeBuild()
+	if err != nil {
+		return nil, err
+	}
+	if len(builder.transfers) == 0 {
+		return nil, ErrNoTokenTransfers
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(drtChainCore.BuiltInFunctionMultiDCDTNFTTransfer).
+		ArgAddress(builder.receiver).
+		ArgInt64(int64(len(builder.transfers)))
+	for _, transfer := range builder.transfers {
+		err = checkTokenTransfer(transfer)
+		if err != nil {
+			return nil, err
+		}
+
+		dataBuilder.
+			ArgBytes([]byte(transfer.TokenIdentifier)).
+			ArgBigInt(big.NewInt(0).SetUint64(transfer.Nonce)).
+			ArgBigInt(transfer.Amount)
+	}
+
+	transferGasLimit := uint64(len(builder.transfers))*GasLimitMultiDCDTNFTTransferPerToken + AdditionalGasForNFTTransfer
+
+	return builder.buildTransaction(builder.senderAccount.Address, dataBuilder, transferGasLimit)
+}
@@ -0,0 +1,178 @@
+package builders
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+)
+
+const (
+	vecMapperLengthSuffix    = ".len"
+	vecMapperItemSuffix      = ".item"
+	setMapperInfoSuffix      = ".info"
+	setMapperNodeIDSuffix    = ".node_id"
+	setMapperValueSuffix     = ".value"
+	setMapperNodeLinksSuffix = ".node_links"
+	mapMapperValueSuffix     = ".mapped"
+)
+
+// storageKeyBuilder can be used to construct the storage keys of the Wasm VM smart contracts' storage mappers. The
+// arguments are nested encoded and appended to the base key, as the smart contracts framework does for the storage
+// mapper arguments
+type storageKeyBuilder struct {
+	key []byte
+	err error
+}
+
+// NewStorageKeyBuilder creates a new storage key builder starting from the provided base key, which is the name
+// declared in the #[storage_mapper("...")] annotation
+func NewStorageKeyBuilder(baseKey string) *storageKeyBuilder {
+	return &storageKeyBuilder{
+		key: []byte(baseKey),
+	}
+}
+
+// ArgAddress appends the provided address (32 bytes)
+func (builder *storageKeyBuilder) ArgAddress(address core.AddressHandler) StorageKeyBuilder {
+	if builder.err != nil {
+		return builder
+	}
+
+	if check.IfNil(address) {
+		builder.err = fmt.Errorf("%w in builder.ArgAddress", ErrNilAddress)
+		return builder
+	}
+	if !address.IsValid() {
+		builder.err = fmt.Errorf("%w in builder.ArgAddress", ErrInvalidAddress)
+		return builder
+	}
+
+	builder.key = append(builder.key, address.AddressBytes()...)
+
+	return builder
+}
+
+// ArgBigUint appends the provided unsigned big integer, length prefixed
+func (builder *storageKeyBuilder) ArgBigUint(value *big.Int) StorageKeyBuilder {
+	if builder.err != nil {
+		return builder
+	}
+
+	if value == nil {
+		builder.err = fmt.Errorf("%w in builder.ArgBigUint", ErrNilValue)
+		return builder
+	}
+	if value.Sign() < 0 {
+		builder.err = fmt.Errorf("%w in builder.ArgBigUint, negative value %s", ErrInvalidValue, value.String())
+		return builder
+	}
+
+	return builder.ArgBytes(value.Bytes())
+}
+
+// ArgUint32 appends the provided value (4 bytes, big endian)
+func (builder *storageKeyBuilder) ArgUint32(value uint32) StorageKeyBuilder {
+	builder.key = binary.BigEndian.AppendUint32(builder.key, value)
+
+	return builder
+}
+
+// ArgUint64 appends the provided value (8 bytes, big endian)
+func (builder *storageKeyBuilder) ArgUint64(value uint64) StorageKeyBuilder {
+	builder.key = binary.BigEndian.AppendUint64(builder.key, value)
+
+	return builder
+}
+
+// ArgBytes appends the provided bytes, length prefixed. It should be used for managed buffers and token identifiers
+func (builder *storageKeyBuilder) ArgBytes(bytes []byte) StorageKeyBuilder {
+	builder.key = binary.BigEndian.AppendUint32(builder.key, uint32(len(bytes)))
+	builder.key = append(builder.key, bytes...)
+
+	return builder
+}
+
+// ArgString appends the provided string, length prefixed
+func (builder *storageKeyBuilder) ArgString(value string) StorageKeyBuilder {
+	return builder.ArgBytes([]byte(value))
+}
+
+// ArgRaw appends the provided bytes as they are. It should be used for arguments that are already nested encoded
+func (builder *storageKeyBuilder) ArgRaw(bytes []byte) StorageKeyBuilder {
+	builder.key = append(builder.key, bytes...)
+
+	return builder
+}
+
+// ToBytes returns the key of a SingleValueMapper or the nested encoded arguments, when built with an empty base key
+func (builder *storageKeyBuilder) ToBytes() ([]byte, error) {
+	return builder.keyWithSuffix("")
+}
+
+// ToHexString returns the hex encoded key of a SingleValueMapper
+func (builder *storageKeyBuilder) ToHexString() (string, error) {
+	key, err := builder.ToBytes()
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(key), nil
+}
+
+// VecMapperLengthKey returns the key holding the number of items of a VecMapper
+func (builder *storageKeyBuilder) VecMapperLengthKey() ([]byte, error) {
+	return builder.keyWithSuffix(vecMapperLengthSuffix)
+}
+
+// VecMapperItemKey returns the key holding the item of a VecMapper at the provided index. The indexes start from 1
+func (builder *storageKeyBuilder) VecMapperItemKey(index uint32) ([]byte, error) {
+	return builder.keyWithSuffix(vecMapperItemSuffix, binary.BigEndian.AppendUint32(nil, index)...)
+}
+
+// SetMapperInfoKey returns the key holding the info of a SetMapper (length, front, back and new node IDs)
+func (builder *storageKeyBuilder) SetMapperInfoKey() ([]byte, error) {
+	return builder.keyWithSuffix(setMapperInfoSuffix)
+}
+
+// SetMapperNodeIDKey returns the key holding the node ID of a SetMapper's value. The value should be nested encoded
+func (builder *storageKeyBuilder) SetMapperNodeIDKey(nestedValue []byte) ([]byte, error) {
+	return builder.keyWithSuffix(setMapperNodeIDSuffix, nestedValue...)
+}
+
+// SetMapperValueKey returns the key holding the value of a SetMapper's node
+func (builder *storageKeyBuilder) SetMapperValueKey(nodeID uint32) ([]byte, error) {
+	return builder.keyWithSuffix(setMapperValueSuffix, binary.BigEndian.AppendUint32(nil, nodeID)...)
+}
+
+// SetMapperNodeLinksKey returns the key holding the previous and next node IDs of a SetMapper's node
+func (builder *storageKeyBuilder) SetMapperNodeLinksKey(nodeID uint32) ([]byte, error) {
+	return builder.keyWithSuffix(setMapperNodeLinksSuffix, binary.BigEndian.AppendUint32(nil, nodeID)...)
+}
+
+// MapMapperValueKey returns the key holding the value of a MapMapper's key. The key should be nested encoded. The keys
+// of a MapMapper are held in a SetMapper built with the same base key
+func (builder *storageKeyBuilder) MapMapperValueKey(nestedKey []byte) ([]byte, error) {
+	return builder.keyWithSuffix(mapMapperValueSuffix, nestedKey...)
+}
+
+func (builder *storageKeyBuilder) keyWithSuffix(suffix string, suffixArgs ...byte) ([]byte, error) {
+	if builder.err != nil {
+		return nil, builder.err
+	}
+
+	key := make([]byte, 0, len(builder.key)+len(suffix)+len(suffixArgs))
+	key = append(key, builder.key...)
+	key = append(key, suffix...)
+	key = append(key, suffixArgs...)
+
+	return key, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *storageKeyBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
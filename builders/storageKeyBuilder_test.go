@@ -0,0 +1,121 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStorageKeyBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder := NewStorageKeyBuilder("owner")
+	assert.False(t, check.IfNil(builder))
+
+	key, err := builder.ToBytes()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("owner"), key)
+
+	hexKey, err := builder.ToHexString()
+	assert.Nil(t, err)
+	assert.Equal(t, "6f776e6572", hexKey)
+}
+
+func TestStorageKeyBuilder_Arguments(t *testing.T) {
+	t.Parallel()
+
+	address, errBech32 := data.NewAddressFromBech32String("drt1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq889n6e")
+	require.Nil(t, errBech32)
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := NewStorageKeyBuilder("balance").ArgAddress(nil).ArgUint32(1).ToBytes()
+		assert.True(t, errors.Is(err, ErrNilAddress))
+		assert.Nil(t, key)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := NewStorageKeyBuilder("balance").ArgAddress(data.NewAddressFromBytes(nil)).ToBytes()
+		assert.True(t, errors.Is(err, ErrInvalidAddress))
+		assert.Nil(t, key)
+	})
+	t.Run("nil or negative big uint should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewStorageKeyBuilder("balance").ArgBigUint(nil).ToBytes()
+		assert.True(t, errors.Is(err, ErrNilValue))
+
+		_, err = NewStorageKeyBuilder("balance").ArgBigUint(big.NewInt(-1)).VecMapperLengthKey()
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("arguments should be nested encoded", func(t *testing.T) {
+		t.Parallel()
+
+		key, err := NewStorageKeyBuilder("k").
+			ArgAddress(address).
+			ArgUint32(1).
+			ArgUint64(2).
+			ArgBigUint(big.NewInt(256)).
+			ArgString("ab").
+			ArgBytes(nil).
+			ArgRaw([]byte{9}).
+			ToBytes()
+		require.Nil(t, err)
+
+		expected := append([]byte("k"), address.AddressBytes()...)
+		expected = append(expected, 0, 0, 0, 1)
+		expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 2)
+		expected = append(expected, 0, 0, 0, 2, 1, 0)
+		expected = append(expected, 0, 0, 0, 2, 'a', 'b')
+		expected = append(expected, 0, 0, 0, 0)
+		expected = append(expected, 9)
+		assert.Equal(t, expected, key)
+	})
+}
+
+func TestStorageKeyBuilder_MapperKeys(t *testing.T) {
+	t.Parallel()
+
+	builder := NewStorageKeyBuilder("items").ArgUint32(5)
+	base := []byte{'i', 't', 'e', 'm', 's', 0, 0, 0, 5}
+
+	key, err := builder.VecMapperLengthKey()
+	assert.Nil(t, err)
+	assert.Equal(t, append(append([]byte{}, base...), []byte(".len")...), key)
+
+	key, err = builder.VecMapperItemKey(3)
+	assert.Nil(t, err)
+	assert.Equal(t, append(append(append([]byte{}, base...), []byte(".item")...), 0, 0, 0, 3), key)
+
+	key, err = builder.SetMapperInfoKey()
+	assert.Nil(t, err)
+	assert.Equal(t, append(append([]byte{}, base...), []byte(".info")...), key)
+
+	nestedValue, _ := NewStorageKeyBuilder("").ArgString("TKN-001122").ToBytes()
+	key, err = builder.SetMapperNodeIDKey(nestedValue)
+	assert.Nil(t, err)
+	assert.Equal(t, append(append(append([]byte{}, base...), []byte(".node_id")...), nestedValue...), key)
+
+	key, err = builder.SetMapperValueKey(7)
+	assert.Nil(t, err)
+	assert.Equal(t, append(append(append([]byte{}, base...), []byte(".value")...), 0, 0, 0, 7), key)
+
+	key, err = builder.SetMapperNodeLinksKey(7)
+	assert.Nil(t, err)
+	assert.Equal(t, append(append(append([]byte{}, base...), []byte(".node_links")...), 0, 0, 0, 7), key)
+
+	key, err = builder.MapMapperValueKey(nestedValue)
+	assert.Nil(t, err)
+	assert.Equal(t, append(append(append([]byte{}, base...), []byte(".mapped")...), nestedValue...), key)
+
+	key, err = builder.ToBytes()
+	assert.Nil(t, err)
+	assert.Equal(t, base, key, "the mapper keys should not alter the builder")
+}
@@ -0,0 +1,428 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	drtChainCore "github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const (
+	// GasLimitTokenIssue is the gas needed by the system DCDT contract to issue or register a token
+	GasLimitTokenIssue = 60000000
+	// GasLimitTokenSystemOperation is the gas needed by the system DCDT contract to manage an existing token: roles,
+	// freeze, wipe, pause and ownership transfer
+	GasLimitTokenSystemOperation = 60000000
+	// GasLimitLocalMintBurn is the gas needed by the local mint and local burn built-in functions
+	GasLimitLocalMintBurn = 300000
+	// GasLimitNFTCreate is the gas needed by the NFT create built-in function
+	GasLimitNFTCreate = 3000000
+	// GasLimitNFTAddQuantity is the gas needed by the NFT add quantity built-in function
+	GasLimitNFTAddQuantity = 300000
+)
+
+const (
+	issueFungibleFunction     = "issue"
+	issueNonFungibleFunction  = "issueNonFungible"
+	issueSemiFungibleFunction = "issueSemiFungible"
+	registerMetaDCDTFunction  = "registerMetaDCDT"
+	setSpecialRoleFunction    = "setSpecialRole"
+	unsetSpecialRoleFunction  = "unSetSpecialRole"
+	freezeFunction            = "freeze"
+	unfreezeFunction          = "unFreeze"
+	wipeFunction              = "wipe"
+	pauseFunction             = "pause"
+	unpauseFunction           = "unPause"
+	transferOwnershipFunction = "transferOwnership"
+)
+
+// DefaultTokenIssueCost is the default value, in denominated REWA, paid for issuing a token
+var DefaultTokenIssueCost = big.NewInt(50000000000000000)
+
+// DCDTSystemSCAddress is the address of the system DCDT smart contract
+var DCDTSystemSCAddress = data.NewAddressFromBytes([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2, 255, 255})
+
+// TokenProperties holds the properties a token is issued with
+type TokenProperties struct {
+	CanFreeze                bool
+	CanWipe                  bool
+	CanPause                 bool
+	CanChangeOwner           bool
+	CanUpgrade               bool
+	CanAddSpecialRoles       bool
+	CanTransferNFTCreateRole bool
+}
+
+// ArgsIssueFungibleToken is the DTO used to issue a fungible token
+type ArgsIssueFungibleToken struct {
+	Name          string
+	Ticker        string
+	InitialSupply *big.Int
+	NumDecimals   uint32
+	Properties    TokenProperties
+}
+
+// ArgsIssueCollection is the DTO used to issue a non-fungible or a semi-fungible token collection
+type ArgsIssueCollection struct {
+	Name       string
+	Ticker     string
+	Properties TokenProperties
+}
+
+// ArgsRegisterMetaDCDT is the DTO used to register a meta DCDT collection
+type ArgsRegisterMetaDCDT struct {
+	Name        string
+	Ticker      string
+	NumDecimals uint32
+	Properties  TokenProperties
+}
+
+// ArgsSpecialRoles is the DTO used to set or unset the special roles of an address for a token
+type ArgsSpecialRoles struct {
+	TokenIdentifier string
+	Address         core.AddressHandler
+	Roles           []string
+}
+
+// ArgsNFTCreate is the DTO used to create a new NFT, SFT or meta DCDT token of a collection
+type ArgsNFTCreate struct {
+	TokenIdentifier string
+	InitialQuantity *big.Int
+	Name            string
+	Royalties       uint32
+	Hash            []byte
+	Attributes      []byte
+	URIs            []string
+}
+
+type tokenManagementBuilder struct {
+	senderAccount *data.Account
+	networkConfig *data.NetworkConfig
+	issueCost     *big.Int
+}
+
+// NewTokenManagementBuilder creates a new builder for the transactions that issue and manage DCDT tokens
+func NewTokenManagementBuilder() *tokenManagementBuilder {
+	return &tokenManagementBuilder{
+		issueCost: big.NewInt(0).Set(DefaultTokenIssueCost),
+	}
+}
+
+// SetSenderAccount sets the account sending the transactions, usually the token manager
+func (builder *tokenManagementBuilder) SetSenderAccount(account *data.Account) *tokenManagementBuilder {
+	builder.senderAccount = account
+
+	return builder
+}
+
+// SetNetworkConfig sets the network config
+func (builder *tokenManagementBuilder) SetNetworkConfig(config *data.NetworkConfig) *tokenManagementBuilder {
+	builder.networkConfig = config
+
+	return builder
+}
+
+// SetIssueCost sets the value paid for issuing a token. Defaults to DefaultTokenIssueCost
+func (builder *tokenManagementBuilder) SetIssueCost(issueCost *big.Int) *tokenManagementBuilder {
+	builder.issueCost = issueCost
+
+	return builder
+}
+
+// IssueFungible builds the transaction issuing a fungible token
+func (builder *tokenManagementBuilder) IssueFungible(args ArgsIssueFungibleToken) (*transaction.FrontendTransaction, error) {
+	if args.InitialSupply == nil || args.InitialSupply.Sign() <= 0 {
+		return nil, fmt.Errorf("%w for the initial supply", ErrInvalidValue)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(issueFungibleFunction).
+		ArgBytes([]byte(args.Name)).
+		ArgBytes([]byte(args.Ticker)).
+		ArgBigInt(args.InitialSupply).
+		ArgInt64(int64(args.NumDecimals))
+	addTokenProperties(dataBuilder, args.Properties, false)
+
+	return builder.buildIssueTransaction(dataBuilder)
+}
+
+// IssueNonFungible builds the transaction issuing a non-fungible token collection
+func (builder *tokenManagementBuilder) IssueNonFungible(args ArgsIssueCollection) (*transaction.FrontendTransaction, error) {
+	return builder.issueCollection(issueNonFungibleFunction, args)
+}
+
+// IssueSemiFungible builds the transaction issuing a semi-fungible token collection
+func (builder *tokenManagementBuilder) IssueSemiFungible(args ArgsIssueCollection) (*transaction.FrontendTransaction, error) {
+	return builder.issueCollection(issueSemiFungibleFunction, args)
+}
+
+func (builder *tokenManagementBuilder) issueCollection(function string, args ArgsIssueCollection) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(args.Name)).
+		ArgBytes([]byte(args.Ticker))
+	addTokenProperties(dataBuilder, args.Properties, true)
+
+	return builder.buildIssueTransaction(dataBuilder)
+}
+
+// RegisterMetaDCDT builds the transaction registering a meta DCDT collection
+func (builder *tokenManagementBuilder) RegisterMetaDCDT(args ArgsRegisterMetaDCDT) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(registerMetaDCDTFunction).
+		ArgBytes([]byte(args.Name)).
+		ArgBytes([]byte(args.Ticker)).
+		ArgInt64(int64(args.NumDecimals))
+	addTokenProperties(dataBuilder, args.Properties, true)
+
+	return builder.buildIssueTransaction(dataBuilder)
+}
+
+// SetSpecialRoles builds the transaction granting the provided roles to an address
+func (builder *tokenManagementBuilder) SetSpecialRoles(args ArgsSpecialRoles) (*transaction.FrontendTransaction, error) {
+	return builder.specialRoles(setSpecialRoleFunction, args)
+}
+
+// UnsetSpecialRoles builds the transaction revoking the provided roles of an address
+func (builder *tokenManagementBuilder) UnsetSpecialRoles(args ArgsSpecialRoles) (*transaction.FrontendTransaction, error) {
+	return builder.specialRoles(unsetSpecialRoleFunction, args)
+}
+
+func (builder *tokenManagementBuilder) specialRoles(function string, args ArgsSpecialRoles) (*transaction.FrontendTransaction, error) {
+	if len(args.Roles) == 0 {
+		return nil, ErrNoRoles
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(args.TokenIdentifier)).
+		ArgAddress(args.Address)
+	for _, role := range args.Roles {
+		dataBuilder.ArgBytes([]byte(role))
+	}
+
+	return builder.buildSystemSCTransaction(args.TokenIdentifier, dataBuilder)
+}
+
+// LocalMint builds the transaction minting the provided amount of a fungible token. The sender needs the local mint role
+func (builder *tokenManagementBuilder) LocalMint(tokenIdentifier string, amount *big.Int) (*transaction.FrontendTransaction, error) {
+	return builder.localMintBurn(drtChainCore.BuiltInFunctionDCDTLocalMint, tokenIdentifier, amount)
+}
+
+// LocalBurn builds the transaction burning the provided amount of a fungible token. The sender needs the local burn role
+func (builder *tokenManagementBuilder) LocalBurn(tokenIdentifier string, amount *big.Int) (*transaction.FrontendTransaction, error) {
+	return builder.localMintBurn(drtChainCore.BuiltInFunctionDCDTLocalBurn, tokenIdentifier, amount)
+}
+
+func (builder *tokenManagementBuilder) localMintBurn(function string, tokenIdentifier string, amount *big.Int) (*transaction.FrontendTransaction, error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("%w for the amount", ErrInvalidValue)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(amount)
+
+	return builder.buildSelfTransaction(tokenIdentifier, dataBuilder, GasLimitLocalMintBurn)
+}
+
+// NFTCreate builds the transaction creating a new token of the provided collection. The sender needs the NFT create role
+func (builder *tokenManagementBuilder) NFTCreate(args ArgsNFTCreate) (*transaction.FrontendTransaction, error) {
+	if args.InitialQuantity == nil || args.InitialQuantity.Sign() <= 0 {
+		return nil, fmt.Errorf("%w for the initial quantity", ErrInvalidValue)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(drtChainCore.BuiltInFunctionDCDTNFTCreate).
+		ArgBytes([]byte(args.TokenIdentifier)).
+		ArgBigInt(args.InitialQuantity).
+		ArgHexString(hex.EncodeToString([]byte(args.Name))).
+		ArgInt64(int64(args.Royalties)).
+		ArgHexString(hex.EncodeToString(args.Hash)).
+		ArgHexString(hex.EncodeToString(args.Attributes))
+	if len(args.URIs) == 0 {
+		dataBuilder.ArgHexString("")
+	}
+	for _, uri := range args.URIs {
+		dataBuilder.ArgHexString(hex.EncodeToString([]byte(uri)))
+	}
+
+	return builder.buildSelfTransaction(args.TokenIdentifier, dataBuilder, GasLimitNFTCreate)
+}
+
+// NFTAddQuantity builds the transaction adding the provided quantity to an existing SFT or meta DCDT token. The sender
+// needs the NFT add quantity role
+func (builder *tokenManagementBuilder) NFTAddQuantity(tokenIdentifier string, nonce uint64, quantity *big.Int) (*transaction.FrontendTransaction, error) {
+	if nonce == 0 {
+		return nil, ErrInvalidTokenNonce
+	}
+	if quantity == nil || quantity.Sign() <= 0 {
+		return nil, fmt.Errorf("%w for the quantity", ErrInvalidValue)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(drtChainCore.BuiltInFunctionDCDTNFTAddQuantity).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(nonce)).
+		ArgBigInt(quantity)
+
+	return builder.buildSelfTransaction(tokenIdentifier, dataBuilder, GasLimitNFTAddQuantity)
+}
+
+// Freeze builds the transaction freezing the token balance of the provided address
+func (builder *tokenManagementBuilder) Freeze(tokenIdentifier string, address core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation(freezeFunction, tokenIdentifier, address)
+}
+
+// Unfreeze builds the transaction unfreezing the token balance of the provided address
+func (builder *tokenManagementBuilder) Unfreeze(tokenIdentifier string, address core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation(unfreezeFunction, tokenIdentifier, address)
+}
+
+// Wipe builds the transaction wiping out the frozen token balance of the provided address
+func (builder *tokenManagementBuilder) Wipe(tokenIdentifier string, address core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation(wipeFunction, tokenIdentifier, address)
+}
+
+// TransferOwnership builds the transaction transferring the management of the token to the provided address
+func (builder *tokenManagementBuilder) TransferOwnership(tokenIdentifier string, newOwner core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation(transferOwnershipFunction, tokenIdentifier, newOwner)
+}
+
+func (builder *tokenManagementBuilder) tokenAddressOperation(function string, tokenIdentifier string, address core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgAddress(address)
+
+	return builder.buildSystemSCTransaction(tokenIdentifier, dataBuilder)
+}
+
+// Pause builds the transaction pausing all the transfers of the token
+func (builder *tokenManagementBuilder) Pause(tokenIdentifier string) (*transaction.FrontendTransaction, error) {
+	return builder.tokenOperation(pauseFunction, tokenIdentifier)
+}
+
+// Unpause builds the transaction resuming the transfers of the token
+func (builder *tokenManagementBuilder) Unpause(tokenIdentifier string) (*transaction.FrontendTransaction, error) {
+	return builder.tokenOperation(unpauseFunction, tokenIdentifier)
+}
+
+func (builder *tokenManagementBuilder) tokenOperation(function string, tokenIdentifier string) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(tokenIdentifier))
+
+	return builder.buildSystemSCTransaction(tokenIdentifier, dataBuilder)
+}
+
+func addTokenProperties(dataBuilder TxDataBuilder, properties TokenProperties, isCollection bool) {
+	addTokenProperty(dataBuilder, "canFreeze", properties.CanFreeze)
+	addTokenProperty(dataBuilder, "canWipe", properties.CanWipe)
+	addTokenProperty(dataBuilder, "canPause", properties.CanPause)
+	if isCollection {
+		addTokenProperty(dataBuilder, "canTransferNFTCreateRole", properties.CanTransferNFTCreateRole)
+	}
+	addTokenProperty(dataBuilder, "canChangeOwner", properties.CanChangeOwner)
+	addTokenProperty(dataBuilder, "canUpgrade", properties.CanUpgrade)
+	addTokenProperty(dataBuilder, "canAddSpecialRoles", properties.CanAddSpecialRoles)
+}
+
+func addTokenProperty(dataBuilder TxDataBuilder, name string, value bool) {
+	dataBuilder.
+		ArgBytes([]byte(name)).
+		ArgBytes([]byte(strconv.FormatBool(value)))
+}
+
+func (builder *tokenManagementBuilder) checkBeforeBuild() error {
+	if builder.senderAccount == nil {
+		return ErrNilSenderAccount
+	}
+	if builder.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+
+	return nil
+}
+
+func (builder *tokenManagementBuilder) buildIssueTransaction(dataBuilder TxDataBuilder) (*transaction.FrontendTransaction, error) {
+	if builder.issueCost == nil || builder.issueCost.Sign() < 0 {
+		return nil, fmt.Errorf("%w for the issue cost", ErrInvalidValue)
+	}
+
+	receiver, err := DCDTSystemSCAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.buildTransaction(receiver, builder.issueCost, dataBuilder, GasLimitTokenIssue)
+}
+
+func (builder *tokenManagementBuilder) buildSystemSCTransaction(tokenIdentifier string, dataBuilder TxDataBuilder) (*transaction.FrontendTransaction, error) {
+	if len(tokenIdentifier) == 0 {
+		return nil, ErrEmptyTokenIdentifier
+	}
+
+	receiver, err := DCDTSystemSCAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.buildTransaction(receiver, big.NewInt(0), dataBuilder, GasLimitTokenSystemOperation)
+}
+
+func (builder *tokenManagementBuilder) buildSelfTransaction(
+	tokenIdentifier string,
+	dataBuilder TxDataBuilder,
+	operationGasLimit uint64,
+) (*transaction.FrontendTransaction, error) {
+	if len(tokenIdentifier) == 0 {
+		return nil, ErrEmptyTokenIdentifier
+	}
+	err := builder.checkBeforeBuild()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.buildTransaction(builder.senderAccount.Address, big.NewInt(0), dataBuilder, operationGasLimit)
+}
+
+// buildTransaction creates the unsigned transaction with a gas limit covering the data field and the operation cost
+func (builder *tokenManagementBuilder) buildTransaction(
+	receiver string,
+	value *big.Int,
+	dataBuilder TxDataBuilder,
+	operationGasLimit uint64,
+) (*transaction.FrontendTransaction, error) {
+	err := builder.checkBeforeBuild()
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := dataBuilder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := builder.networkConfig.MinGasLimit +
+		builder.networkConfig.GasPerDataByte*uint64(len(txData)) +
+		operationGasLimit
+
+	return &transaction.FrontendTransaction{
+		Nonce:    builder.senderAccount.Nonce,
+		Value:    value.String(),
+		Receiver: receiver,
+		Sender:   builder.senderAccount.Address,
+		GasPrice: builder.networkConfig.MinGasPrice,
+		GasLimit: gasLimit,
+		Data:     txData,
+		ChainID:  builder.networkConfig.ChainID,
+		Version:  builder.networkConfig.MinTransactionVersion,
+	}, nil
+}
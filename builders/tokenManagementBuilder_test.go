@@ -0,0 +1,267 @@
+package builders
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSystemSCAddress = "drt1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls6prdez"
+	testTokenIdentifier = "MTK-a1b2c3"
+	hexTokenIdentifier  = "4d544b2d613162326333"
+)
+
+func createTokenManagementBuilder() *tokenManagementBuilder {
+	return NewTokenManagementBuilder().
+		SetSenderAccount(&data.Account{Address: testOwnerAddress, Nonce: 9}).
+		SetNetworkConfig(createContractTestNetworkConfig())
+}
+
+func checkTokenManagementTransaction(
+	t *testing.T,
+	tx *transaction.FrontendTransaction,
+	expectedReceiver string,
+	expectedValue string,
+	expectedData string,
+	operationGasLimit uint64,
+) {
+	assert.Equal(t, expectedData, string(tx.Data))
+	assert.Equal(t, expectedReceiver, tx.Receiver)
+	assert.Equal(t, testOwnerAddress, tx.Sender)
+	assert.Equal(t, expectedValue, tx.Value)
+	assert.Equal(t, uint64(9), tx.Nonce)
+	assert.Equal(t, uint64(50000+1500*len(expectedData))+operationGasLimit, tx.GasLimit)
+	assert.Equal(t, "T", tx.ChainID)
+	assert.Equal(t, uint32(2), tx.Version)
+}
+
+func TestTokenManagementBuilder_MissingFields(t *testing.T) {
+	t.Parallel()
+
+	tx, err := NewTokenManagementBuilder().SetNetworkConfig(createContractTestNetworkConfig()).Pause(testTokenIdentifier)
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrNilSenderAccount, err)
+
+	tx, err = NewTokenManagementBuilder().SetSenderAccount(&data.Account{}).Pause(testTokenIdentifier)
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	tx, err = createTokenManagementBuilder().Pause("")
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrEmptyTokenIdentifier, err)
+
+	tx, err = createTokenManagementBuilder().LocalMint("", big.NewInt(1))
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrEmptyTokenIdentifier, err)
+}
+
+func TestTokenManagementBuilder_Issue(t *testing.T) {
+	t.Parallel()
+
+	systemSCAddress, err := DCDTSystemSCAddress.AddressAsBech32String()
+	require.Nil(t, err)
+	assert.Equal(t, testSystemSCAddress, systemSCAddress)
+
+	t.Run("fungible token", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsIssueFungibleToken{
+			Name:          "MYTOKEN",
+			Ticker:        "MTK",
+			InitialSupply: big.NewInt(1000),
+			NumDecimals:   6,
+			Properties: TokenProperties{
+				CanFreeze:          true,
+				CanPause:           true,
+				CanAddSpecialRoles: true,
+			},
+		}
+		tx, err := createTokenManagementBuilder().IssueFungible(args)
+		require.Nil(t, err)
+
+		expectedData := "issue@4d59544f4b454e@4d544b@03e8@06" +
+			"@63616e467265657a65@74727565" +
+			"@63616e57697065@66616c7365" +
+			"@63616e5061757365@74727565" +
+			"@63616e4368616e67654f776e6572@66616c7365" +
+			"@63616e55706772616465@66616c7365" +
+			"@63616e4164645370656369616c526f6c6573@74727565"
+		checkTokenManagementTransaction(t, tx, testSystemSCAddress, "50000000000000000", expectedData, GasLimitTokenIssue)
+
+		args.InitialSupply = nil
+		tx, err = createTokenManagementBuilder().IssueFungible(args)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		args.InitialSupply = big.NewInt(1)
+		args.Name = ""
+		tx, err = createTokenManagementBuilder().IssueFungible(args)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("collections", func(t *testing.T) {
+		t.Parallel()
+
+		args := ArgsIssueCollection{
+			Name:       "MYTOKEN",
+			Ticker:     "MTK",
+			Properties: TokenProperties{CanTransferNFTCreateRole: true},
+		}
+		expectedProperties := "@63616e467265657a65@66616c7365" +
+			"@63616e57697065@66616c7365" +
+			"@63616e5061757365@66616c7365" +
+			"@63616e5472616e736665724e4654437265617465526f6c65@74727565" +
+			"@63616e4368616e67654f776e6572@66616c7365" +
+			"@63616e55706772616465@66616c7365" +
+			"@63616e4164645370656369616c526f6c6573@66616c7365"
+		builder := createTokenManagementBuilder().SetIssueCost(big.NewInt(7))
+
+		tx, err := builder.IssueNonFungible(args)
+		require.Nil(t, err)
+		checkTokenManagementTransaction(t, tx, testSystemSCAddress, "7", "issueNonFungible@4d59544f4b454e@4d544b"+expectedProperties, GasLimitTokenIssue)
+
+		tx, err = builder.IssueSemiFungible(args)
+		require.Nil(t, err)
+		checkTokenManagementTransaction(t, tx, testSystemSCAddress, "7", "issueSemiFungible@4d59544f4b454e@4d544b"+expectedProperties, GasLimitTokenIssue)
+
+		tx, err = builder.RegisterMetaDCDT(ArgsRegisterMetaDCDT{
+			Name:        args.Name,
+			Ticker:      args.Ticker,
+			NumDecimals: 18,
+			Properties:  args.Properties,
+		})
+		require.Nil(t, err)
+		checkTokenManagementTransaction(t, tx, testSystemSCAddress, "7", "registerMetaDCDT@4d59544f4b454e@4d544b@12"+expectedProperties, GasLimitTokenIssue)
+
+		tx, err = builder.SetIssueCost(nil).IssueNonFungible(args)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+}
+
+func TestTokenManagementBuilder_SpecialRoles(t *testing.T) {
+	t.Parallel()
+
+	address := createTestContractAddress(t)
+	args := ArgsSpecialRoles{
+		TokenIdentifier: testTokenIdentifier,
+		Address:         address,
+		Roles:           []string{"DCDTRoleLocalMint", "DCDTRoleLocalBurn"},
+	}
+	expectedArgs := hexTokenIdentifier + "@" + hex.EncodeToString(address.AddressBytes()) +
+		"@44434454526f6c654c6f63616c4d696e74@44434454526f6c654c6f63616c4275726e"
+
+	tx, err := createTokenManagementBuilder().SetSpecialRoles(args)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "setSpecialRole@"+expectedArgs, GasLimitTokenSystemOperation)
+
+	tx, err = createTokenManagementBuilder().UnsetSpecialRoles(args)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "unSetSpecialRole@"+expectedArgs, GasLimitTokenSystemOperation)
+
+	args.Roles = nil
+	tx, err = createTokenManagementBuilder().SetSpecialRoles(args)
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrNoRoles, err)
+
+	args.Roles = []string{"DCDTRoleLocalMint"}
+	args.Address = nil
+	tx, err = createTokenManagementBuilder().SetSpecialRoles(args)
+	assert.Nil(t, tx)
+	assert.True(t, errors.Is(err, ErrNilAddress))
+}
+
+func TestTokenManagementBuilder_MintAndBurn(t *testing.T) {
+	t.Parallel()
+
+	tx, err := createTokenManagementBuilder().LocalMint(testTokenIdentifier, big.NewInt(1000))
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testOwnerAddress, "0", "DCDTLocalMint@"+hexTokenIdentifier+"@03e8", GasLimitLocalMintBurn)
+
+	tx, err = createTokenManagementBuilder().LocalBurn(testTokenIdentifier, big.NewInt(10))
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testOwnerAddress, "0", "DCDTLocalBurn@"+hexTokenIdentifier+"@0a", GasLimitLocalMintBurn)
+
+	tx, err = createTokenManagementBuilder().LocalBurn(testTokenIdentifier, big.NewInt(0))
+	assert.Nil(t, tx)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
+func TestTokenManagementBuilder_NFTCreateAndAddQuantity(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsNFTCreate{
+		TokenIdentifier: testTokenIdentifier,
+		InitialQuantity: big.NewInt(1),
+		Name:            "MyNFT",
+		Royalties:       2500,
+		Attributes:      []byte("metadata:abc"),
+		URIs:            []string{"ipfs://abc"},
+	}
+	tx, err := createTokenManagementBuilder().NFTCreate(args)
+	require.Nil(t, err)
+	expectedData := "DCDTNFTCreate@" + hexTokenIdentifier + "@01@4d794e4654@09c4@@6d657461646174613a616263@697066733a2f2f616263"
+	checkTokenManagementTransaction(t, tx, testOwnerAddress, "0", expectedData, GasLimitNFTCreate)
+
+	args.URIs = nil
+	tx, err = createTokenManagementBuilder().NFTCreate(args)
+	require.Nil(t, err)
+	assert.True(t, strings.HasSuffix(string(tx.Data), "@6d657461646174613a616263@"))
+
+	args.InitialQuantity = nil
+	tx, err = createTokenManagementBuilder().NFTCreate(args)
+	assert.Nil(t, tx)
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+
+	tx, err = createTokenManagementBuilder().NFTAddQuantity(testTokenIdentifier, 5, big.NewInt(100))
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testOwnerAddress, "0", "DCDTNFTAddQuantity@"+hexTokenIdentifier+"@05@64", GasLimitNFTAddQuantity)
+
+	tx, err = createTokenManagementBuilder().NFTAddQuantity(testTokenIdentifier, 0, big.NewInt(100))
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrInvalidTokenNonce, err)
+}
+
+func TestTokenManagementBuilder_SystemOperations(t *testing.T) {
+	t.Parallel()
+
+	address := createTestContractAddress(t)
+	hexAddress := hex.EncodeToString(address.AddressBytes())
+	builder := createTokenManagementBuilder()
+
+	tx, err := builder.Freeze(testTokenIdentifier, address)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "freeze@"+hexTokenIdentifier+"@"+hexAddress, GasLimitTokenSystemOperation)
+
+	tx, err = builder.Unfreeze(testTokenIdentifier, address)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "unFreeze@"+hexTokenIdentifier+"@"+hexAddress, GasLimitTokenSystemOperation)
+
+	tx, err = builder.Wipe(testTokenIdentifier, address)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "wipe@"+hexTokenIdentifier+"@"+hexAddress, GasLimitTokenSystemOperation)
+
+	tx, err = builder.TransferOwnership(testTokenIdentifier, address)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "transferOwnership@"+hexTokenIdentifier+"@"+hexAddress, GasLimitTokenSystemOperation)
+
+	tx, err = builder.Pause(testTokenIdentifier)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "pause@"+hexTokenIdentifier, GasLimitTokenSystemOperation)
+
+	tx, err = builder.Unpause(testTokenIdentifier)
+	require.Nil(t, err)
+	checkTokenManagementTransaction(t, tx, testSystemSCAddress, "0", "unPause@"+hexTokenIdentifier, GasLimitTokenSystemOperation)
+
+	tx, err = builder.Freeze(testTokenIdentifier, nil)
+	assert.Nil(t, tx)
+	assert.True(t, errors.Is(err, ErrNilAddress))
+}
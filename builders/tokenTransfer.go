@@ -0,0 +1,124 @@
+package builders
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const (
+	// GasLimitDCDTTransfer is the gas consumed by the DCDTTransfer built-in function
+	GasLimitDCDTTransfer = 200000
+	// GasLimitDCDTNFTTransfer is the gas consumed by the DCDTNFTTransfer built-in function
+	GasLimitDCDTNFTTransfer = 200000
+	// GasLimitMultiDCDTNFTTransferPerToken is the gas consumed by the MultiDCDTNFTTransfer built-in function for each
+	// transferred token
+	GasLimitMultiDCDTNFTTransferPerToken = 200000
+	// AdditionalGasForTokenTransfer is the gas added on top of the built-in function cost for a fungible token transfer
+	AdditionalGasForTokenTransfer = 100000
+	// AdditionalGasForNFTTransfer is the gas added on top of the built-in function cost for the DCDTNFTTransfer and
+	// MultiDCDTNFTTransfer token transfers
+	AdditionalGasForNFTTransfer = 800000
+)
+
+// TokenTransfer holds the token, nonce and amount of a token transfer. The nonce is 0 for fungible tokens
+type TokenTransfer struct {
+	TokenIdentifier string
+	Nonce           uint64
+	Amount          *big.Int
+}
+
+func checkTokenTransfer(transfer *TokenTransfer) error {
+	if transfer == nil {
+		return fmt.Errorf("%w for the token transfer", ErrNilValue)
+	}
+	if len(transfer.TokenIdentifier) == 0 {
+		return ErrEmptyTokenIdentifier
+	}
+	if transfer.Amount == nil || transfer.Amount.Sign() <= 0 {
+		return fmt.Errorf("%w for the amount of token %s", ErrInvalidValue, transfer.TokenIdentifier)
+	}
+
+	return nil
+}
+
+// baseTokenTransferBuilder holds the fields common to the token transfer builders. The embedded base builder holds
+// the arguments of the optional contract function called after the transfer
+type baseTokenTransferBuilder struct {
+	*baseBuilder
+	receiver      core.AddressHandler
+	senderAccount *data.Account
+	networkConfig *data.NetworkConfig
+	function      string
+	extraGasLimit uint64
+}
+
+func newBaseTokenTransferBuilder() *baseTokenTransferBuilder {
+	return &baseTokenTransferBuilder{
+		baseBuilder: &baseBuilder{},
+	}
+}
+
+func (builder *baseTokenTransferBuilder) checkBeforeBuild() error {
+	if builder.err != nil {
+		return builder.err
+	}
+	if builder.senderAccount == nil {
+		return ErrNilSenderAccount
+	}
+	if builder.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+	if len(builder.function) == 0 && len(builder.args) > 0 {
+		return ErrMissingFunction
+	}
+
+	return builder.checkAddress(builder.receiver)
+}
+
+// appendFunctionCall adds the contract function call, if any, after the transfer arguments
+func (builder *baseTokenTransferBuilder) appendFunctionCall(dataBuilder TxDataBuilder) {
+	if len(builder.function) == 0 {
+		return
+	}
+
+	dataBuilder.ArgBytes([]byte(builder.function))
+	for _, arg := range builder.args {
+		dataBuilder.ArgHexString(arg)
+	}
+}
+
+// buildTransaction creates the unsigned transaction with a gas limit covering the data field, the built-in function
+// cost and the extra gas needed by the called function
+func (builder *baseTokenTransferBuilder) buildTransaction(
+	receiver string,
+	dataBuilder TxDataBuilder,
+	transferGasLimit uint64,
+) (*transaction.FrontendTransaction, error) {
+	builder.appendFunctionCall(dataBuilder)
+
+	txData, err := dataBuilder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := builder.networkConfig.MinGasLimit +
+		builder.networkConfig.GasPerDataByte*uint64(len(txData)) +
+		transferGasLimit +
+		builder.extraGasLimit
+
+	return &transaction.FrontendTransaction{
+		Nonce:    builder.senderAccount.Nonce,
+		Value:    "0",
+		Receiver: receiver,
+		Sender:   builder.senderAccount.Address,
+		GasPrice: builder.networkConfig.MinGasPrice,
+		GasLimit: gasLimit,
+		Data:     txData,
+		ChainID:  builder.networkConfig.ChainID,
+		Version:  builder.networkConfig.MinTransactionVersion,
+	}, nil
+}
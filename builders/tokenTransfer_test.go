@@ -0,0 +1,181 @@
+package builders
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFungibleToken = "USDC-a1b2c3"
+	testNFTCollection = "NFT-123456"
+)
+
+func TestDCDTTransferBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		receiver := createTestContractAddress(t)
+		sender := &data.Account{Address: testOwnerAddress}
+
+		_, err := NewDCDTTransferBuilder().SetReceiver(receiver).SetNetworkConfig(createContractTestNetworkConfig()).Build()
+		assert.Equal(t, ErrNilSenderAccount, err)
+
+		_, err = NewDCDTTransferBuilder().SetReceiver(receiver).SetSenderAccount(sender).Build()
+		assert.Equal(t, ErrNilNetworkConfig, err)
+
+		_, err = NewDCDTTransferBuilder().SetSenderAccount(sender).SetNetworkConfig(createContractTestNetworkConfig()).Build()
+		assert.True(t, errors.Is(err, ErrNilAddress))
+
+		builder := NewDCDTTransferBuilder().
+			SetReceiver(receiver).
+			SetSenderAccount(sender).
+			SetNetworkConfig(createContractTestNetworkConfig())
+		_, err = builder.Build()
+		assert.True(t, errors.Is(err, ErrNilValue))
+
+		_, err = builder.SetToken("", big.NewInt(1)).Build()
+		assert.Equal(t, ErrEmptyTokenIdentifier, err)
+
+		_, err = builder.SetToken(testFungibleToken, big.NewInt(0)).Build()
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		_, err = builder.SetToken(testFungibleToken, big.NewInt(1)).ArgInt64(1).Build()
+		assert.Equal(t, ErrMissingFunction, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewDCDTTransferBuilder().
+			SetToken(testFungibleToken, big.NewInt(1000)).
+			SetReceiver(createTestContractAddress(t)).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress, Nonce: 3}).
+			SetNetworkConfig(createContractTestNetworkConfig()).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "DCDTTransfer@555344432d613162326333@03e8"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testContractAddress, tx.Receiver)
+		assert.Equal(t, testOwnerAddress, tx.Sender)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(3), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+GasLimitDCDTTransfer+AdditionalGasForTokenTransfer), tx.GasLimit)
+	})
+	t.Run("with function call should work", func(t *testing.T) {
+		t.Parallel()
+
+		encoder := &testsCommon.ArgumentsEncoderStub{
+			EncodeArgumentsCalled: func(function string, values ...interface{}) ([][]byte, error) {
+				assert.Equal(t, "deposit", function)
+
+				return [][]byte{{7}, {}}, nil
+			},
+		}
+		tx, err := NewDCDTTransferBuilder().
+			SetToken(testFungibleToken, big.NewInt(1000)).
+			SetReceiver(createTestContractAddress(t)).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress}).
+			SetNetworkConfig(createContractTestNetworkConfig()).
+			SetFunction("deposit").
+			ArgsEncoded(encoder, 7).
+			SetExtraGasLimit(5000000).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "DCDTTransfer@555344432d613162326333@03e8@6465706f736974@07@"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+GasLimitDCDTTransfer+AdditionalGasForTokenTransfer+5000000), tx.GasLimit)
+	})
+}
+
+func TestDCDTNFTTransferBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewDCDTNFTTransferBuilder().
+			SetToken(testNFTCollection, 0, big.NewInt(1)).
+			SetReceiver(createTestContractAddress(t)).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress}).
+			SetNetworkConfig(createContractTestNetworkConfig()).
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrInvalidTokenNonce, err)
+	})
+	t.Run("should send to self with the receiver as argument", func(t *testing.T) {
+		t.Parallel()
+
+		receiver := createTestContractAddress(t)
+		tx, err := NewDCDTNFTTransferBuilder().
+			SetToken(testNFTCollection, 10, big.NewInt(1)).
+			SetReceiver(receiver).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress, Nonce: 4}).
+			SetNetworkConfig(createContractTestNetworkConfig()).
+			SetFunction("deposit").
+			ArgBigInt(big.NewInt(5)).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "DCDTNFTTransfer@4e46542d313233343536@0a@01@" + hex.EncodeToString(receiver.AddressBytes()) + "@6465706f736974@05"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testOwnerAddress, tx.Receiver)
+		assert.Equal(t, testOwnerAddress, tx.Sender)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(4), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+GasLimitDCDTNFTTransfer+AdditionalGasForNFTTransfer), tx.GasLimit)
+	})
+}
+
+func TestMultiDCDTNFTTransferBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid transfers should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder := NewMultiDCDTNFTTransferBuilder().
+			SetReceiver(createTestContractAddress(t)).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress}).
+			SetNetworkConfig(createContractTestNetworkConfig())
+		_, err := builder.Build()
+		assert.Equal(t, ErrNoTokenTransfers, err)
+
+		_, err = builder.AddTransfers(&TokenTransfer{TokenIdentifier: testFungibleToken}).Build()
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should send to self with the receiver as argument", func(t *testing.T) {
+		t.Parallel()
+
+		receiver := createTestContractAddress(t)
+		tx, err := NewMultiDCDTNFTTransferBuilder().
+			AddTransfers(
+				&TokenTransfer{TokenIdentifier: testFungibleToken, Amount: big.NewInt(1000)},
+				&TokenTransfer{TokenIdentifier: testNFTCollection, Nonce: 10, Amount: big.NewInt(1)},
+			).
+			SetReceiver(receiver).
+			SetSenderAccount(&data.Account{Address: testOwnerAddress, Nonce: 5}).
+			SetNetworkConfig(createContractTestNetworkConfig()).
+			SetFunction("deposit").
+			SetExtraGasLimit(1000000).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "MultiDCDTNFTTransfer@" + hex.EncodeToString(receiver.AddressBytes()) +
+			"@02@555344432d613162326333@00@03e8@4e46542d313233343536@0a@01@6465706f736974"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testOwnerAddress, tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(5), tx.Nonce)
+		expectedGasLimit := 50000 + 1500*len(expectedData) + 2*GasLimitMultiDCDTNFTTransferPerToken + AdditionalGasForNFTTransfer + 1000000
+		assert.Equal(t, uint64(expectedGasLimit), tx.GasLimit)
+	})
+}
@@ -145,6 +145,12 @@ func (builder *txBuilder) ApplyRelayerSignature(
 // ComputeTxHash will return the hash of the provided transaction. It assumes that the transaction is already signed,
 // otherwise it will return an error.
 func (builder *txBuilder) ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error) {
+	return ComputeTransactionHash(tx)
+}
+
+// ComputeTransactionHash will return the hash of the provided transaction. It assumes that the transaction is already
+// signed, otherwise it will return an error.
+func ComputeTransactionHash(tx *transaction.FrontendTransaction) ([]byte, error) {
 	if len(tx.Signature) == 0 {
 		return nil, ErrMissingSignature
 	}
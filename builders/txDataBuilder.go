@@ -74,6 +74,14 @@ func (builder *txDataBuilder) ArgBytesList(list [][]byte) TxDataBuilder {
 	return builder
 }
 
+// ArgsEncoded adds the provided values, encoded by the provided encoder as the arguments of the function. The function
+// should be set before calling this method
+func (builder *txDataBuilder) ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) TxDataBuilder {
+	builder.addArgsEncoded(encoder, builder.function, values...)
+
+	return builder
+}
+
 // ToDataString returns the formatted data string ready to be used in a transaction call
 func (builder *txDataBuilder) ToDataString() (string, error) {
 	if builder.err != nil {
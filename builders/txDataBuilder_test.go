@@ -9,6 +9,7 @@ import (
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -162,3 +163,56 @@ func TestTxDataBuilder_InvalidArguments(t *testing.T) {
 		assert.True(t, errors.Is(errString, ErrInvalidValue))
 	})
 }
+
+func TestTxDataBuilder_ArgsEncoded(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil encoder should error", func(t *testing.T) {
+		t.Parallel()
+
+		txData, err := NewTxDataBuilder().
+			Function("function").
+			ArgsEncoded(nil, 1).
+			ToDataString()
+		assert.Empty(t, txData)
+		assert.True(t, errors.Is(err, ErrNilArgumentsEncoder))
+	})
+	t.Run("encoder errors should be propagated", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		encoder := &testsCommon.ArgumentsEncoderStub{
+			EncodeArgumentsCalled: func(function string, values ...interface{}) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+
+		txData, err := NewTxDataBuilder().
+			Function("function").
+			ArgsEncoded(encoder, 1).
+			ToDataString()
+		assert.Empty(t, txData)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.True(t, strings.Contains(err.Error(), "builder.ArgsEncoded for function function"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		encoder := &testsCommon.ArgumentsEncoderStub{
+			EncodeArgumentsCalled: func(function string, values ...interface{}) ([][]byte, error) {
+				assert.Equal(t, "function", function)
+				assert.Equal(t, []interface{}{0, 10}, values)
+
+				return [][]byte{{}, {10}}, nil
+			},
+		}
+
+		txData, err := NewTxDataBuilder().
+			Function("function").
+			ArgInt64(4).
+			ArgsEncoded(encoder, 0, 10).
+			ToDataString()
+		assert.Nil(t, err)
+		assert.Equal(t, "function@04@@0a", txData)
+	})
+}
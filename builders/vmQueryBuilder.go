@@ -63,6 +63,14 @@ func (builder *vmQueryBuilder) ArgBytes(bytes []byte) VMQueryBuilder {
 	return builder
 }
 
+// ArgsEncoded adds the provided values, encoded by the provided encoder as the arguments of the function. The function
+// should be set before calling this method
+func (builder *vmQueryBuilder) ArgsEncoded(encoder ArgumentsEncoder, values ...interface{}) VMQueryBuilder {
+	builder.addArgsEncoded(encoder, builder.function, values...)
+
+	return builder
+}
+
 // CallerAddress sets the caller address
 func (builder *vmQueryBuilder) CallerAddress(address core.AddressHandler) VMQueryBuilder {
 	err := builder.checkAddress(address)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -114,3 +115,30 @@ func TestVmQueryBuilder_AllGoodArguments(t *testing.T) {
 
 	require.Equal(t, expectedArgs, valueRequest.Args)
 }
+
+func TestVmQueryBuilder_ArgsEncoded(t *testing.T) {
+	t.Parallel()
+
+	encoder := &testsCommon.ArgumentsEncoderStub{
+		EncodeArgumentsCalled: func(function string, values ...interface{}) ([][]byte, error) {
+			assert.Equal(t, "function", function)
+			assert.Equal(t, []interface{}{"abc"}, values)
+
+			return [][]byte{[]byte("abc")}, nil
+		},
+	}
+
+	valueRequest, err := NewVMQueryBuilder().
+		Function("function").
+		ArgsEncoded(encoder, "abc").
+		ToVmValueRequest()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{hex.EncodeToString([]byte("abc"))}, valueRequest.Args)
+
+	valueRequest, err = NewVMQueryBuilder().
+		Function("function").
+		ArgsEncoded(nil, "abc").
+		ToVmValueRequest()
+	assert.Nil(t, valueRequest)
+	assert.True(t, errors.Is(err, ErrNilArgumentsEncoder))
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/authentication"
+)
+
+const (
+	httpAuthorizationKey = "Authorization"
+	bearerTokenPrefix    = "Bearer "
+)
+
+type bearerTokenInterceptor struct {
+	authClient authentication.AuthClient
+}
+
+// NewBearerTokenInterceptor creates a request interceptor that sets, on each request, the access token provided by the
+// auth client as a bearer token. The token is requested before each attempt, so the auth client should cache it
+func NewBearerTokenInterceptor(authClient authentication.AuthClient) (*bearerTokenInterceptor, error) {
+	if check.IfNil(authClient) {
+		return nil, ErrNilAuthClient
+	}
+
+	return &bearerTokenInterceptor{
+		authClient: authClient,
+	}, nil
+}
+
+// BeforeRequest sets the Authorization header on the request
+func (interceptor *bearerTokenInterceptor) BeforeRequest(request *http.Request) error {
+	accessToken, err := interceptor.authClient.GetAccessToken()
+	if err != nil {
+		return fmt.Errorf("%w while getting the access token", err)
+	}
+
+	request.Header.Set(httpAuthorizationKey, bearerTokenPrefix+accessToken)
+
+	return nil
+}
+
+// AfterResponse does nothing
+func (interceptor *bearerTokenInterceptor) AfterResponse(_ *http.Request, _ *http.Response, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interceptor *bearerTokenInterceptor) IsInterfaceNil() bool {
+	return interceptor == nil
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/authentication/native/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBearerTokenInterceptor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil auth client should error", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor, err := NewBearerTokenInterceptor(nil)
+		assert.True(t, check.IfNil(interceptor))
+		assert.Equal(t, ErrNilAuthClient, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor, err := NewBearerTokenInterceptor(&mock.NativeStub{})
+		assert.False(t, check.IfNil(interceptor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBearerTokenInterceptor_BeforeRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("auth client errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		interceptor, _ := NewBearerTokenInterceptor(&mock.NativeStub{
+			GetAccessTokenCalled: func() (string, error) {
+				return "", expectedErr
+			},
+		})
+
+		request := httptest.NewRequest(http.MethodGet, "/endpoint", nil)
+		err := interceptor.BeforeRequest(request)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Empty(t, request.Header.Get(httpAuthorizationKey))
+	})
+	t.Run("should set the access token", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor, _ := NewBearerTokenInterceptor(&mock.NativeStub{
+			GetAccessTokenCalled: func() (string, error) {
+				return "token", nil
+			},
+		})
+
+		request := httptest.NewRequest(http.MethodGet, "/endpoint", nil)
+		err := interceptor.BeforeRequest(request)
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer token", request.Header.Get(httpAuthorizationKey))
+	})
+}
@@ -6,10 +6,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/core/metrics"
 )
 
+var log = logger.GetOrCreate("drt-go-sdk/core/http")
+
 const (
 	httpUserAgentKey = "User-Agent"
 	httpUserAgent    = "Dharitri/1.0.1 (GO SDK tools)"
@@ -19,74 +26,301 @@ const (
 
 	httpContentTypeKey = "Content-Type"
 	httpContentType    = "application/json"
+
+	httpRetryAfterKey = "Retry-After"
+	// maxRetryAfter bounds the time the requests are held back when the server asks for it
+	maxRetryAfter = time.Minute * 5
 )
 
+// ArgsHttpClientWrapper is the DTO used in the http client wrapper constructor
+type ArgsHttpClientWrapper struct {
+	Client         Client
+	URL            string
+	RetryPolicy    RetryPolicy
+	RequestLimiter RequestLimiter
+	MetricsHandler sdkCore.MetricsHandler
+	Interceptors   []RequestInterceptor
+}
+
 type clientWrapper struct {
-	url    string
-	client Client
+	url            string
+	client         Client
+	retryPolicy    RetryPolicy
+	requestLimiter RequestLimiter
+	metricsHandler sdkCore.MetricsHandler
+	interceptors   []RequestInterceptor
 }
 
-// NewHttpClientWrapper will create a new instance of type httpClientWrapper
-func NewHttpClientWrapper(client Client, url string) *clientWrapper {
-	providedClient := client
+// NewHttpClientWrapper will create a new instance of type httpClientWrapper that does not retry the failed requests.
+// The provided interceptors are called, in order, before each request and, in reverse order, after each response
+func NewHttpClientWrapper(client Client, url string, interceptors ...RequestInterceptor) *clientWrapper {
+	return NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+		Client:       client,
+		URL:          url,
+		Interceptors: interceptors,
+	})
+}
+
+// NewHttpClientWrapperWithArgs will create a new instance of type httpClientWrapper. If no client is provided, the
+// default http client is used. If no retry policy is provided, the failed requests will not be retried. If no request
+// limiter is provided, the requests are not limited. In both cases, the delays the server asks for with Retry-After,
+// capped to 5 minutes, are honored before retrying the request. If no metrics handler is provided, the requests are not
+// instrumented. The nil interceptors are ignored.
+func NewHttpClientWrapperWithArgs(args ArgsHttpClientWrapper) *clientWrapper {
+	providedClient := args.Client
 	if check.IfNilReflect(providedClient) {
 		providedClient = http.DefaultClient
 	}
 
+	var retryPolicy RetryPolicy = &DisabledRetryPolicy{}
+	if !check.IfNil(args.RetryPolicy) {
+		retryPolicy = args.RetryPolicy
+	}
+
+	var requestLimiter RequestLimiter = &DisabledRequestLimiter{}
+	if !check.IfNil(args.RequestLimiter) {
+		requestLimiter = args.RequestLimiter
+	}
+
+	var metricsHandler sdkCore.MetricsHandler = &metrics.DisabledMetricsHandler{}
+	if !check.IfNil(args.MetricsHandler) {
+		metricsHandler = args.MetricsHandler
+	}
+
+	interceptors := make([]RequestInterceptor, 0, len(args.Interceptors))
+	for _, interceptor := range args.Interceptors {
+		if !check.IfNil(interceptor) {
+			interceptors = append(interceptors, interceptor)
+		}
+	}
+
 	return &clientWrapper{
-		url:    url,
-		client: providedClient,
+		url:            args.URL,
+		client:         providedClient,
+		retryPolicy:    retryPolicy,
+		requestLimiter: requestLimiter,
+		metricsHandler: metricsHandler,
+		interceptors:   interceptors,
 	}
 }
 
-// GetHTTP does a GET method operation on the specified endpoint
+// GetHTTP does a GET method operation on the specified endpoint. The request is retried as defined by the retry policy.
 func (wrapper *clientWrapper) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	return wrapper.doWithRetry(ctx, endpoint, http.MethodGet, true, nil, func() ([]byte, int, time.Duration, error) {
+		return wrapper.getHTTP(ctx, endpoint)
+	})
+}
+
+// getHTTP does the GET request and returns, besides the response, the delay asked by the server with Retry-After, if any
+func (wrapper *clientWrapper) getHTTP(ctx context.Context, endpoint string) ([]byte, int, time.Duration, error) {
 	url := fmt.Sprintf("%s/%s", wrapper.url, endpoint)
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return nil, http.StatusBadRequest, err
+		return nil, http.StatusBadRequest, 0, err
 	}
 
 	applyGetHeaderParams(request)
+	err = wrapper.beforeRequest(request)
+	if err != nil {
+		return nil, http.StatusBadRequest, 0, err
+	}
+
+	release, err := wrapper.requestLimiter.Acquire(ctx, wrapper.url, endpoint)
+	if err != nil {
+		return nil, http.StatusBadRequest, 0, err
+	}
+	defer release()
 
+	startTime := time.Now()
 	response, err := wrapper.client.Do(request)
+	wrapper.afterResponse(request, response, err)
 	if err != nil {
-		return nil, http.StatusBadRequest, err
+		wrapper.observeRequest(endpoint, http.MethodGet, 0, startTime)
+		return nil, http.StatusBadRequest, 0, err
 	}
+	retryAfter := wrapper.handleRetryAfter(endpoint, response)
 	defer func() {
 		_ = response.Body.Close()
 	}()
 
 	body, err := io.ReadAll(response.Body)
+	wrapper.observeRequest(endpoint, http.MethodGet, response.StatusCode, startTime)
 	if err != nil {
-		return nil, response.StatusCode, err
+		return nil, response.StatusCode, retryAfter, err
 	}
 
-	return body, response.StatusCode, nil
+	return body, response.StatusCode, retryAfter, nil
 }
 
-// PostHTTP does a POST method operation on the specified endpoint with the provided raw data bytes
+// PostHTTP does a POST method operation on the specified endpoint with the provided raw data bytes. The request is
+// retried as defined by the retry policy only if the context marks it as idempotent or if it carries a resend checker
+// that allows the request to be sent again.
 func (wrapper *clientWrapper) PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error) {
+	return wrapper.doWithRetry(ctx, endpoint, http.MethodPost, IsIdempotentRequest(ctx), ResendCheckerFromContext(ctx), func() ([]byte, int, time.Duration, error) {
+		return wrapper.postHTTP(ctx, endpoint, data)
+	})
+}
+
+// postHTTP does the POST request and returns, besides the response, the delay asked by the server with Retry-After, if
+// any
+func (wrapper *clientWrapper) postHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, time.Duration, error) {
 	url := fmt.Sprintf("%s/%s", wrapper.url, endpoint)
 	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
 	if err != nil {
-		return nil, http.StatusBadRequest, err
+		return nil, http.StatusBadRequest, 0, err
 	}
 
 	applyPostHeaderParams(request)
+	err = wrapper.beforeRequest(request)
+	if err != nil {
+		return nil, http.StatusBadRequest, 0, err
+	}
 
+	release, err := wrapper.requestLimiter.Acquire(ctx, wrapper.url, endpoint)
+	if err != nil {
+		return nil, http.StatusBadRequest, 0, err
+	}
+	defer release()
+
+	startTime := time.Now()
 	response, err := wrapper.client.Do(request)
+	wrapper.afterResponse(request, response, err)
 	if err != nil {
-		return nil, http.StatusBadRequest, err
+		wrapper.observeRequest(endpoint, http.MethodPost, 0, startTime)
+		return nil, http.StatusBadRequest, 0, err
 	}
+	retryAfter := wrapper.handleRetryAfter(endpoint, response)
 
 	defer func() {
 		_ = response.Body.Close()
 	}()
 
 	buff, err := io.ReadAll(response.Body)
+	wrapper.observeRequest(endpoint, http.MethodPost, response.StatusCode, startTime)
+
+	return buff, response.StatusCode, retryAfter, err
+}
+
+func (wrapper *clientWrapper) beforeRequest(request *http.Request) error {
+	for _, interceptor := range wrapper.interceptors {
+		err := interceptor.BeforeRequest(request)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (wrapper *clientWrapper) afterResponse(request *http.Request, response *http.Response, err error) {
+	for i := len(wrapper.interceptors) - 1; i >= 0; i-- {
+		wrapper.interceptors[i].AfterResponse(request, response, err)
+	}
+}
+
+// observeRequest reports the outcome of a request, using 0 as status code if no response was received
+func (wrapper *clientWrapper) observeRequest(endpoint string, method string, statusCode int, startTime time.Time) {
+	wrapper.metricsHandler.ObserveRequest(EndpointTemplate(endpoint), method, statusCode, time.Since(startTime))
+}
+
+// handleRetryAfter returns the delay asked by the server on throttling responses, capped to maxRetryAfter, and holds
+// back the requests of the endpoint's class towards this URL for that delay. Returns 0 if no delay was asked
+func (wrapper *clientWrapper) handleRetryAfter(endpoint string, response *http.Response) time.Duration {
+	if response.StatusCode != http.StatusTooManyRequests && response.StatusCode != http.StatusServiceUnavailable {
+		return 0
+	}
+
+	delay, ok := parseRetryAfter(response.Header.Get(httpRetryAfterKey), time.Now())
+	if !ok {
+		return 0
+	}
+	if delay > maxRetryAfter {
+		delay = maxRetryAfter
+	}
+
+	log.Debug("clientWrapper: server asked to retry later", "url", wrapper.url, "endpoint", endpoint, "delay", delay)
+	wrapper.requestLimiter.NotifyRetryAfter(wrapper.url, endpoint, delay)
+
+	return delay
+}
+
+// parseRetryAfter parses the Retry-After header value, which can be either a number of seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseUint(value, 10, 32)
+	if err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if date.Before(now) {
+		return 0, true
+	}
+
+	return date.Sub(now), true
+}
+
+func (wrapper *clientWrapper) doWithRetry(
+	ctx context.Context,
+	endpoint string,
+	method string,
+	isIdempotent bool,
+	resendChecker ResendChecker,
+	handler func() ([]byte, int, time.Duration, error),
+) ([]byte, int, error) {
+	buff, code, retryAfter, err := handler()
+	canRetry := isIdempotent || resendChecker != nil
+	if !canRetry {
+		return buff, code, err
+	}
+
+	for attempt := 1; attempt < wrapper.retryPolicy.MaxAttempts(); attempt++ {
+		if !wrapper.retryPolicy.IsRetryable(ctx, code, err) {
+			return buff, code, err
+		}
+
+		backoff := wrapper.retryPolicy.BackoffDuration(attempt)
+		if retryAfter > backoff {
+			// the server asked for a longer delay than the retry policy's backoff
+			backoff = retryAfter
+		}
+		log.Debug("clientWrapper: request failed, retrying", "url", wrapper.url, "attempt", attempt,
+			"code", code, "error", err, "backoff", backoff)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return buff, code, err
+		case <-timer.C:
+		}
+
+		if !isIdempotent {
+			canResend, response, errCheck := resendChecker(ctx)
+			if errCheck != nil {
+				log.Debug("clientWrapper: can not check if the request can be sent again", "url", wrapper.url, "error", errCheck)
+				return buff, code, err
+			}
+			if !canResend {
+				if response != nil {
+					return response, http.StatusOK, nil
+				}
+
+				return buff, code, err
+			}
+		}
+
+		wrapper.metricsHandler.IncrementRetries(EndpointTemplate(endpoint), method)
+		buff, code, retryAfter, err = handler()
+	}
 
-	return buff, response.StatusCode, err
+	return buff, code, err
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -2,16 +2,20 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
 )
 
 func TestNewClientWrapper(t *testing.T) {
@@ -98,3 +102,505 @@ func TestClientWrapper_PostHTTP(t *testing.T) {
 		assert.Equal(t, http.StatusOK, code)
 	})
 }
+
+type retryPolicyStub struct {
+	maxAttempts int
+}
+
+func (stub *retryPolicyStub) MaxAttempts() int {
+	return stub.maxAttempts
+}
+
+func (stub *retryPolicyStub) IsRetryable(ctx context.Context, statusCode int, err error) bool {
+	return ctx.Err() == nil && (err != nil || statusCode == http.StatusBadGateway || statusCode == http.StatusTooManyRequests)
+}
+
+func (stub *retryPolicyStub) BackoffDuration(_ int) time.Duration {
+	return time.Millisecond
+}
+
+func (stub *retryPolicyStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createFailingHttpServer(numFailures int32, numRequests *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if atomic.AddInt32(numRequests, 1) <= numFailures {
+			rw.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		rw.WriteHeader(http.StatusOK)
+		_, _ = rw.Write([]byte("response"))
+	}))
+}
+
+func TestClientWrapper_Retries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("GET should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(2, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		})
+
+		resp, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, []byte("response"), resp)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numRequests))
+	})
+	t.Run("GET should stop after the maximum number of attempts", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(3, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		})
+
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadGateway, code)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numRequests))
+	})
+	t.Run("GET should not be retried with the default policy", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(1, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapper(nil, testHttpServer.URL)
+
+		_, code, _ := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, http.StatusBadGateway, code)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numRequests))
+	})
+	t.Run("POST should not be retried if not idempotent", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(1, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		})
+
+		_, code, _ := wrapper.PostHTTP(context.Background(), "endpoint", nil)
+		assert.Equal(t, http.StatusBadGateway, code)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numRequests))
+	})
+	t.Run("idempotent POST should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(1, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		})
+
+		resp, code, err := wrapper.PostHTTP(WithIdempotentRequest(context.Background()), "endpoint", nil)
+		assert.Equal(t, []byte("response"), resp)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numRequests))
+	})
+	t.Run("POST with resend checker allowing the resend should be retried", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(1, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		})
+
+		numChecks := 0
+		ctx := WithResendChecker(context.Background(), func(ctx context.Context) (bool, []byte, error) {
+			numChecks++
+			return true, nil, nil
+		})
+		resp, code, err := wrapper.PostHTTP(ctx, "endpoint", nil)
+		assert.Equal(t, []byte("response"), resp)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numRequests))
+		assert.Equal(t, 1, numChecks)
+	})
+	t.Run("POST with resend checker returning the response should not be retried", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(1, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		})
+
+		ctx := WithResendChecker(context.Background(), func(ctx context.Context) (bool, []byte, error) {
+			return false, []byte("known response"), nil
+		})
+		resp, code, err := wrapper.PostHTTP(ctx, "endpoint", nil)
+		assert.Equal(t, []byte("known response"), resp)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numRequests))
+	})
+	t.Run("POST with resend checker erroring should not be retried", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(1, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		})
+
+		ctx := WithResendChecker(context.Background(), func(ctx context.Context) (bool, []byte, error) {
+			return true, nil, errors.New("expected error")
+		})
+		_, code, err := wrapper.PostHTTP(ctx, "endpoint", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadGateway, code)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numRequests))
+	})
+	t.Run("context done should stop the retries", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(10, &numRequests)
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL: testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{
+				maxAttempts: 10,
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, code, err := wrapper.GetHTTP(ctx, "endpoint")
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&numRequests))
+	})
+}
+
+func TestClientWrapper_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("throttling response should hold back the next requests of the same class", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if atomic.AddInt32(&numRequests, 1) == 1 {
+				rw.Header().Set(httpRetryAfterKey, "1")
+				rw.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testHttpServer.Close()
+
+		limiter, _ := NewRequestLimiter(ArgsRequestLimiter{})
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:            testHttpServer.URL,
+			RequestLimiter: limiter,
+		})
+
+		_, code, err := wrapper.GetHTTP(context.Background(), "transaction/status")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+		assert.Equal(t, uint64(1), limiter.State()[EndpointClassTransactions].NumRetryAfter)
+
+		// other classes are not held back
+		_, code, err = wrapper.GetHTTP(context.Background(), "network/config")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+
+		startTime := time.Now()
+		_, code, err = wrapper.GetHTTP(context.Background(), "transaction/status")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.GreaterOrEqual(t, time.Since(startTime), time.Millisecond*500)
+	})
+	t.Run("throttling response without a request limiter should not hold back the next requests", func(t *testing.T) {
+		t.Parallel()
+
+		testHttpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.Header().Set(httpRetryAfterKey, "100")
+			rw.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapper(nil, testHttpServer.URL)
+
+		_, code, err := wrapper.GetHTTP(context.Background(), "transaction/status")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+
+		startTime := time.Now()
+		_, code, err = wrapper.GetHTTP(context.Background(), "transaction/status")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusTooManyRequests, code)
+		assert.Less(t, time.Since(startTime), time.Second*10)
+	})
+	t.Run("throttling response without a request limiter should delay the retry", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if atomic.AddInt32(&numRequests, 1) == 1 {
+				rw.Header().Set(httpRetryAfterKey, "1")
+				rw.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testHttpServer.Close()
+
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 2},
+		})
+
+		startTime := time.Now()
+		_, code, err := wrapper.GetHTTP(context.Background(), "transaction/status")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numRequests))
+		// the retry waited for the delay asked by the server instead of the retry policy's backoff
+		assert.GreaterOrEqual(t, time.Since(startTime), time.Millisecond*900)
+	})
+	t.Run("retry after on a successful response should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		testHttpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			rw.Header().Set(httpRetryAfterKey, "100")
+			rw.WriteHeader(http.StatusOK)
+		}))
+		defer testHttpServer.Close()
+
+		limiter, _ := NewRequestLimiter(ArgsRequestLimiter{})
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:            testHttpServer.URL,
+			RequestLimiter: limiter,
+		})
+
+		_, code, err := wrapper.PostHTTP(context.Background(), "transaction/send", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, uint64(0), limiter.State()[EndpointClassTransactions].NumRetryAfter)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	delay, ok := parseRetryAfter("", now)
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	delay, ok = parseRetryAfter("invalid", now)
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	delay, ok = parseRetryAfter("-3", now)
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+
+	delay, ok = parseRetryAfter("12", now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Second*12, delay)
+
+	delay, ok = parseRetryAfter(now.Add(time.Minute).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, delay)
+
+	delay, ok = parseRetryAfter(now.Add(-time.Minute).Format(http.TimeFormat), now)
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), delay)
+}
+
+func TestClientWrapper_Metrics(t *testing.T) {
+	t.Parallel()
+
+	numRequests := int32(0)
+	testHttpServer := createFailingHttpServer(1, &numRequests)
+	defer testHttpServer.Close()
+
+	observedCodes := make([]int, 0)
+	observedEndpoints := make([]string, 0)
+	retriedEndpoints := make([]string, 0)
+	wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+		URL:         testHttpServer.URL,
+		RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+		MetricsHandler: &testsCommon.MetricsHandlerStub{
+			ObserveRequestCalled: func(endpoint string, method string, statusCode int, duration time.Duration) {
+				assert.Equal(t, http.MethodGet, method)
+				observedEndpoints = append(observedEndpoints, endpoint)
+				observedCodes = append(observedCodes, statusCode)
+			},
+			IncrementRetriesCalled: func(endpoint string, method string) {
+				assert.Equal(t, http.MethodGet, method)
+				retriedEndpoints = append(retriedEndpoints, endpoint)
+			},
+		},
+	})
+
+	_, code, err := wrapper.GetHTTP(context.Background(), "block/1/by-nonce/7?withTxs=true")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []int{http.StatusBadGateway, http.StatusOK}, observedCodes)
+	assert.Equal(t, []string{"block/:number/by-nonce/:number", "block/:number/by-nonce/:number"}, observedEndpoints)
+	assert.Equal(t, []string{"block/:number/by-nonce/:number"}, retriedEndpoints)
+
+	testHttpServer.Close()
+	_, _, err = wrapper.GetHTTP(context.Background(), "network/config")
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, observedCodes[len(observedCodes)-1])
+}
+
+type requestInterceptorStub struct {
+	beforeRequestCalled func(request *http.Request) error
+	afterResponseCalled func(request *http.Request, response *http.Response, err error)
+}
+
+func (stub *requestInterceptorStub) BeforeRequest(request *http.Request) error {
+	if stub.beforeRequestCalled != nil {
+		return stub.beforeRequestCalled(request)
+	}
+
+	return nil
+}
+
+func (stub *requestInterceptorStub) AfterResponse(request *http.Request, response *http.Response, err error) {
+	if stub.afterResponseCalled != nil {
+		stub.afterResponseCalled(request, response, err)
+	}
+}
+
+func (stub *requestInterceptorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func TestClientWrapper_Interceptors(t *testing.T) {
+	t.Parallel()
+
+	t.Run("interceptors should be called in order", func(t *testing.T) {
+		t.Parallel()
+
+		testHttpServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "key", req.Header.Get("X-Api-Key"))
+			assert.Equal(t, "request ID", req.Header.Get(DefaultRequestIDHeader))
+			assert.Equal(t, httpContentType, req.Header.Get(httpContentTypeKey))
+			rw.WriteHeader(http.StatusAccepted)
+		}))
+		defer testHttpServer.Close()
+
+		calls := make([]string, 0)
+		createInterceptor := func(name string) RequestInterceptor {
+			return &requestInterceptorStub{
+				beforeRequestCalled: func(request *http.Request) error {
+					calls = append(calls, "before "+name)
+					return nil
+				},
+				afterResponseCalled: func(request *http.Request, response *http.Response, err error) {
+					assert.Nil(t, err)
+					assert.Equal(t, http.StatusAccepted, response.StatusCode)
+					calls = append(calls, "after "+name)
+				},
+			}
+		}
+		headersInterceptor, _ := NewStaticHeadersInterceptor(map[string]string{"X-Api-Key": "key"})
+
+		wrapper := NewHttpClientWrapper(nil, testHttpServer.URL,
+			createInterceptor("first"),
+			nil,
+			headersInterceptor,
+			NewRequestIDInterceptor(""),
+			createInterceptor("second"),
+		)
+
+		ctx := WithRequestID(context.Background(), "request ID")
+		_, code, err := wrapper.PostHTTP(ctx, "endpoint", []byte("data"))
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusAccepted, code)
+		assert.Equal(t, []string{"before first", "before second", "after second", "after first"}, calls)
+	})
+	t.Run("interceptor error should abort the request", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(0, &numRequests)
+		defer testHttpServer.Close()
+
+		expectedErr := errors.New("expected error")
+		afterResponseCalled := false
+		wrapper := NewHttpClientWrapper(nil, testHttpServer.URL, &requestInterceptorStub{
+			beforeRequestCalled: func(request *http.Request) error {
+				return expectedErr
+			},
+			afterResponseCalled: func(request *http.Request, response *http.Response, err error) {
+				afterResponseCalled = true
+			},
+		})
+
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&numRequests))
+		assert.False(t, afterResponseCalled)
+	})
+	t.Run("interceptors should be called on each attempt", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := int32(0)
+		testHttpServer := createFailingHttpServer(2, &numRequests)
+		defer testHttpServer.Close()
+
+		statusCodes := make([]int, 0)
+		wrapper := NewHttpClientWrapperWithArgs(ArgsHttpClientWrapper{
+			URL:         testHttpServer.URL,
+			RetryPolicy: &retryPolicyStub{maxAttempts: 3},
+			Interceptors: []RequestInterceptor{&requestInterceptorStub{
+				afterResponseCalled: func(request *http.Request, response *http.Response, err error) {
+					statusCodes = append(statusCodes, response.StatusCode)
+				},
+			}},
+		})
+
+		_, code, err := wrapper.GetHTTP(context.Background(), "endpoint")
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK}, statusCodes)
+	})
+}
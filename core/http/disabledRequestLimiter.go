@@ -0,0 +1,25 @@
+package http
+
+import (
+	"context"
+	"time"
+)
+
+// DisabledRequestLimiter is a request limiter that does not limit the requests and does not hold back the other requests
+// on Retry-After delays
+type DisabledRequestLimiter struct {
+}
+
+// Acquire returns immediately
+func (limiter *DisabledRequestLimiter) Acquire(_ context.Context, _ string, _ string) (func(), error) {
+	return func() {}, nil
+}
+
+// NotifyRetryAfter does nothing
+func (limiter *DisabledRequestLimiter) NotifyRetryAfter(_ string, _ string, _ time.Duration) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (limiter *DisabledRequestLimiter) IsInterfaceNil() bool {
+	return limiter == nil
+}
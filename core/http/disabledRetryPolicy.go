@@ -0,0 +1,30 @@
+package http
+
+import (
+	"context"
+	"time"
+)
+
+// DisabledRetryPolicy is a retry policy that only does one attempt
+type DisabledRetryPolicy struct {
+}
+
+// MaxAttempts returns 1
+func (policy *DisabledRetryPolicy) MaxAttempts() int {
+	return 1
+}
+
+// IsRetryable returns false
+func (policy *DisabledRetryPolicy) IsRetryable(_ context.Context, _ int, _ error) bool {
+	return false
+}
+
+// BackoffDuration returns 0
+func (policy *DisabledRetryPolicy) BackoffDuration(_ int) time.Duration {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *DisabledRetryPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
@@ -0,0 +1,48 @@
+package http
+
+import "strings"
+
+// EndpointClass groups the REST API endpoints that put a similar load on the server
+type EndpointClass string
+
+const (
+	// EndpointClassAccounts groups the account, storage and token reads
+	EndpointClassAccounts EndpointClass = "accounts"
+	// EndpointClassBlocks groups the block, hyper block and raw data fetches
+	EndpointClassBlocks EndpointClass = "blocks"
+	// EndpointClassTransactions groups the transaction sends, simulations and lookups
+	EndpointClassTransactions EndpointClass = "transactions"
+	// EndpointClassVmQueries groups the smart contract queries
+	EndpointClassVmQueries EndpointClass = "vm-queries"
+	// EndpointClassNetwork groups the network configuration and node status reads
+	EndpointClassNetwork EndpointClass = "network"
+	// EndpointClassOther groups all the other endpoints
+	EndpointClassOther EndpointClass = "other"
+)
+
+var endpointClassPrefixes = []struct {
+	prefix string
+	class  EndpointClass
+}{
+	{prefix: "address/", class: EndpointClassAccounts},
+	{prefix: "block/", class: EndpointClassBlocks},
+	{prefix: "blocks/", class: EndpointClassBlocks},
+	{prefix: "hyperblock/", class: EndpointClassBlocks},
+	{prefix: "internal/", class: EndpointClassBlocks},
+	{prefix: "transaction/", class: EndpointClassTransactions},
+	{prefix: "vm-values/", class: EndpointClassVmQueries},
+	{prefix: "network/", class: EndpointClassNetwork},
+	{prefix: "node/", class: EndpointClassNetwork},
+}
+
+// ClassifyEndpoint returns the class of the provided REST API endpoint
+func ClassifyEndpoint(endpoint string) EndpointClass {
+	endpoint = strings.TrimPrefix(endpoint, "/")
+	for _, entry := range endpointClassPrefixes {
+		if strings.HasPrefix(endpoint, entry.prefix) {
+			return entry.class
+		}
+	}
+
+	return EndpointClassOther
+}
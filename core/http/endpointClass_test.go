@@ -0,0 +1,32 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testData := map[string]EndpointClass{
+		"address/drt1/nonce":              EndpointClassAccounts,
+		"/address/drt1/keys":              EndpointClassAccounts,
+		"block/0/by-nonce/7":              EndpointClassBlocks,
+		"blocks/by-round/7":               EndpointClassBlocks,
+		"hyperblock/by-hash/aabb":         EndpointClassBlocks,
+		"internal/raw/block/by-nonce/7":   EndpointClassBlocks,
+		"transaction/send":                EndpointClassTransactions,
+		"transaction/pool?by-sender=drt1": EndpointClassTransactions,
+		"vm-values/query":                 EndpointClassVmQueries,
+		"network/config":                  EndpointClassNetwork,
+		"node/status":                     EndpointClassNetwork,
+		"validator/statistics":            EndpointClassOther,
+		"":                                EndpointClassOther,
+		"addresses":                       EndpointClassOther,
+	}
+
+	for endpoint, expectedClass := range testData {
+		assert.Equal(t, expectedClass, ClassifyEndpoint(endpoint), "endpoint: %s", endpoint)
+	}
+}
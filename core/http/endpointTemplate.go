@@ -0,0 +1,50 @@
+package http
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode"
+
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+)
+
+const (
+	templateNumber  = ":number"
+	templateAddress = ":address"
+	templateHex     = ":hex"
+	templateToken   = ":token"
+)
+
+// EndpointTemplate returns the provided REST API endpoint with the query parameters removed and the path parameters
+// (numbers, addresses, hex encoded values and token identifiers) replaced by placeholders, so that it can be used
+// as a low cardinality label
+func EndpointTemplate(endpoint string) string {
+	path, _, _ := strings.Cut(strings.TrimPrefix(endpoint, "/"), "?")
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = templateSegment(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func templateSegment(segment string) string {
+	if len(segment) == 0 {
+		return segment
+	}
+	if _, err := strconv.ParseUint(segment, 10, 64); err == nil {
+		return templateNumber
+	}
+	if _, err := sdkCore.AddressPublicKeyConverter.Decode(segment); err == nil {
+		return templateAddress
+	}
+	if _, err := hex.DecodeString(segment); err == nil {
+		return templateHex
+	}
+	if strings.Contains(segment, "-") && unicode.IsUpper(rune(segment[0])) {
+		return templateToken
+	}
+
+	return segment
+}
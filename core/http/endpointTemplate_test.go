@@ -0,0 +1,30 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointTemplate(t *testing.T) {
+	t.Parallel()
+
+	address := "drt1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssey5egf"
+	testData := map[string]string{
+		"":                                   "",
+		"network/config":                     "network/config",
+		"/node/status/4294967295":            "node/status/:number",
+		"address/" + address:                 "address/:address",
+		"address/" + address + "/key/0a0b0c": "address/:address/key/:hex",
+		"address/" + address + "/dcdt/WREWA-abcdef":      "address/:address/dcdt/:token",
+		"address/" + address + "/nft/NFT-abcdef/nonce/7": "address/:address/nft/:token/nonce/:number",
+		"block/1/by-nonce/7?withTxs=true":                "block/:number/by-nonce/:number",
+		"transaction/pool?by-sender=" + address:          "transaction/pool",
+		"hyperblock/by-hash/aabbccdd":                    "hyperblock/by-hash/:hex",
+		"transaction/send":                               "transaction/send",
+	}
+
+	for endpoint, expectedTemplate := range testData {
+		assert.Equal(t, expectedTemplate, EndpointTemplate(endpoint), "endpoint: %s", endpoint)
+	}
+}
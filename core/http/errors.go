@@ -0,0 +1,33 @@
+package http
+
+import "errors"
+
+// ErrInvalidMaxAttempts signals that an invalid maximum number of attempts was provided
+var ErrInvalidMaxAttempts = errors.New("invalid maximum number of attempts")
+
+// ErrInvalidBackoffDuration signals that an invalid backoff duration was provided
+var ErrInvalidBackoffDuration = errors.New("invalid backoff duration")
+
+// ErrInvalidBackoffMultiplier signals that an invalid backoff multiplier was provided
+var ErrInvalidBackoffMultiplier = errors.New("invalid backoff multiplier")
+
+// ErrInvalidJitterFactor signals that an invalid jitter factor was provided
+var ErrInvalidJitterFactor = errors.New("invalid jitter factor")
+
+// ErrInvalidRequestsPerSecond signals that an invalid number of requests per second was provided
+var ErrInvalidRequestsPerSecond = errors.New("invalid requests per second")
+
+// ErrInvalidBurst signals that an invalid burst was provided
+var ErrInvalidBurst = errors.New("invalid burst")
+
+// ErrInvalidMaxInFlight signals that an invalid maximum number of in-flight requests was provided
+var ErrInvalidMaxInFlight = errors.New("invalid maximum number of in-flight requests")
+
+// ErrNoHeaders signals that no headers were provided
+var ErrNoHeaders = errors.New("no headers")
+
+// ErrNilAuthClient signals that a nil auth client was provided
+var ErrNilAuthClient = errors.New("nil auth client")
+
+// ErrEmptyHeaderName signals that an empty header name was provided
+var ErrEmptyHeaderName = errors.New("empty header name")
@@ -1,8 +1,44 @@
 package http
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+	"time"
+)
 
 // Client is the interface we expect to call in order to do the HTTP requests
 type Client interface {
 	Do(req *http.Request) (*http.Response, error)
 }
+
+// RetryPolicy defines the behavior of a component able to decide if and when a failed request should be retried
+type RetryPolicy interface {
+	MaxAttempts() int
+	IsRetryable(ctx context.Context, statusCode int, err error) bool
+	BackoffDuration(attempt int) time.Duration
+	IsInterfaceNil() bool
+}
+
+// RequestLimiter defines the component able to limit the rate and the concurrency of the requests. Acquire blocks
+// until the request can be sent and returns the function that must be called after the response was read.
+// NotifyRetryAfter holds back the requests towards the provided URL, as asked by its server
+type RequestLimiter interface {
+	Acquire(ctx context.Context, url string, endpoint string) (release func(), err error)
+	NotifyRetryAfter(url string, endpoint string, delay time.Duration)
+	IsInterfaceNil() bool
+}
+
+// ResendChecker is the handler called before re-posting a non-idempotent request. It returns true if the request can
+// be safely sent again. If the request should not be sent again but its outcome is already known, the handler can
+// return the response that should be used instead.
+type ResendChecker func(ctx context.Context) (canResend bool, response []byte, err error)
+
+// RequestInterceptor defines a component able to change the requests before they are sent and to inspect the
+// responses. BeforeRequest is called before each attempt of a request, after the default headers were applied, and
+// an error returned by it aborts the attempt. AfterResponse is called after each attempt with the received response,
+// or with the error if no response was received, and it must not consume the response body
+type RequestInterceptor interface {
+	BeforeRequest(request *http.Request) error
+	AfterResponse(request *http.Request, response *http.Response, err error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/pborman/uuid"
+)
+
+// DefaultRequestIDHeader is the header used to propagate the request ID if no other header is provided
+const DefaultRequestIDHeader = "X-Request-ID"
+
+type requestIDInterceptor struct {
+	headerName string
+}
+
+// NewRequestIDInterceptor creates a request interceptor that sets the request ID on each request, in the provided
+// header. The request ID is taken from the request's context, as set by WithRequestID, so all the attempts of a
+// request and all the requests done with the same context share it. If the context does not carry a request ID, a new
+// one is generated for each attempt. If the header name is empty, DefaultRequestIDHeader is used
+func NewRequestIDInterceptor(headerName string) *requestIDInterceptor {
+	if len(headerName) == 0 {
+		headerName = DefaultRequestIDHeader
+	}
+
+	return &requestIDInterceptor{
+		headerName: headerName,
+	}
+}
+
+// BeforeRequest sets the request ID header on the request
+func (interceptor *requestIDInterceptor) BeforeRequest(request *http.Request) error {
+	requestID, found := RequestIDFromContext(request.Context())
+	if !found {
+		requestID = uuid.New()
+	}
+
+	request.Header.Set(interceptor.headerName, requestID)
+
+	return nil
+}
+
+// AfterResponse logs the request ID of the failed requests
+func (interceptor *requestIDInterceptor) AfterResponse(request *http.Request, response *http.Response, err error) {
+	if err == nil && response.StatusCode < http.StatusBadRequest {
+		return
+	}
+
+	statusCode := 0
+	if response != nil {
+		statusCode = response.StatusCode
+	}
+
+	log.Debug("requestIDInterceptor: request failed", "url", request.URL.String(),
+		"request ID", request.Header.Get(interceptor.headerName), "code", statusCode, "error", err)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interceptor *requestIDInterceptor) IsInterfaceNil() bool {
+	return interceptor == nil
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequestIDInterceptor(t *testing.T) {
+	t.Parallel()
+
+	interceptor := NewRequestIDInterceptor("")
+	assert.False(t, check.IfNil(interceptor))
+	assert.Equal(t, DefaultRequestIDHeader, interceptor.headerName)
+
+	interceptor = NewRequestIDInterceptor("X-Trace-ID")
+	assert.Equal(t, "X-Trace-ID", interceptor.headerName)
+}
+
+func TestRequestIDInterceptor_BeforeRequest(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should propagate the request ID from the context", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := NewRequestIDInterceptor("")
+		ctx := WithRequestID(context.Background(), "request ID")
+		request := httptest.NewRequest(http.MethodGet, "/endpoint", nil).WithContext(ctx)
+
+		err := interceptor.BeforeRequest(request)
+		assert.Nil(t, err)
+		assert.Equal(t, "request ID", request.Header.Get(DefaultRequestIDHeader))
+	})
+	t.Run("should generate a request ID if the context does not carry one", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := NewRequestIDInterceptor("")
+		request1 := httptest.NewRequest(http.MethodGet, "/endpoint", nil)
+		request2 := httptest.NewRequest(http.MethodGet, "/endpoint", nil).WithContext(WithRequestID(context.Background(), ""))
+
+		_ = interceptor.BeforeRequest(request1)
+		_ = interceptor.BeforeRequest(request2)
+		assert.NotEmpty(t, request1.Header.Get(DefaultRequestIDHeader))
+		assert.NotEmpty(t, request2.Header.Get(DefaultRequestIDHeader))
+		assert.NotEqual(t, request1.Header.Get(DefaultRequestIDHeader), request2.Header.Get(DefaultRequestIDHeader))
+	})
+}
@@ -0,0 +1,368 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const defaultBurst = 1
+
+// RequestLimits holds the limits applied to the requests of an endpoint class
+type RequestLimits struct {
+	// RequestsPerSecond is the average rate at which the requests are sent. 0 disables the rate limiting
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that can be sent at once, above the average rate. Defaults to 1
+	Burst int
+	// MaxInFlight is the maximum number of concurrent requests. 0 disables the concurrency limiting
+	MaxInFlight int
+}
+
+// ArgsRequestLimiter is the DTO used in the request limiter constructor
+type ArgsRequestLimiter struct {
+	// DefaultLimits are applied on each endpoint class that does not have its own limits
+	DefaultLimits RequestLimits
+	// ClassLimits holds the limits of specific endpoint classes
+	ClassLimits map[EndpointClass]RequestLimits
+}
+
+// RequestLimiterState holds the observable state of the request limiter for an endpoint class. ThrottledUntil is the
+// latest time until which the class is held back on one of the URLs
+type RequestLimiterState struct {
+	AvailableTokens float64
+	InFlight        int
+	Waiting         int
+	ThrottledUntil  time.Time
+	NumRequests     uint64
+	NumRetryAfter   uint64
+	TotalWaitTime   time.Duration
+}
+
+type retryAfterKey struct {
+	url   string
+	class EndpointClass
+}
+
+type requestLimiter struct {
+	defaultLimits RequestLimits
+	classLimits   map[EndpointClass]RequestLimits
+
+	mutClasses sync.Mutex
+	classes    map[EndpointClass]*classLimiter
+	pauses     map[retryAfterKey]time.Time
+}
+
+// NewRequestLimiter creates a request limiter that applies, for each endpoint class, a token bucket rate limit and a
+// cap on the number of in-flight requests. Each endpoint class has its own bucket and in-flight counter, shared by all
+// the URLs using the limiter. The delays asked by a server with Retry-After only hold back the requests towards that
+// server's URL.
+func NewRequestLimiter(args ArgsRequestLimiter) (*requestLimiter, error) {
+	err := checkRequestLimits(args.DefaultLimits)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the default limits", err)
+	}
+
+	classLimits := make(map[EndpointClass]RequestLimits, len(args.ClassLimits))
+	for class, limits := range args.ClassLimits {
+		err = checkRequestLimits(limits)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the %s endpoint class", err, class)
+		}
+
+		classLimits[class] = limits
+	}
+
+	return newRequestLimiter(args.DefaultLimits, classLimits), nil
+}
+
+func newRequestLimiter(defaultLimits RequestLimits, classLimits map[EndpointClass]RequestLimits) *requestLimiter {
+	return &requestLimiter{
+		defaultLimits: defaultLimits,
+		classLimits:   classLimits,
+		classes:       make(map[EndpointClass]*classLimiter),
+		pauses:        make(map[retryAfterKey]time.Time),
+	}
+}
+
+func checkRequestLimits(limits RequestLimits) error {
+	if limits.RequestsPerSecond < 0 || math.IsNaN(limits.RequestsPerSecond) || math.IsInf(limits.RequestsPerSecond, 0) {
+		return fmt.Errorf("%w, provided: %v", ErrInvalidRequestsPerSecond, limits.RequestsPerSecond)
+	}
+	if limits.Burst < 0 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidBurst, limits.Burst)
+	}
+	if limits.MaxInFlight < 0 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidMaxInFlight, limits.MaxInFlight)
+	}
+
+	return nil
+}
+
+// Acquire blocks until the request on the provided URL and endpoint is allowed by the limits of the endpoint's class,
+// or until the context is done. The returned function must be called once the response was consumed.
+func (limiter *requestLimiter) Acquire(ctx context.Context, url string, endpoint string) (func(), error) {
+	startTime := time.Now()
+	key := retryAfterKey{
+		url:   url,
+		class: ClassifyEndpoint(endpoint),
+	}
+
+	err := limiter.waitForRetryAfter(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return limiter.getClassLimiter(key.class).acquire(ctx, startTime)
+}
+
+// NotifyRetryAfter holds back the requests of the endpoint's class towards the provided URL for the provided duration
+func (limiter *requestLimiter) NotifyRetryAfter(url string, endpoint string, delay time.Duration) {
+	key := retryAfterKey{
+		url:   url,
+		class: ClassifyEndpoint(endpoint),
+	}
+	cl := limiter.getClassLimiter(key.class)
+	cl.countRetryAfter()
+
+	limiter.mutClasses.Lock()
+	defer limiter.mutClasses.Unlock()
+
+	until := time.Now().Add(delay)
+	if until.After(limiter.pauses[key]) {
+		limiter.pauses[key] = until
+	}
+}
+
+func (limiter *requestLimiter) waitForRetryAfter(ctx context.Context, key retryAfterKey) error {
+	for {
+		delay := limiter.computeRetryAfterDelay(key, time.Now())
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (limiter *requestLimiter) computeRetryAfterDelay(key retryAfterKey, now time.Time) time.Duration {
+	limiter.mutClasses.Lock()
+	defer limiter.mutClasses.Unlock()
+
+	pausedUntil, found := limiter.pauses[key]
+	if !found {
+		return 0
+	}
+	if !now.Before(pausedUntil) {
+		delete(limiter.pauses, key)
+		return 0
+	}
+
+	return pausedUntil.Sub(now)
+}
+
+// State returns the current state of each endpoint class that was used so far
+func (limiter *requestLimiter) State() map[EndpointClass]RequestLimiterState {
+	limiter.mutClasses.Lock()
+	defer limiter.mutClasses.Unlock()
+
+	state := make(map[EndpointClass]RequestLimiterState, len(limiter.classes))
+	for class, cl := range limiter.classes {
+		state[class] = cl.state(time.Now())
+	}
+	for key, pausedUntil := range limiter.pauses {
+		classState := state[key.class]
+		if pausedUntil.After(classState.ThrottledUntil) {
+			classState.ThrottledUntil = pausedUntil
+			state[key.class] = classState
+		}
+	}
+
+	return state
+}
+
+func (limiter *requestLimiter) getClassLimiter(class EndpointClass) *classLimiter {
+	limiter.mutClasses.Lock()
+	defer limiter.mutClasses.Unlock()
+
+	cl, found := limiter.classes[class]
+	if found {
+		return cl
+	}
+
+	limits, found := limiter.classLimits[class]
+	if !found {
+		limits = limiter.defaultLimits
+	}
+	cl = newClassLimiter(limits)
+	limiter.classes[class] = cl
+
+	return cl
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (limiter *requestLimiter) IsInterfaceNil() bool {
+	return limiter == nil
+}
+
+type classLimiter struct {
+	requestsPerSecond float64
+	burst             float64
+	inFlightSlots     chan struct{}
+
+	mut           sync.Mutex
+	tokens        float64
+	lastRefill    time.Time
+	numInFlight   int
+	numWaiting    int
+	numRequests   uint64
+	numRetryAfter uint64
+	totalWaitTime time.Duration
+}
+
+func newClassLimiter(limits RequestLimits) *classLimiter {
+	burst := limits.Burst
+	if burst == 0 {
+		burst = defaultBurst
+	}
+
+	cl := &classLimiter{
+		requestsPerSecond: limits.RequestsPerSecond,
+		burst:             float64(burst),
+		tokens:            float64(burst),
+		lastRefill:        time.Now(),
+	}
+	if limits.MaxInFlight > 0 {
+		cl.inFlightSlots = make(chan struct{}, limits.MaxInFlight)
+	}
+
+	return cl
+}
+
+func (cl *classLimiter) acquire(ctx context.Context, startTime time.Time) (func(), error) {
+	cl.changeNumWaiting(1)
+	defer cl.changeNumWaiting(-1)
+
+	if cl.inFlightSlots != nil {
+		select {
+		case cl.inFlightSlots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	err := cl.waitForToken(ctx)
+	if err != nil {
+		cl.releaseSlot()
+		return nil, err
+	}
+
+	cl.mut.Lock()
+	cl.numInFlight++
+	cl.numRequests++
+	cl.totalWaitTime += time.Since(startTime)
+	cl.mut.Unlock()
+
+	once := sync.Once{}
+	release := func() {
+		once.Do(func() {
+			cl.mut.Lock()
+			cl.numInFlight--
+			cl.mut.Unlock()
+
+			cl.releaseSlot()
+		})
+	}
+
+	return release, nil
+}
+
+func (cl *classLimiter) changeNumWaiting(delta int) {
+	cl.mut.Lock()
+	cl.numWaiting += delta
+	cl.mut.Unlock()
+}
+
+func (cl *classLimiter) releaseSlot() {
+	if cl.inFlightSlots != nil {
+		<-cl.inFlightSlots
+	}
+}
+
+func (cl *classLimiter) waitForToken(ctx context.Context) error {
+	for {
+		delay := cl.reserveToken(time.Now())
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserveToken consumes a token and returns 0 if one is available, otherwise returns the time to wait for the next one
+func (cl *classLimiter) reserveToken(now time.Time) time.Duration {
+	cl.mut.Lock()
+	defer cl.mut.Unlock()
+
+	if cl.requestsPerSecond == 0 {
+		return 0
+	}
+
+	cl.tokens = cl.computeTokens(now)
+	cl.lastRefill = now
+	if cl.tokens >= 1 {
+		cl.tokens--
+		return 0
+	}
+
+	missingTokens := 1 - cl.tokens
+	return time.Duration(missingTokens / cl.requestsPerSecond * float64(time.Second))
+}
+
+func (cl *classLimiter) computeTokens(now time.Time) float64 {
+	elapsed := now.Sub(cl.lastRefill)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	return math.Min(cl.burst, cl.tokens+elapsed.Seconds()*cl.requestsPerSecond)
+}
+
+func (cl *classLimiter) countRetryAfter() {
+	cl.mut.Lock()
+	cl.numRetryAfter++
+	cl.mut.Unlock()
+}
+
+func (cl *classLimiter) state(now time.Time) RequestLimiterState {
+	cl.mut.Lock()
+	defer cl.mut.Unlock()
+
+	availableTokens := math.Inf(1)
+	if cl.requestsPerSecond > 0 {
+		availableTokens = cl.computeTokens(now)
+	}
+
+	return RequestLimiterState{
+		AvailableTokens: availableTokens,
+		InFlight:        cl.numInFlight,
+		Waiting:         cl.numWaiting,
+		NumRequests:     cl.numRequests,
+		NumRetryAfter:   cl.numRetryAfter,
+		TotalWaitTime:   cl.totalWaitTime,
+	}
+}
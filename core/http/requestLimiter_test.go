@@ -0,0 +1,231 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testURL = "http://localhost:8079"
+
+func TestNewRequestLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid requests per second should error", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, err := NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{RequestsPerSecond: -1},
+		})
+		assert.True(t, check.IfNil(limiter))
+		assert.True(t, errors.Is(err, ErrInvalidRequestsPerSecond))
+
+		limiter, err = NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{RequestsPerSecond: math.Inf(1)},
+		})
+		assert.True(t, check.IfNil(limiter))
+		assert.True(t, errors.Is(err, ErrInvalidRequestsPerSecond))
+	})
+	t.Run("invalid burst should error", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, err := NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{Burst: -1},
+		})
+		assert.True(t, check.IfNil(limiter))
+		assert.True(t, errors.Is(err, ErrInvalidBurst))
+	})
+	t.Run("invalid max in flight for a class should error", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, err := NewRequestLimiter(ArgsRequestLimiter{
+			ClassLimits: map[EndpointClass]RequestLimits{
+				EndpointClassVmQueries: {MaxInFlight: -1},
+			},
+		})
+		assert.True(t, check.IfNil(limiter))
+		assert.True(t, errors.Is(err, ErrInvalidMaxInFlight))
+		assert.Contains(t, err.Error(), string(EndpointClassVmQueries))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, err := NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{RequestsPerSecond: 10, Burst: 5, MaxInFlight: 2},
+		})
+		assert.False(t, check.IfNil(limiter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRequestLimiter_Acquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no limits should not block", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, _ := NewRequestLimiter(ArgsRequestLimiter{})
+		for i := 0; i < 100; i++ {
+			release, err := limiter.Acquire(context.Background(), testURL, "address/drt1")
+			require.Nil(t, err)
+			release()
+		}
+
+		state := limiter.State()[EndpointClassAccounts]
+		assert.Equal(t, uint64(100), state.NumRequests)
+		assert.Equal(t, 0, state.InFlight)
+		assert.True(t, math.IsInf(state.AvailableTokens, 1))
+	})
+	t.Run("rate limit should delay the requests above the burst", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, _ := NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{RequestsPerSecond: 20, Burst: 2},
+		})
+
+		startTime := time.Now()
+		for i := 0; i < 4; i++ {
+			release, err := limiter.Acquire(context.Background(), testURL, "transaction/send")
+			require.Nil(t, err)
+			release()
+		}
+
+		// 2 requests are served from the burst, the other 2 wait 50ms each
+		assert.GreaterOrEqual(t, time.Since(startTime), time.Millisecond*90)
+		assert.Greater(t, limiter.State()[EndpointClassTransactions].TotalWaitTime, time.Duration(0))
+	})
+	t.Run("endpoint classes should have separate buckets", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, _ := NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{RequestsPerSecond: 0.001},
+			ClassLimits: map[EndpointClass]RequestLimits{
+				EndpointClassNetwork: {},
+			},
+		})
+
+		release, err := limiter.Acquire(context.Background(), testURL, "vm-values/query")
+		require.Nil(t, err)
+		release()
+		release, err = limiter.Acquire(context.Background(), testURL, "address/drt1")
+		require.Nil(t, err)
+		release()
+		for i := 0; i < 10; i++ {
+			release, err = limiter.Acquire(context.Background(), testURL, "network/config")
+			require.Nil(t, err)
+			release()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+		_, err = limiter.Acquire(ctx, testURL, "vm-values/query")
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+	t.Run("max in flight should cap the concurrent requests", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, _ := NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{MaxInFlight: 2},
+		})
+
+		numInFlight := int32(0)
+		maxInFlight := int32(0)
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				release, err := limiter.Acquire(context.Background(), testURL, "block/by-nonce/1")
+				if err != nil {
+					return
+				}
+				defer release()
+
+				current := atomic.AddInt32(&numInFlight, 1)
+				for {
+					max := atomic.LoadInt32(&maxInFlight)
+					if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond * 10)
+				atomic.AddInt32(&numInFlight, -1)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(2), atomic.LoadInt32(&maxInFlight))
+		assert.Equal(t, uint64(10), limiter.State()[EndpointClassBlocks].NumRequests)
+	})
+	t.Run("context done while waiting for a slot should error and not leak the slot", func(t *testing.T) {
+		t.Parallel()
+
+		limiter, _ := NewRequestLimiter(ArgsRequestLimiter{
+			DefaultLimits: RequestLimits{MaxInFlight: 1},
+		})
+
+		release, err := limiter.Acquire(context.Background(), testURL, "block/by-nonce/1")
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+		defer cancel()
+		_, err = limiter.Acquire(ctx, testURL, "block/by-nonce/2")
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Equal(t, 1, limiter.State()[EndpointClassBlocks].InFlight)
+
+		// releasing twice should not free more slots
+		release()
+		release()
+		release, err = limiter.Acquire(context.Background(), testURL, "block/by-nonce/2")
+		require.Nil(t, err)
+		assert.Equal(t, 1, limiter.State()[EndpointClassBlocks].InFlight)
+		release()
+		assert.Equal(t, 0, limiter.State()[EndpointClassBlocks].InFlight)
+	})
+}
+
+func TestRequestLimiter_NotifyRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	limiter, _ := NewRequestLimiter(ArgsRequestLimiter{})
+	limiter.NotifyRetryAfter(testURL, "transaction/send", time.Millisecond*100)
+
+	state := limiter.State()[EndpointClassTransactions]
+	assert.Equal(t, uint64(1), state.NumRetryAfter)
+	assert.True(t, state.ThrottledUntil.After(time.Now()))
+
+	// other endpoint classes are not affected
+	release, err := limiter.Acquire(context.Background(), testURL, "address/drt1")
+	require.Nil(t, err)
+	release()
+
+	startTime := time.Now()
+	release, err = limiter.Acquire(context.Background(), testURL, "transaction/send")
+	require.Nil(t, err)
+	release()
+	assert.GreaterOrEqual(t, time.Since(startTime), time.Millisecond*80)
+
+	// a shorter delay should not shorten the current pause
+	limiter.NotifyRetryAfter(testURL, "transaction/send", time.Second)
+	throttledUntil := limiter.State()[EndpointClassTransactions].ThrottledUntil
+	limiter.NotifyRetryAfter(testURL, "transaction/send", time.Millisecond)
+	state = limiter.State()[EndpointClassTransactions]
+	assert.Equal(t, throttledUntil, state.ThrottledUntil)
+	assert.Equal(t, uint64(3), state.NumRetryAfter)
+
+	// the same endpoint class on other URLs is not affected
+	startTime = time.Now()
+	release, err = limiter.Acquire(context.Background(), "http://localhost:8080", "transaction/send")
+	require.Nil(t, err)
+	release()
+	assert.Less(t, time.Since(startTime), time.Millisecond*500)
+}
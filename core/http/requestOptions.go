@@ -0,0 +1,53 @@
+package http
+
+import "context"
+
+type contextKey string
+
+const (
+	idempotentRequestKey contextKey = "idempotentRequest"
+	resendCheckerKey     contextKey = "resendChecker"
+	requestIDKey         contextKey = "requestID"
+)
+
+// WithIdempotentRequest returns a context that marks the POST request done with it as idempotent, meaning that it can
+// be retried without any other check. GET requests are always considered idempotent.
+func WithIdempotentRequest(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentRequestKey, true)
+}
+
+// WithResendChecker returns a context that attaches the provided checker to the POST request done with it. The checker
+// is called before each retry of the request and decides if the request can be re-posted.
+func WithResendChecker(ctx context.Context, checker ResendChecker) context.Context {
+	return context.WithValue(ctx, resendCheckerKey, checker)
+}
+
+// WithRequestID returns a context that attaches the provided request ID to the requests done with it. The request ID
+// is sent by the request ID interceptor
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID attached to the context, if any
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey).(string)
+
+	return requestID, ok && len(requestID) > 0
+}
+
+// IsIdempotentRequest returns true if the context marks the POST request done with it as idempotent
+func IsIdempotentRequest(ctx context.Context) bool {
+	isIdempotent, ok := ctx.Value(idempotentRequestKey).(bool)
+
+	return ok && isIdempotent
+}
+
+// ResendCheckerFromContext returns the resend checker attached to the context, if any
+func ResendCheckerFromContext(ctx context.Context) ResendChecker {
+	checker, ok := ctx.Value(resendCheckerKey).(ResendChecker)
+	if !ok {
+		return nil
+	}
+
+	return checker
+}
@@ -0,0 +1,137 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	minMaxAttempts       = 1
+	minBackoffMultiplier = 1.0
+	maxJitterFactor      = 1.0
+)
+
+// DefaultRetryableStatusCodes holds the HTTP status codes that are retried if no other status codes are provided
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// ArgsExponentialBackoffRetryPolicy is the DTO used in the exponential backoff retry policy constructor
+type ArgsExponentialBackoffRetryPolicy struct {
+	MaxAttempts          int
+	InitialBackoff       time.Duration
+	MaxBackoff           time.Duration
+	BackoffMultiplier    float64
+	JitterFactor         float64
+	RetryableStatusCodes []int
+}
+
+type exponentialBackoffRetryPolicy struct {
+	maxAttempts          int
+	initialBackoff       time.Duration
+	maxBackoff           time.Duration
+	backoffMultiplier    float64
+	jitterFactor         float64
+	retryableStatusCodes map[int]struct{}
+	randomHandler        func() float64
+}
+
+// NewExponentialBackoffRetryPolicy creates a retry policy that waits exponentially more between consecutive attempts.
+// Each backoff duration is decreased by a random amount, up to the jitter factor, so that multiple clients do not
+// retry at the same time.
+func NewExponentialBackoffRetryPolicy(args ArgsExponentialBackoffRetryPolicy) (*exponentialBackoffRetryPolicy, error) {
+	err := checkArgsExponentialBackoffRetryPolicy(args)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCodes := args.RetryableStatusCodes
+	if len(statusCodes) == 0 {
+		statusCodes = DefaultRetryableStatusCodes
+	}
+
+	retryableStatusCodes := make(map[int]struct{}, len(statusCodes))
+	for _, statusCode := range statusCodes {
+		retryableStatusCodes[statusCode] = struct{}{}
+	}
+
+	return &exponentialBackoffRetryPolicy{
+		maxAttempts:          args.MaxAttempts,
+		initialBackoff:       args.InitialBackoff,
+		maxBackoff:           args.MaxBackoff,
+		backoffMultiplier:    args.BackoffMultiplier,
+		jitterFactor:         args.JitterFactor,
+		retryableStatusCodes: retryableStatusCodes,
+		randomHandler:        rand.Float64,
+	}, nil
+}
+
+func checkArgsExponentialBackoffRetryPolicy(args ArgsExponentialBackoffRetryPolicy) error {
+	if args.MaxAttempts < minMaxAttempts {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", ErrInvalidMaxAttempts, args.MaxAttempts, minMaxAttempts)
+	}
+	if args.InitialBackoff < 0 {
+		return fmt.Errorf("%w for the initial backoff, provided: %v", ErrInvalidBackoffDuration, args.InitialBackoff)
+	}
+	if args.MaxBackoff < args.InitialBackoff {
+		return fmt.Errorf("%w for the maximum backoff, provided: %v, minimum: %v",
+			ErrInvalidBackoffDuration, args.MaxBackoff, args.InitialBackoff)
+	}
+	if args.BackoffMultiplier < minBackoffMultiplier {
+		return fmt.Errorf("%w, provided: %v, minimum: %v", ErrInvalidBackoffMultiplier, args.BackoffMultiplier, minBackoffMultiplier)
+	}
+	if args.JitterFactor < 0 || args.JitterFactor > maxJitterFactor {
+		return fmt.Errorf("%w, provided: %v, allowed interval: [0, %v]", ErrInvalidJitterFactor, args.JitterFactor, maxJitterFactor)
+	}
+
+	return nil
+}
+
+// MaxAttempts returns the maximum number of attempts, including the first one
+func (policy *exponentialBackoffRetryPolicy) MaxAttempts() int {
+	return policy.maxAttempts
+}
+
+// IsRetryable returns true if the request that resulted in the provided status code and error can be retried.
+// Transport errors (including per-attempt timeouts) are retryable, while an expired or canceled context is not.
+func (policy *exponentialBackoffRetryPolicy) IsRetryable(ctx context.Context, statusCode int, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+
+	_, found := policy.retryableStatusCodes[statusCode]
+	return found
+}
+
+// BackoffDuration returns the time to wait before the next attempt. The attempt parameter starts from 1,
+// meaning the wait time after the first failed attempt.
+func (policy *exponentialBackoffRetryPolicy) BackoffDuration(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	backoff := float64(policy.initialBackoff) * math.Pow(policy.backoffMultiplier, float64(attempt-1))
+	if backoff > float64(policy.maxBackoff) {
+		backoff = float64(policy.maxBackoff)
+	}
+
+	backoff -= backoff * policy.jitterFactor * policy.randomHandler()
+
+	return time.Duration(backoff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (policy *exponentialBackoffRetryPolicy) IsInterfaceNil() bool {
+	return policy == nil
+}
@@ -0,0 +1,161 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsExponentialBackoffRetryPolicy() ArgsExponentialBackoffRetryPolicy {
+	return ArgsExponentialBackoffRetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond * 100,
+		MaxBackoff:        time.Second,
+		BackoffMultiplier: 2,
+		JitterFactor:      0.5,
+	}
+}
+
+func TestNewExponentialBackoffRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max attempts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExponentialBackoffRetryPolicy()
+		args.MaxAttempts = 0
+		policy, err := NewExponentialBackoffRetryPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidMaxAttempts))
+	})
+	t.Run("negative initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExponentialBackoffRetryPolicy()
+		args.InitialBackoff = -1
+		policy, err := NewExponentialBackoffRetryPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidBackoffDuration))
+	})
+	t.Run("max backoff lower than the initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExponentialBackoffRetryPolicy()
+		args.MaxBackoff = args.InitialBackoff - 1
+		policy, err := NewExponentialBackoffRetryPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidBackoffDuration))
+	})
+	t.Run("invalid backoff multiplier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExponentialBackoffRetryPolicy()
+		args.BackoffMultiplier = 0.99
+		policy, err := NewExponentialBackoffRetryPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidBackoffMultiplier))
+	})
+	t.Run("invalid jitter factor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExponentialBackoffRetryPolicy()
+		args.JitterFactor = 1.01
+		policy, err := NewExponentialBackoffRetryPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidJitterFactor))
+
+		args.JitterFactor = -0.01
+		policy, err = NewExponentialBackoffRetryPolicy(args)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidJitterFactor))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewExponentialBackoffRetryPolicy(createMockArgsExponentialBackoffRetryPolicy())
+		assert.False(t, check.IfNil(policy))
+		assert.Nil(t, err)
+		assert.Equal(t, 3, policy.MaxAttempts())
+	})
+}
+
+func TestExponentialBackoffRetryPolicy_IsRetryable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default status codes", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewExponentialBackoffRetryPolicy(createMockArgsExponentialBackoffRetryPolicy())
+		assert.True(t, policy.IsRetryable(context.Background(), http.StatusBadGateway, nil))
+		assert.True(t, policy.IsRetryable(context.Background(), http.StatusServiceUnavailable, nil))
+		assert.True(t, policy.IsRetryable(context.Background(), http.StatusGatewayTimeout, nil))
+		assert.True(t, policy.IsRetryable(context.Background(), http.StatusTooManyRequests, nil))
+		assert.False(t, policy.IsRetryable(context.Background(), http.StatusOK, nil))
+		assert.False(t, policy.IsRetryable(context.Background(), http.StatusBadRequest, nil))
+		assert.False(t, policy.IsRetryable(context.Background(), http.StatusInternalServerError, nil))
+	})
+	t.Run("custom status codes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsExponentialBackoffRetryPolicy()
+		args.RetryableStatusCodes = []int{http.StatusInternalServerError}
+		policy, _ := NewExponentialBackoffRetryPolicy(args)
+		assert.True(t, policy.IsRetryable(context.Background(), http.StatusInternalServerError, nil))
+		assert.False(t, policy.IsRetryable(context.Background(), http.StatusBadGateway, nil))
+	})
+	t.Run("transport errors should be retryable", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewExponentialBackoffRetryPolicy(createMockArgsExponentialBackoffRetryPolicy())
+		assert.True(t, policy.IsRetryable(context.Background(), http.StatusBadRequest, errors.New("i/o timeout")))
+		assert.False(t, policy.IsRetryable(context.Background(), http.StatusBadRequest, context.Canceled))
+	})
+	t.Run("context done should not be retryable", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		policy, _ := NewExponentialBackoffRetryPolicy(createMockArgsExponentialBackoffRetryPolicy())
+		assert.False(t, policy.IsRetryable(ctx, http.StatusBadGateway, nil))
+		assert.False(t, policy.IsRetryable(ctx, http.StatusBadRequest, errors.New("i/o timeout")))
+	})
+}
+
+func TestExponentialBackoffRetryPolicy_BackoffDuration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without jitter", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewExponentialBackoffRetryPolicy(createMockArgsExponentialBackoffRetryPolicy())
+		policy.randomHandler = func() float64 {
+			return 0
+		}
+
+		assert.Equal(t, time.Millisecond*100, policy.BackoffDuration(0))
+		assert.Equal(t, time.Millisecond*100, policy.BackoffDuration(1))
+		assert.Equal(t, time.Millisecond*200, policy.BackoffDuration(2))
+		assert.Equal(t, time.Millisecond*400, policy.BackoffDuration(3))
+		assert.Equal(t, time.Millisecond*800, policy.BackoffDuration(4))
+		assert.Equal(t, time.Second, policy.BackoffDuration(5))
+		assert.Equal(t, time.Second, policy.BackoffDuration(100))
+	})
+	t.Run("with jitter", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewExponentialBackoffRetryPolicy(createMockArgsExponentialBackoffRetryPolicy())
+		policy.randomHandler = func() float64 {
+			return 1
+		}
+
+		assert.Equal(t, time.Millisecond*50, policy.BackoffDuration(1))
+		assert.Equal(t, time.Millisecond*100, policy.BackoffDuration(2))
+		assert.Equal(t, time.Millisecond*500, policy.BackoffDuration(10))
+	})
+}
@@ -0,0 +1,47 @@
+package http
+
+import "net/http"
+
+type staticHeadersInterceptor struct {
+	headers map[string]string
+}
+
+// NewStaticHeadersInterceptor creates a request interceptor that sets the provided headers on each request, such as an
+// API key. The provided headers override the default ones
+func NewStaticHeadersInterceptor(headers map[string]string) (*staticHeadersInterceptor, error) {
+	if len(headers) == 0 {
+		return nil, ErrNoHeaders
+	}
+
+	headersCopy := make(map[string]string, len(headers))
+	for name, value := range headers {
+		if len(name) == 0 {
+			// the value is not part of the error since it might be a secret, such as an API key
+			return nil, ErrEmptyHeaderName
+		}
+
+		headersCopy[name] = value
+	}
+
+	return &staticHeadersInterceptor{
+		headers: headersCopy,
+	}, nil
+}
+
+// BeforeRequest sets the headers on the request
+func (interceptor *staticHeadersInterceptor) BeforeRequest(request *http.Request) error {
+	for name, value := range interceptor.headers {
+		request.Header.Set(name, value)
+	}
+
+	return nil
+}
+
+// AfterResponse does nothing
+func (interceptor *staticHeadersInterceptor) AfterResponse(_ *http.Request, _ *http.Response, _ error) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (interceptor *staticHeadersInterceptor) IsInterfaceNil() bool {
+	return interceptor == nil
+}
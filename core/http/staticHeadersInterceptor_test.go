@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStaticHeadersInterceptor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no headers should error", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor, err := NewStaticHeadersInterceptor(nil)
+		assert.True(t, check.IfNil(interceptor))
+		assert.Equal(t, ErrNoHeaders, err)
+	})
+	t.Run("empty header name should error", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor, err := NewStaticHeadersInterceptor(map[string]string{"": "secret-api-key"})
+		assert.True(t, check.IfNil(interceptor))
+		assert.Equal(t, ErrEmptyHeaderName, err)
+		assert.NotContains(t, err.Error(), "secret-api-key")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		headers := map[string]string{
+			"X-Api-Key":      "key",
+			httpUserAgentKey: "custom agent",
+		}
+		interceptor, err := NewStaticHeadersInterceptor(headers)
+		assert.False(t, check.IfNil(interceptor))
+		assert.Nil(t, err)
+
+		// changing the provided map should not affect the interceptor
+		headers["X-Api-Key"] = "changed"
+
+		request := httptest.NewRequest(http.MethodGet, "/endpoint", nil)
+		applyGetHeaderParams(request)
+		err = interceptor.BeforeRequest(request)
+		assert.Nil(t, err)
+		assert.Equal(t, "key", request.Header.Get("X-Api-Key"))
+		assert.Equal(t, "custom agent", request.Header.Get(httpUserAgentKey))
+		assert.Equal(t, httpAcceptType, request.Header.Get(httpAcceptTypeKey))
+	})
+}
@@ -1,6 +1,10 @@
 package core
 
-import crypto "github.com/TerraDharitri/drt-go-chain-crypto"
+import (
+	"time"
+
+	crypto "github.com/TerraDharitri/drt-go-chain-crypto"
+)
 
 // AddressHandler will handle different implementations of an address
 type AddressHandler interface {
@@ -20,3 +24,16 @@ type CryptoComponentsHolder interface {
 	GetAddressHandler() AddressHandler
 	IsInterfaceNil() bool
 }
+
+// MetricsHandler defines the instrumentation hooks called by the SDK components. The endpoints are provided as
+// templates, with the addresses, hashes and numbers replaced by placeholders. The addresses are bech32 encoded
+type MetricsHandler interface {
+	ObserveRequest(endpoint string, method string, statusCode int, duration time.Duration)
+	IncrementRetries(endpoint string, method string)
+	SetQueuedTransactions(address string, numTransactions int)
+	IncrementSentTransactions(address string)
+	IncrementFailedTransactions(address string)
+	SetCurrentNonce(address string, nonce uint64)
+	ObserveCacheLookup(class string, isHit bool)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import "time"
+
+// DisabledMetricsHandler is a no-op implementation of the MetricsHandler interface
+type DisabledMetricsHandler struct {
+}
+
+// ObserveRequest does nothing
+func (handler *DisabledMetricsHandler) ObserveRequest(_ string, _ string, _ int, _ time.Duration) {
+}
+
+// IncrementRetries does nothing
+func (handler *DisabledMetricsHandler) IncrementRetries(_ string, _ string) {
+}
+
+// SetQueuedTransactions does nothing
+func (handler *DisabledMetricsHandler) SetQueuedTransactions(_ string, _ int) {
+}
+
+// IncrementSentTransactions does nothing
+func (handler *DisabledMetricsHandler) IncrementSentTransactions(_ string) {
+}
+
+// IncrementFailedTransactions does nothing
+func (handler *DisabledMetricsHandler) IncrementFailedTransactions(_ string) {
+}
+
+// SetCurrentNonce does nothing
+func (handler *DisabledMetricsHandler) SetCurrentNonce(_ string, _ uint64) {
+}
+
+// ObserveCacheLookup does nothing
+func (handler *DisabledMetricsHandler) ObserveCacheLookup(_ string, _ bool) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *DisabledMetricsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
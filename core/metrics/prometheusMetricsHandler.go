@@ -0,0 +1,375 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+)
+
+var log = logger.GetOrCreate("drt-go-sdk/core/metrics")
+
+const (
+	// PrometheusContentType is the content type of the Prometheus text exposition format
+	PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	metricHttpRequests             = "drt_sdk_http_requests_total"
+	metricHttpRequestDuration      = "drt_sdk_http_request_duration_seconds"
+	metricHttpRetries              = "drt_sdk_http_retries_total"
+	metricNonceHandlerQueuedTxs    = "drt_sdk_nonce_handler_queued_transactions"
+	metricNonceHandlerSentTxs      = "drt_sdk_nonce_handler_sent_transactions_total"
+	metricNonceHandlerFailedTxs    = "drt_sdk_nonce_handler_failed_transactions_total"
+	metricNonceHandlerCurrentNonce = "drt_sdk_nonce_handler_current_nonce"
+	metricCacheLookups             = "drt_sdk_response_cache_lookups_total"
+	metricTypeCounter              = "counter"
+	metricTypeGauge                = "gauge"
+	metricTypeHistogram            = "histogram"
+	labelEndpoint                  = "endpoint"
+	labelMethod                    = "method"
+	labelCode                      = "code"
+	labelAddress                   = "address"
+	labelClass                     = "class"
+	labelResult                    = "result"
+	resultHit                      = "hit"
+	resultMiss                     = "miss"
+	labelBucketUpperBound          = "le"
+	positiveInfinityLabelValue     = "+Inf"
+	histogramBucketSuffix          = "_bucket"
+	histogramSumSuffix             = "_sum"
+	histogramCountSuffix           = "_count"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the request duration histogram buckets
+var DefaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+type endpointKey struct {
+	endpoint string
+	method   string
+}
+
+type requestKey struct {
+	endpointKey
+	statusCode int
+}
+
+type cacheLookupKey struct {
+	class string
+	isHit bool
+}
+
+type histogram struct {
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+}
+
+// prometheusMetricsHandler keeps the reported metrics in memory and exports them in the Prometheus text format.
+// It can be mounted directly as an http handler. This struct is concurrent safe.
+type prometheusMetricsHandler struct {
+	latencyBuckets []float64
+
+	mut                sync.RWMutex
+	requests           map[requestKey]uint64
+	durations          map[endpointKey]*histogram
+	retries            map[endpointKey]uint64
+	queuedTransactions map[string]int
+	sentTransactions   map[string]uint64
+	failedTransactions map[string]uint64
+	currentNonces      map[string]uint64
+	cacheLookups       map[cacheLookupKey]uint64
+}
+
+// NewPrometheusMetricsHandler creates a metrics handler that exports the metrics in the Prometheus text format, using
+// the default latency buckets
+func NewPrometheusMetricsHandler() *prometheusMetricsHandler {
+	return &prometheusMetricsHandler{
+		latencyBuckets:     DefaultLatencyBuckets,
+		requests:           make(map[requestKey]uint64),
+		durations:          make(map[endpointKey]*histogram),
+		retries:            make(map[endpointKey]uint64),
+		queuedTransactions: make(map[string]int),
+		sentTransactions:   make(map[string]uint64),
+		failedTransactions: make(map[string]uint64),
+		currentNonces:      make(map[string]uint64),
+		cacheLookups:       make(map[cacheLookupKey]uint64),
+	}
+}
+
+// ObserveRequest records the status code and the duration of a request. The status code is 0 if no response was received
+func (handler *prometheusMetricsHandler) ObserveRequest(endpoint string, method string, statusCode int, duration time.Duration) {
+	key := endpointKey{
+		endpoint: endpoint,
+		method:   method,
+	}
+
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	handler.requests[requestKey{endpointKey: key, statusCode: statusCode}]++
+
+	h, found := handler.durations[key]
+	if !found {
+		h = &histogram{
+			bucketCounts: make([]uint64, len(handler.latencyBuckets)),
+		}
+		handler.durations[key] = h
+	}
+
+	seconds := duration.Seconds()
+	for i, upperBound := range handler.latencyBuckets {
+		if seconds <= upperBound {
+			h.bucketCounts[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// IncrementRetries records a retry of a request
+func (handler *prometheusMetricsHandler) IncrementRetries(endpoint string, method string) {
+	handler.mut.Lock()
+	handler.retries[endpointKey{endpoint: endpoint, method: method}]++
+	handler.mut.Unlock()
+}
+
+// SetQueuedTransactions records the number of transactions waiting to be sent for an address
+func (handler *prometheusMetricsHandler) SetQueuedTransactions(address string, numTransactions int) {
+	handler.mut.Lock()
+	handler.queuedTransactions[address] = numTransactions
+	handler.mut.Unlock()
+}
+
+// IncrementSentTransactions records a transaction sent for an address
+func (handler *prometheusMetricsHandler) IncrementSentTransactions(address string) {
+	handler.mut.Lock()
+	handler.sentTransactions[address]++
+	handler.mut.Unlock()
+}
+
+// IncrementFailedTransactions records a transaction of an address that could not be sent
+func (handler *prometheusMetricsHandler) IncrementFailedTransactions(address string) {
+	handler.mut.Lock()
+	handler.failedTransactions[address]++
+	handler.mut.Unlock()
+}
+
+// SetCurrentNonce records the last nonce applied on the transactions of an address
+func (handler *prometheusMetricsHandler) SetCurrentNonce(address string, nonce uint64) {
+	handler.mut.Lock()
+	handler.currentNonces[address] = nonce
+	handler.mut.Unlock()
+}
+
+// ObserveCacheLookup records a response cache lookup of an endpoint class
+func (handler *prometheusMetricsHandler) ObserveCacheLookup(class string, isHit bool) {
+	handler.mut.Lock()
+	handler.cacheLookups[cacheLookupKey{class: class, isHit: isHit}]++
+	handler.mut.Unlock()
+}
+
+// WriteMetrics writes all the metrics in the Prometheus text format
+func (handler *prometheusMetricsHandler) WriteMetrics(writer io.Writer) error {
+	buff := &bytes.Buffer{}
+
+	handler.mut.RLock()
+	handler.writeRequests(buff)
+	handler.writeDurations(buff)
+	handler.writeRetries(buff)
+	writeAddressFamily(buff, metricNonceHandlerQueuedTxs, metricTypeGauge,
+		"Number of transactions waiting to be sent, per address", intValues(handler.queuedTransactions))
+	writeAddressFamily(buff, metricNonceHandlerSentTxs, metricTypeCounter,
+		"Number of transactions sent, per address", handler.sentTransactions)
+	writeAddressFamily(buff, metricNonceHandlerFailedTxs, metricTypeCounter,
+		"Number of transactions that could not be sent, per address", handler.failedTransactions)
+	writeAddressFamily(buff, metricNonceHandlerCurrentNonce, metricTypeGauge,
+		"Last nonce applied on the transactions, per address", handler.currentNonces)
+	handler.writeCacheLookups(buff)
+	handler.mut.RUnlock()
+
+	_, err := writer.Write(buff.Bytes())
+
+	return err
+}
+
+func (handler *prometheusMetricsHandler) writeRequests(buff *bytes.Buffer) {
+	if len(handler.requests) == 0 {
+		return
+	}
+
+	keys := make([]requestKey, 0, len(handler.requests))
+	for key := range handler.requests {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].endpointKey != keys[j].endpointKey {
+			return lessEndpointKey(keys[i].endpointKey, keys[j].endpointKey)
+		}
+		return keys[i].statusCode < keys[j].statusCode
+	})
+
+	writeHeader(buff, metricHttpRequests, metricTypeCounter, "Number of requests, per endpoint, method and status code")
+	for _, key := range keys {
+		labels := formatLabels(labelEndpoint, key.endpoint, labelMethod, key.method, labelCode, strconv.Itoa(key.statusCode))
+		writeSample(buff, metricHttpRequests, labels, strconv.FormatUint(handler.requests[key], 10))
+	}
+}
+
+func (handler *prometheusMetricsHandler) writeDurations(buff *bytes.Buffer) {
+	if len(handler.durations) == 0 {
+		return
+	}
+
+	writeHeader(buff, metricHttpRequestDuration, metricTypeHistogram, "Duration of the requests, per endpoint and method")
+	for _, key := range sortedEndpointKeys(handler.durations) {
+		h := handler.durations[key]
+		for i, upperBound := range handler.latencyBuckets {
+			labels := formatLabels(labelEndpoint, key.endpoint, labelMethod, key.method,
+				labelBucketUpperBound, formatFloat(upperBound))
+			writeSample(buff, metricHttpRequestDuration+histogramBucketSuffix, labels, strconv.FormatUint(h.bucketCounts[i], 10))
+		}
+
+		labels := formatLabels(labelEndpoint, key.endpoint, labelMethod, key.method,
+			labelBucketUpperBound, positiveInfinityLabelValue)
+		writeSample(buff, metricHttpRequestDuration+histogramBucketSuffix, labels, strconv.FormatUint(h.count, 10))
+
+		labels = formatLabels(labelEndpoint, key.endpoint, labelMethod, key.method)
+		writeSample(buff, metricHttpRequestDuration+histogramSumSuffix, labels, formatFloat(h.sum))
+		writeSample(buff, metricHttpRequestDuration+histogramCountSuffix, labels, strconv.FormatUint(h.count, 10))
+	}
+}
+
+func (handler *prometheusMetricsHandler) writeRetries(buff *bytes.Buffer) {
+	if len(handler.retries) == 0 {
+		return
+	}
+
+	writeHeader(buff, metricHttpRetries, metricTypeCounter, "Number of retried requests, per endpoint and method")
+	for _, key := range sortedEndpointKeys(handler.retries) {
+		labels := formatLabels(labelEndpoint, key.endpoint, labelMethod, key.method)
+		writeSample(buff, metricHttpRetries, labels, strconv.FormatUint(handler.retries[key], 10))
+	}
+}
+
+func (handler *prometheusMetricsHandler) writeCacheLookups(buff *bytes.Buffer) {
+	if len(handler.cacheLookups) == 0 {
+		return
+	}
+
+	keys := make([]cacheLookupKey, 0, len(handler.cacheLookups))
+	for key := range handler.cacheLookups {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].class != keys[j].class {
+			return keys[i].class < keys[j].class
+		}
+		return keys[i].isHit && !keys[j].isHit
+	})
+
+	writeHeader(buff, metricCacheLookups, metricTypeCounter, "Number of response cache lookups, per endpoint class and result")
+	for _, key := range keys {
+		result := resultMiss
+		if key.isHit {
+			result = resultHit
+		}
+
+		labels := formatLabels(labelClass, key.class, labelResult, result)
+		writeSample(buff, metricCacheLookups, labels, strconv.FormatUint(handler.cacheLookups[key], 10))
+	}
+}
+
+// ServeHTTP writes all the metrics in the Prometheus text format on the response
+func (handler *prometheusMetricsHandler) ServeHTTP(writer http.ResponseWriter, _ *http.Request) {
+	writer.Header().Set("Content-Type", PrometheusContentType)
+	writer.WriteHeader(http.StatusOK)
+
+	err := handler.WriteMetrics(writer)
+	if err != nil {
+		log.Debug("prometheusMetricsHandler: can not write the metrics", "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *prometheusMetricsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
+
+func writeAddressFamily(buff *bytes.Buffer, name string, metricType string, help string, values map[string]uint64) {
+	if len(values) == 0 {
+		return
+	}
+
+	addresses := make([]string, 0, len(values))
+	for address := range values {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	writeHeader(buff, name, metricType, help)
+	for _, address := range addresses {
+		writeSample(buff, name, formatLabels(labelAddress, address), strconv.FormatUint(values[address], 10))
+	}
+}
+
+func intValues(values map[string]int) map[string]uint64 {
+	converted := make(map[string]uint64, len(values))
+	for key, value := range values {
+		if value < 0 {
+			value = 0
+		}
+		converted[key] = uint64(value)
+	}
+
+	return converted
+}
+
+func sortedEndpointKeys[T any](values map[endpointKey]T) []endpointKey {
+	keys := make([]endpointKey, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lessEndpointKey(keys[i], keys[j])
+	})
+
+	return keys
+}
+
+func lessEndpointKey(first endpointKey, second endpointKey) bool {
+	if first.endpoint != second.endpoint {
+		return first.endpoint < second.endpoint
+	}
+
+	return first.method < second.method
+}
+
+func writeHeader(buff *bytes.Buffer, name string, metricType string, help string) {
+	_, _ = fmt.Fprintf(buff, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+func writeSample(buff *bytes.Buffer, name string, labels string, value string) {
+	_, _ = fmt.Fprintf(buff, "%s{%s} %s\n", name, labels, value)
+}
+
+// formatLabels formats the provided label name and value pairs
+func formatLabels(namesAndValues ...string) string {
+	labels := make([]string, 0, len(namesAndValues)/2)
+	for i := 0; i+1 < len(namesAndValues); i += 2 {
+		labels = append(labels, fmt.Sprintf(`%s="%s"`, namesAndValues[i], labelValueEscaper.Replace(namesAndValues[i+1])))
+	}
+
+	return strings.Join(labels, ",")
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
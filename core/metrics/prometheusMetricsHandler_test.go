@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrometheusMetricsHandler(t *testing.T) {
+	t.Parallel()
+
+	handler := NewPrometheusMetricsHandler()
+	assert.False(t, check.IfNil(handler))
+
+	buff := &bytes.Buffer{}
+	err := handler.WriteMetrics(buff)
+	assert.Nil(t, err)
+	assert.Empty(t, buff.String())
+}
+
+func TestPrometheusMetricsHandler_WriteMetrics(t *testing.T) {
+	t.Parallel()
+
+	handler := NewPrometheusMetricsHandler()
+	handler.ObserveRequest("network/config", http.MethodGet, http.StatusOK, time.Millisecond*20)
+	handler.ObserveRequest("network/config", http.MethodGet, http.StatusOK, time.Second*20)
+	handler.ObserveRequest("network/config", http.MethodGet, 0, time.Millisecond)
+	handler.ObserveRequest("transaction/send", http.MethodPost, http.StatusTooManyRequests, time.Millisecond*200)
+	handler.IncrementRetries("transaction/send", http.MethodPost)
+	handler.IncrementRetries("transaction/send", http.MethodPost)
+	handler.SetQueuedTransactions("drt1b", 3)
+	handler.SetQueuedTransactions("drt1a", 5)
+	handler.SetQueuedTransactions("drt1a", 4)
+	handler.IncrementSentTransactions("drt1a")
+	handler.IncrementFailedTransactions("drt1b")
+	handler.SetCurrentNonce("drt1a", 37)
+	handler.ObserveCacheLookup("network", true)
+	handler.ObserveCacheLookup("network", true)
+	handler.ObserveCacheLookup("network", false)
+
+	buff := &bytes.Buffer{}
+	err := handler.WriteMetrics(buff)
+	require.Nil(t, err)
+	output := buff.String()
+
+	expectedLines := []string{
+		"# TYPE drt_sdk_http_requests_total counter",
+		`drt_sdk_http_requests_total{endpoint="network/config",method="GET",code="0"} 1`,
+		`drt_sdk_http_requests_total{endpoint="network/config",method="GET",code="200"} 2`,
+		`drt_sdk_http_requests_total{endpoint="transaction/send",method="POST",code="429"} 1`,
+		"# TYPE drt_sdk_http_request_duration_seconds histogram",
+		`drt_sdk_http_request_duration_seconds_bucket{endpoint="network/config",method="GET",le="0.005"} 1`,
+		`drt_sdk_http_request_duration_seconds_bucket{endpoint="network/config",method="GET",le="0.025"} 2`,
+		`drt_sdk_http_request_duration_seconds_bucket{endpoint="network/config",method="GET",le="10"} 2`,
+		`drt_sdk_http_request_duration_seconds_bucket{endpoint="network/config",method="GET",le="+Inf"} 3`,
+		`drt_sdk_http_request_duration_seconds_sum{endpoint="network/config",method="GET"} 20.021`,
+		`drt_sdk_http_request_duration_seconds_count{endpoint="network/config",method="GET"} 3`,
+		`drt_sdk_http_retries_total{endpoint="transaction/send",method="POST"} 2`,
+		"# TYPE drt_sdk_nonce_handler_queued_transactions gauge",
+		`drt_sdk_nonce_handler_queued_transactions{address="drt1a"} 4`,
+		`drt_sdk_nonce_handler_queued_transactions{address="drt1b"} 3`,
+		`drt_sdk_nonce_handler_sent_transactions_total{address="drt1a"} 1`,
+		`drt_sdk_nonce_handler_failed_transactions_total{address="drt1b"} 1`,
+		`drt_sdk_nonce_handler_current_nonce{address="drt1a"} 37`,
+		`drt_sdk_response_cache_lookups_total{class="network",result="hit"} 2`,
+		`drt_sdk_response_cache_lookups_total{class="network",result="miss"} 1`,
+	}
+	for _, line := range expectedLines {
+		assert.Contains(t, output, line+"\n")
+	}
+
+	// the series are sorted
+	assert.Less(t, strings.Index(output, `code="0"`), strings.Index(output, `code="200"`))
+	assert.Less(t, strings.Index(output, `{address="drt1a"} 4`), strings.Index(output, `{address="drt1b"} 3`))
+}
+
+func TestPrometheusMetricsHandler_ShouldEscapeLabelValues(t *testing.T) {
+	t.Parallel()
+
+	handler := NewPrometheusMetricsHandler()
+	handler.IncrementSentTransactions("a\"b\\c\nd")
+
+	buff := &bytes.Buffer{}
+	_ = handler.WriteMetrics(buff)
+	assert.Contains(t, buff.String(), `{address="a\"b\\c\nd"} 1`)
+}
+
+func TestPrometheusMetricsHandler_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	handler := NewPrometheusMetricsHandler()
+	handler.SetCurrentNonce("drt1a", 7)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, PrometheusContentType, recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `drt_sdk_nonce_handler_current_nonce{address="drt1a"} 7`)
+}
@@ -14,18 +14,43 @@ const (
 	Proxy RestAPIEntityType = "proxy"
 )
 
+// FilterQuery holds the criteria used when filtering logs. All the provided criteria must match for an event to be
+// returned.
 type FilterQuery struct {
 	BlockHash []byte              // return logs only from block with this hash
 	FromBlock core.OptionalUint64 // beginning of the queried range, no value set means genesis block
 	ToBlock   core.OptionalUint64 // end of the range, no value set means latest block
 	Addresses []string            // restricts matches to events created by specific contracts
 	ShardID   core.OptionalUint32 // identifies the shard to query
+	AllShards bool                // queries all the shards, including the metachain. Block nonces apply to each shard, capped to its latest block
 
-	// The Topic list restricts matches to particular event topics. Each event has a list
-	// of topics. Topics matches a prefix of that list. An empty element slice matches any
-	// topic. Non-empty elements represent an alternative that matches any of the
-	// contained topics.
+	// The Topics list restricts matches to events whose topics start with the provided ones, in the same order.
+	// An empty element only matches an empty topic on that position. Use TopicAlternatives for wildcards.
+	Topics [][]byte
+
+	// The TopicAlternatives list restricts matches to particular event topics, position by position. Each element
+	// holds the alternatives accepted on that position: an event matches if, for each position, its topic equals
+	// any of the contained alternatives. An empty element is a wildcard and matches any topic on that position.
+	// Events with fewer topics than the number of positions do not match.
 	//
-	// Events are only returned if they match all topics. The order of the topics is not important.
-	Topics [][]byte // Topics is a slice of arrays of 32 bytes each
+	// Examples:
+	// {} or nil                  matches any topics
+	// {{A}}                      matches topic A in first position
+	// {{}, {B}}                  matches any topic in first position AND B in second position
+	// {{A}, {B}}                 matches topic A in first position AND B in second position
+	// {{A, B}, {C, D}}           matches topic (A OR B) in first position AND (C OR D) in second position
+	TopicAlternatives [][][]byte
+
+	// Identifiers restricts matches to events with one of the provided identifiers (e.g. DCDTTransfer)
+	Identifiers []string
+
+	// TxHashes restricts matches to events generated by one of the provided transactions (hex encoded hashes)
+	TxHashes []string
+}
+
+// LogsCursor identifies the position of a logs stream. All the blocks before Nonce, in the ShardID shard, were
+// completely processed, so the stream can be resumed from this position without missing or duplicating events.
+type LogsCursor struct {
+	ShardID uint32
+	Nonce   uint64
 }
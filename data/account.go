@@ -3,6 +3,8 @@ package data
 import (
 	"errors"
 	"math/big"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 )
 
 var errInvalidBalance = errors.New("invalid balance")
@@ -10,7 +12,8 @@ var errInvalidBalance = errors.New("invalid balance")
 // AccountResponse holds the account endpoint response
 type AccountResponse struct {
 	Data struct {
-		Account *Account `json:"account"`
+		Account   *Account      `json:"account"`
+		BlockInfo api.BlockInfo `json:"blockInfo"`
 	} `json:"data"`
 	Error string `json:"error"`
 	Code  string `json:"code"`
@@ -35,6 +38,9 @@ type Account struct {
 	Username        string `json:"username"`
 	DeveloperReward string `json:"developerReward"`
 	OwnerAddress    string `json:"ownerAddress"`
+
+	// BlockInfo is the block the node used to read the account state
+	BlockInfo api.BlockInfo `json:"-"`
 }
 
 // GetBalance computes the float representation of the balance,
@@ -59,6 +65,7 @@ func (a *Account) GetBalance(decimals int) (float64, error) {
 type DCDTFungibleResponse struct {
 	Data struct {
 		TokenData *DCDTFungibleTokenData `json:"tokenData"`
+		BlockInfo api.BlockInfo          `json:"blockInfo"`
 	} `json:"data"`
 	Error string `json:"error"`
 	Code  string `json:"code"`
@@ -69,12 +76,53 @@ type DCDTFungibleTokenData struct {
 	TokenIdentifier string `json:"tokenIdentifier"`
 	Balance         string `json:"balance"`
 	Properties      string `json:"properties"`
+
+	// BlockInfo is the block the node used to read the token data
+	BlockInfo api.BlockInfo `json:"-"`
+}
+
+// AllDCDTTokensResponse holds the endpoint response of all the tokens held by an address
+type AllDCDTTokensResponse struct {
+	Data struct {
+		Tokens    map[string]*DCDTNFTTokenData `json:"dcdts"`
+		BlockInfo api.BlockInfo                `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// DCDTRolesResponse holds the endpoint response of all the special roles held by an address
+type DCDTRolesResponse struct {
+	Data struct {
+		Roles     map[string][]string `json:"roles"`
+		BlockInfo api.BlockInfo       `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// DCDTRoles holds the special roles of an address, indexed by token identifier
+type DCDTRoles struct {
+	Roles     map[string][]string
+	BlockInfo api.BlockInfo
+}
+
+// HasRole returns true if the address holds the provided role for the provided token
+func (roles *DCDTRoles) HasRole(tokenIdentifier string, role string) bool {
+	for _, tokenRole := range roles.Roles[tokenIdentifier] {
+		if tokenRole == role {
+			return true
+		}
+	}
+
+	return false
 }
 
 // DCDTNFTResponse holds the NFT token data endpoint response
 type DCDTNFTResponse struct {
 	Data struct {
 		TokenData *DCDTNFTTokenData `json:"tokenData"`
+		BlockInfo api.BlockInfo     `json:"blockInfo"`
 	} `json:"data"`
 	Error string `json:"error"`
 	Code  string `json:"code"`
@@ -92,4 +140,7 @@ type DCDTNFTTokenData struct {
 	Hash            []byte   `json:"hash,omitempty"`
 	URIs            [][]byte `json:"uris,omitempty"`
 	Attributes      []byte   `json:"attributes,omitempty"`
+
+	// BlockInfo is the block the node used to read the token data
+	BlockInfo api.BlockInfo `json:"-"`
 }
@@ -0,0 +1,43 @@
+package data
+
+import "github.com/TerraDharitri/drt-go-chain-core/data/api"
+
+// AccountStorageValueResponse holds the account storage value endpoint response
+type AccountStorageValueResponse struct {
+	Data struct {
+		Value     string        `json:"value"`
+		BlockInfo api.BlockInfo `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AccountStorageKeysResponse holds the account storage key-value pairs endpoint response
+type AccountStorageKeysResponse struct {
+	Data struct {
+		Pairs     map[string]string `json:"pairs"`
+		BlockInfo api.BlockInfo     `json:"blockInfo"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// AccountStorageValue holds a value read from an account's storage
+type AccountStorageValue struct {
+	Key       []byte
+	Value     []byte
+	BlockInfo api.BlockInfo
+}
+
+// AccountStorage holds all the key-value pairs from an account's storage
+type AccountStorage struct {
+	// Pairs holds the storage values, indexed by the raw storage key converted to string
+	Pairs     map[string][]byte
+	BlockInfo api.BlockInfo
+}
+
+// Value returns the value stored under the provided key. A missing key yields an empty value, as in the account's
+// storage a missing key is equivalent with an empty value
+func (storage *AccountStorage) Value(key []byte) []byte {
+	return storage.Pairs[string(key)]
+}
@@ -0,0 +1,14 @@
+package data
+
+import "github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+// TaggedEvent holds a log event along with the location where it was generated
+type TaggedEvent struct {
+	Event      *transaction.Events
+	ShardID    uint32
+	BlockNonce uint64
+	BlockHash  string
+	TxHash     string
+	TxIndex    int
+	EventIndex int
+}
@@ -6,7 +6,14 @@ import "github.com/TerraDharitri/drt-go-chain-core/data/api"
 type GuardianDataResponse struct {
 	Data struct {
 		GuardianData *api.GuardianData `json:"guardianData"`
+		BlockInfo    api.BlockInfo     `json:"blockInfo"`
 	} `json:"data"`
 	Error string `json:"error"`
 	Code  string `json:"code"`
 }
+
+// GuardianData holds the guardian data of an account along with the block the node used to read it
+type GuardianData struct {
+	api.GuardianData
+	BlockInfo api.BlockInfo `json:"-"`
+}
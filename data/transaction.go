@@ -1,6 +1,10 @@
 package data
 
-import "github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+import (
+	"fmt"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+)
 
 // SendTransactionResponse holds the response received from the network when broadcasting a transaction
 type SendTransactionResponse struct {
@@ -60,6 +64,9 @@ type TransactionOnNetwork struct {
 	Sender                            string                                `json:"sender"`
 	GasPrice                          uint64                                `json:"gasPrice"`
 	GasLimit                          uint64                                `json:"gasLimit"`
+	GasUsed                           uint64                                `json:"gasUsed,omitempty"`
+	Fee                               string                                `json:"fee,omitempty"`
+	InitiallyPaidFee                  string                                `json:"initiallyPaidFee,omitempty"`
 	Data                              []byte                                `json:"data"`
 	Signature                         string                                `json:"signature"`
 	SourceShard                       uint32                                `json:"sourceShard"`
@@ -92,3 +99,77 @@ type ResponseTxCost struct {
 	Error string             `json:"error"`
 	Code  string             `json:"code"`
 }
+
+// TransactionSimulationResponse defines a response from the node holding the results of a transaction simulation
+type TransactionSimulationResponse struct {
+	Data struct {
+		Result TransactionSimulation `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// TransactionSimulation holds the results of a transaction simulation. The results of an intra-shard transaction are
+// held by the embedded SimulationResults while a cross-shard transaction has the results of each of the two shards
+type TransactionSimulation struct {
+	transaction.SimulationResults
+	SenderShard   *transaction.SimulationResults `json:"senderShard,omitempty"`
+	ReceiverShard *transaction.SimulationResults `json:"receiverShard,omitempty"`
+}
+
+// IsCrossShard returns true if the transaction was simulated on both the sender and the receiver shards
+func (simulation *TransactionSimulation) IsCrossShard() bool {
+	return simulation.SenderShard != nil || simulation.ReceiverShard != nil
+}
+
+// ShardResults returns the results of all the shards the transaction was simulated on
+func (simulation *TransactionSimulation) ShardResults() []*transaction.SimulationResults {
+	if !simulation.IsCrossShard() {
+		return []*transaction.SimulationResults{&simulation.SimulationResults}
+	}
+
+	results := make([]*transaction.SimulationResults, 0, 2)
+	for _, result := range []*transaction.SimulationResults{simulation.SenderShard, simulation.ReceiverShard} {
+		if result != nil {
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// IsSuccessful returns true if the transaction did not fail on any of the simulated shards
+func (simulation *TransactionSimulation) IsSuccessful() bool {
+	return len(simulation.GetFailReason()) == 0
+}
+
+// GetFailReason returns the reason for which the simulation failed on the first failing shard. Returns an empty
+// string if the simulation was successful
+func (simulation *TransactionSimulation) GetFailReason() string {
+	for _, result := range simulation.ShardResults() {
+		if len(result.FailReason) > 0 {
+			return result.FailReason
+		}
+		if result.Status == transaction.TxStatusFail || result.Status == transaction.TxStatusInvalid {
+			return fmt.Sprintf("transaction status %s", result.Status)
+		}
+	}
+
+	return ""
+}
+
+// TransactionOutcome holds the final outcome of a transaction, as observed on the network
+type TransactionOutcome struct {
+	TxHash      string
+	Status      transaction.TxStatus
+	GasUsed     uint64
+	Fee         string
+	ScResults   []*transaction.ApiSmartContractResult
+	Logs        *transaction.ApiLogs
+	Transaction *TransactionOnNetwork
+}
+
+// IsSuccessful returns true if the transaction was successfully executed
+func (outcome *TransactionOutcome) IsSuccessful() bool {
+	return outcome.Status == transaction.TxStatusSuccess
+}
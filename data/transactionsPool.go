@@ -0,0 +1,53 @@
+package data
+
+// TransactionsPoolForSenderResponse holds the response of the transactions pool endpoint for a sender
+type TransactionsPoolForSenderResponse struct {
+	Data struct {
+		TxPool struct {
+			Transactions []struct {
+				TxFields PoolTransaction `json:"txFields"`
+			} `json:"transactions"`
+		} `json:"txPool"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// PoolTransaction holds the fields of a transaction that is waiting in the transactions pool
+type PoolTransaction struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	GasLimit uint64 `json:"gasLimit"`
+	GasPrice uint64 `json:"gasPrice"`
+	Data     []byte `json:"data"`
+	Value    string `json:"value"`
+}
+
+// LastPoolNonceForSenderResponse holds the response of the last pool nonce endpoint for a sender
+type LastPoolNonceForSenderResponse struct {
+	Data struct {
+		Nonce uint64 `json:"nonce"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// TransactionsPoolNonceGapsForSenderResponse holds the response of the pool nonce gaps endpoint for a sender
+type TransactionsPoolNonceGapsForSenderResponse struct {
+	Data struct {
+		NonceGaps struct {
+			Sender string     `json:"sender"`
+			Gaps   []NonceGap `json:"gaps"`
+		} `json:"nonceGaps"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// NonceGap holds an inclusive range of nonces missing from the transactions pool of a sender
+type NonceGap struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
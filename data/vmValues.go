@@ -7,7 +7,8 @@ import (
 
 // VmValuesResponseData follows the format of the data field in an API response for a VM values query
 type VmValuesResponseData struct {
-	Data *vm.VMOutputApi `json:"data"`
+	Data      *vm.VMOutputApi `json:"data"`
+	BlockInfo api.BlockInfo   `json:"blockInfo"`
 }
 
 // ResponseVmValue defines a wrapper over string containing returned data in hex format
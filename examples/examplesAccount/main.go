@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 	"github.com/TerraDharitri/drt-go-sdk/blockchain"
 	"github.com/TerraDharitri/drt-go-sdk/core"
@@ -45,7 +46,7 @@ func main() {
 	}
 
 	// Retrieve account info from the network (balance, nonce)
-	accountInfo, err := ep.GetAccount(context.Background(), address)
+	accountInfo, err := ep.GetAccount(context.Background(), address, api.AccountQueryOptions{})
 	if err != nil {
 		log.Error("error retrieving account info", "error", err)
 		return
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	logger "github.com/TerraDharitri/drt-go-chain-logger"
 	"github.com/TerraDharitri/drt-go-sdk/blockchain"
 	"github.com/TerraDharitri/drt-go-sdk/core"
@@ -36,7 +37,7 @@ func main() {
 		CallValue:  "",
 		Args:       nil,
 	}
-	response, err := ep.ExecuteVMQuery(context.Background(), vmRequest)
+	response, err := ep.ExecuteVMQuery(context.Background(), vmRequest, api.AccountQueryOptions{})
 	if err != nil {
 		log.Error("error executing vm query", "error", err)
 		return
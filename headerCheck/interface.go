@@ -22,7 +22,7 @@ type Proxy interface {
 	GetRawStartOfEpochMetaBlock(ctx context.Context, epoch uint32) ([]byte, error)
 	GetGenesisNodesPubKeys(ctx context.Context) (*data.GenesisNodes, error)
 	GetValidatorsInfoByEpoch(ctx context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error)
-	GetGuardianData(ctx context.Context, address core.AddressHandler) (*api.GuardianData, error)
+	GetGuardianData(ctx context.Context, address core.AddressHandler, queryOptions api.AccountQueryOptions) (*data.GuardianData, error)
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,18 @@
+package awaiter
+
+import "errors"
+
+// ErrNilProxy signals that a nil proxy was provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilPollingStrategy signals that a nil polling strategy was provided
+var ErrNilPollingStrategy = errors.New("nil polling strategy")
+
+// ErrInvalidPollingInterval signals that an invalid polling interval was provided
+var ErrInvalidPollingInterval = errors.New("invalid polling interval")
+
+// ErrInvalidPollingMultiplier signals that an invalid polling multiplier was provided
+var ErrInvalidPollingMultiplier = errors.New("invalid polling multiplier")
+
+// ErrEmptyTransactionHash signals that an empty transaction hash was provided
+var ErrEmptyTransactionHash = errors.New("empty transaction hash")
@@ -0,0 +1,22 @@
+package awaiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// Proxy holds the proxy functions required by the transaction awaiter
+type Proxy interface {
+	ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	IsInterfaceNil() bool
+}
+
+// PollingStrategy defines the component able to tell how much to wait before each poll of the transaction status
+type PollingStrategy interface {
+	Interval(attempt int) time.Duration
+	IsInterfaceNil() bool
+}
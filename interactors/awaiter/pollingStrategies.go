@@ -0,0 +1,91 @@
+package awaiter
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	minPollingInterval   = time.Millisecond
+	minPollingMultiplier = 1.0
+)
+
+type fixedIntervalPollingStrategy struct {
+	interval time.Duration
+}
+
+// NewFixedIntervalPollingStrategy creates a polling strategy that always waits the same interval between polls
+func NewFixedIntervalPollingStrategy(interval time.Duration) (*fixedIntervalPollingStrategy, error) {
+	if interval < minPollingInterval {
+		return nil, fmt.Errorf("%w, provided: %v, minimum: %v", ErrInvalidPollingInterval, interval, minPollingInterval)
+	}
+
+	return &fixedIntervalPollingStrategy{
+		interval: interval,
+	}, nil
+}
+
+// Interval returns the configured interval
+func (strategy *fixedIntervalPollingStrategy) Interval(_ int) time.Duration {
+	return strategy.interval
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (strategy *fixedIntervalPollingStrategy) IsInterfaceNil() bool {
+	return strategy == nil
+}
+
+// ArgsExponentialPollingStrategy is the DTO used in the exponential polling strategy constructor
+type ArgsExponentialPollingStrategy struct {
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+}
+
+type exponentialPollingStrategy struct {
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	multiplier      float64
+}
+
+// NewExponentialPollingStrategy creates a polling strategy that increases the wait time between consecutive polls,
+// up to the maximum interval
+func NewExponentialPollingStrategy(args ArgsExponentialPollingStrategy) (*exponentialPollingStrategy, error) {
+	if args.InitialInterval < minPollingInterval {
+		return nil, fmt.Errorf("%w for the initial interval, provided: %v, minimum: %v",
+			ErrInvalidPollingInterval, args.InitialInterval, minPollingInterval)
+	}
+	if args.MaxInterval < args.InitialInterval {
+		return nil, fmt.Errorf("%w for the maximum interval, provided: %v, minimum: %v",
+			ErrInvalidPollingInterval, args.MaxInterval, args.InitialInterval)
+	}
+	if args.Multiplier < minPollingMultiplier {
+		return nil, fmt.Errorf("%w, provided: %v, minimum: %v", ErrInvalidPollingMultiplier, args.Multiplier, minPollingMultiplier)
+	}
+
+	return &exponentialPollingStrategy{
+		initialInterval: args.InitialInterval,
+		maxInterval:     args.MaxInterval,
+		multiplier:      args.Multiplier,
+	}, nil
+}
+
+// Interval returns the interval to wait before the provided attempt. The attempt parameter starts from 0.
+func (strategy *exponentialPollingStrategy) Interval(attempt int) time.Duration {
+	if attempt < 0 {
+		attempt = 0
+	}
+
+	interval := float64(strategy.initialInterval) * math.Pow(strategy.multiplier, float64(attempt))
+	if interval > float64(strategy.maxInterval) {
+		return strategy.maxInterval
+	}
+
+	return time.Duration(interval)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (strategy *exponentialPollingStrategy) IsInterfaceNil() bool {
+	return strategy == nil
+}
@@ -0,0 +1,83 @@
+package awaiter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFixedIntervalPollingStrategy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := NewFixedIntervalPollingStrategy(0)
+		assert.True(t, check.IfNil(strategy))
+		assert.True(t, errors.Is(err, ErrInvalidPollingInterval))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := NewFixedIntervalPollingStrategy(time.Second)
+		assert.False(t, check.IfNil(strategy))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Second, strategy.Interval(0))
+		assert.Equal(t, time.Second, strategy.Interval(10))
+	})
+}
+
+func TestNewExponentialPollingStrategy(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func() ArgsExponentialPollingStrategy {
+		return ArgsExponentialPollingStrategy{
+			InitialInterval: time.Millisecond * 500,
+			MaxInterval:     time.Second * 3,
+			Multiplier:      2,
+		}
+	}
+
+	t.Run("invalid initial interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.InitialInterval = 0
+		strategy, err := NewExponentialPollingStrategy(args)
+		assert.True(t, check.IfNil(strategy))
+		assert.True(t, errors.Is(err, ErrInvalidPollingInterval))
+	})
+	t.Run("invalid max interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.MaxInterval = args.InitialInterval - 1
+		strategy, err := NewExponentialPollingStrategy(args)
+		assert.True(t, check.IfNil(strategy))
+		assert.True(t, errors.Is(err, ErrInvalidPollingInterval))
+	})
+	t.Run("invalid multiplier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Multiplier = 0.5
+		strategy, err := NewExponentialPollingStrategy(args)
+		assert.True(t, check.IfNil(strategy))
+		assert.True(t, errors.Is(err, ErrInvalidPollingMultiplier))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		strategy, err := NewExponentialPollingStrategy(createArgs())
+		assert.False(t, check.IfNil(strategy))
+		assert.Nil(t, err)
+		assert.Equal(t, time.Millisecond*500, strategy.Interval(0))
+		assert.Equal(t, time.Second, strategy.Interval(1))
+		assert.Equal(t, time.Second*2, strategy.Interval(2))
+		assert.Equal(t, time.Second*3, strategy.Interval(3))
+		assert.Equal(t, time.Second*3, strategy.Interval(100))
+	})
+}
@@ -0,0 +1,138 @@
+package awaiter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+var log = logger.GetOrCreate("drt-go-sdk/interactors/awaiter")
+
+// ArgsTransactionAwaiter is the DTO used in the transaction awaiter constructor
+type ArgsTransactionAwaiter struct {
+	Proxy           Proxy
+	PollingStrategy PollingStrategy
+}
+
+type transactionAwaiter struct {
+	proxy           Proxy
+	pollingStrategy PollingStrategy
+}
+
+// NewTransactionAwaiter creates a component able to wait until transactions reach their final state
+func NewTransactionAwaiter(args ArgsTransactionAwaiter) (*transactionAwaiter, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+	if check.IfNil(args.PollingStrategy) {
+		return nil, ErrNilPollingStrategy
+	}
+
+	return &transactionAwaiter{
+		proxy:           args.Proxy,
+		pollingStrategy: args.PollingStrategy,
+	}, nil
+}
+
+// Await polls the network until the provided transaction is executed, failed or invalid. A cross-shard transaction
+// that was successfully executed is considered final only after it was notarized by the metachain on the destination
+// shard. The function returns an error only if the context is done before the transaction reaches its final state.
+func (awaiter *transactionAwaiter) Await(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+	if len(txHash) == 0 {
+		return nil, ErrEmptyTransactionHash
+	}
+
+	lastStatus := transaction.TxStatusPending
+	for attempt := 0; ; attempt++ {
+		timer := time.NewTimer(awaiter.pollingStrategy.Interval(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("%w while awaiting transaction %s, last known status: %s", ctx.Err(), txHash, lastStatus)
+		case <-timer.C:
+		}
+
+		outcome, status, err := awaiter.checkTransaction(ctx, txHash)
+		if err != nil {
+			log.Debug("transactionAwaiter.Await: error checking the transaction", "hash", txHash, "error", err)
+			continue
+		}
+
+		lastStatus = status
+		if outcome != nil {
+			return outcome, nil
+		}
+	}
+}
+
+func (awaiter *transactionAwaiter) checkTransaction(ctx context.Context, txHash string) (*data.TransactionOutcome, transaction.TxStatus, error) {
+	status, err := awaiter.proxy.ProcessTransactionStatus(ctx, txHash)
+	if err != nil {
+		return nil, transaction.TxStatusPending, err
+	}
+	if status == transaction.TxStatusPending {
+		return nil, status, nil
+	}
+
+	txInfo, err := awaiter.proxy.GetTransactionInfoWithResults(ctx, txHash)
+	if err != nil {
+		return nil, status, err
+	}
+
+	tx := &txInfo.Data.Transaction
+	if status == transaction.TxStatusSuccess && isCrossShard(tx) && tx.NotarizedAtDestinationInMetaNonce == 0 {
+		return nil, transaction.TxStatusPending, nil
+	}
+
+	return &data.TransactionOutcome{
+		TxHash:      txHash,
+		Status:      status,
+		GasUsed:     tx.GasUsed,
+		Fee:         tx.Fee,
+		ScResults:   tx.ScResults,
+		Logs:        tx.Logs,
+		Transaction: tx,
+	}, status, nil
+}
+
+func isCrossShard(tx *data.TransactionOnNetwork) bool {
+	return tx.SourceShard != tx.DestinationShard
+}
+
+// AwaitAll concurrently awaits all the provided transactions. The outcomes are returned in the same order as the
+// provided hashes. If any of the transactions could not be awaited, the first error is returned along with the
+// outcomes gathered so far (the missing ones will be nil).
+func (awaiter *transactionAwaiter) AwaitAll(ctx context.Context, txHashes []string) ([]*data.TransactionOutcome, error) {
+	outcomes := make([]*data.TransactionOutcome, len(txHashes))
+	errs := make([]error, len(txHashes))
+
+	var wg sync.WaitGroup
+	wg.Add(len(txHashes))
+	for idx, txHash := range txHashes {
+		go func(index int, hash string) {
+			defer wg.Done()
+
+			outcomes[index], errs[index] = awaiter.Await(ctx, hash)
+		}(idx, txHash)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return outcomes, err
+		}
+	}
+
+	return outcomes, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (awaiter *transactionAwaiter) IsInterfaceNil() bool {
+	return awaiter == nil
+}
@@ -0,0 +1,223 @@
+package awaiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTransactionAwaiter() ArgsTransactionAwaiter {
+	strategy, _ := NewFixedIntervalPollingStrategy(time.Millisecond)
+
+	return ArgsTransactionAwaiter{
+		Proxy:           &testsCommon.ProxyStub{},
+		PollingStrategy: strategy,
+	}
+}
+
+func createTransactionInfo(sourceShard uint32, destinationShard uint32, notarizedAtDestination uint64) *data.TransactionInfo {
+	txInfo := &data.TransactionInfo{}
+	txInfo.Data.Transaction = data.TransactionOnNetwork{
+		SourceShard:                       sourceShard,
+		DestinationShard:                  destinationShard,
+		NotarizedAtDestinationInMetaNonce: notarizedAtDestination,
+		GasUsed:                           50000,
+		Fee:                               "50000000000000",
+		Logs:                              &transaction.ApiLogs{Address: "address"},
+	}
+
+	return txInfo
+}
+
+func TestNewTransactionAwaiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = nil
+		awaiter, err := NewTransactionAwaiter(args)
+		assert.True(t, check.IfNil(awaiter))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil polling strategy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.PollingStrategy = nil
+		awaiter, err := NewTransactionAwaiter(args)
+		assert.True(t, check.IfNil(awaiter))
+		assert.Equal(t, ErrNilPollingStrategy, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		awaiter, err := NewTransactionAwaiter(createMockArgsTransactionAwaiter())
+		assert.False(t, check.IfNil(awaiter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTransactionAwaiter_Await(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		awaiter, _ := NewTransactionAwaiter(createMockArgsTransactionAwaiter())
+		outcome, err := awaiter.Await(context.Background(), "")
+		assert.Nil(t, outcome)
+		assert.Equal(t, ErrEmptyTransactionHash, err)
+	})
+	t.Run("should wait until the transaction is not pending", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				numCalls++
+				switch numCalls {
+				case 1:
+					return "", errors.New("transaction not found")
+				case 2:
+					return transaction.TxStatusPending, nil
+				default:
+					return transaction.TxStatusFail, nil
+				}
+			},
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfo(0, 0, 0), nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		outcome, err := awaiter.Await(context.Background(), "hash")
+		require.Nil(t, err)
+		assert.Equal(t, 3, numCalls)
+		assert.Equal(t, "hash", outcome.TxHash)
+		assert.Equal(t, transaction.TxStatusFail, outcome.Status)
+		assert.False(t, outcome.IsSuccessful())
+		assert.Equal(t, uint64(50000), outcome.GasUsed)
+		assert.Equal(t, "50000000000000", outcome.Fee)
+		assert.Equal(t, "address", outcome.Logs.Address)
+	})
+	t.Run("cross-shard transaction should wait the notarization on destination", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				numCalls++
+				if numCalls < 3 {
+					return createTransactionInfo(0, 1, 0), nil
+				}
+
+				return createTransactionInfo(0, 1, 100), nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		outcome, err := awaiter.Await(context.Background(), "hash")
+		require.Nil(t, err)
+		assert.Equal(t, 3, numCalls)
+		assert.True(t, outcome.IsSuccessful())
+		assert.Equal(t, uint64(100), outcome.Transaction.NotarizedAtDestinationInMetaNonce)
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				return transaction.TxStatusPending, nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+
+		outcome, err := awaiter.Await(ctx, "hash")
+		assert.Nil(t, outcome)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+}
+
+func TestTransactionAwaiter_AwaitAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the outcomes in order", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				if hexTxHash == "hash2" {
+					return transaction.TxStatusInvalid, nil
+				}
+
+				return transaction.TxStatusSuccess, nil
+			},
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTransactionInfo(0, 0, 0), nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		outcomes, err := awaiter.AwaitAll(context.Background(), []string{"hash1", "hash2", "hash3"})
+		require.Nil(t, err)
+		require.Equal(t, 3, len(outcomes))
+		assert.Equal(t, "hash1", outcomes[0].TxHash)
+		assert.Equal(t, transaction.TxStatusSuccess, outcomes[0].Status)
+		assert.Equal(t, "hash2", outcomes[1].TxHash)
+		assert.Equal(t, transaction.TxStatusInvalid, outcomes[1].Status)
+		assert.Equal(t, "hash3", outcomes[2].TxHash)
+		assert.Equal(t, transaction.TxStatusSuccess, outcomes[2].Status)
+	})
+	t.Run("one transaction not finalized should error", func(t *testing.T) {
+		t.Parallel()
+
+		mut := sync.Mutex{}
+		queried := make(map[string]struct{})
+		args := createMockArgsTransactionAwaiter()
+		args.Proxy = &testsCommon.ProxyStub{
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				mut.Lock()
+				queried[hexTxHash] = struct{}{}
+				mut.Unlock()
+
+				if hexTxHash == "hash2" {
+					return transaction.TxStatusPending, nil
+				}
+
+				return transaction.TxStatusSuccess, nil
+			},
+		}
+		awaiter, _ := NewTransactionAwaiter(args)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+
+		outcomes, err := awaiter.AwaitAll(ctx, []string{"hash1", "hash2"})
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		require.Equal(t, 2, len(outcomes))
+		assert.NotNil(t, outcomes[0])
+		assert.Nil(t, outcomes[1])
+
+		mut.Lock()
+		assert.Equal(t, 2, len(queried))
+		mut.Unlock()
+	})
+}
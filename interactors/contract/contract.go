@@ -0,0 +1,286 @@
+package contract
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const (
+	argumentsSeparator = "@"
+	okReturnCode       = "6f6b"
+	percentDivider     = 100
+)
+
+var log = logger.GetOrCreate("drt-go-sdk/interactors/contract")
+
+// CallOptions holds the optional settings of a contract call
+type CallOptions struct {
+	// Value is the amount of REWA sent along with the call, nil means no value
+	Value *big.Int
+	// GasLimit is the gas limit of the call, 0 means that the gas limit is estimated by the network
+	GasLimit uint64
+	// GasLimitMarginPercent is the percentage added on top of the estimated gas limit
+	GasLimitMarginPercent uint64
+	// Await tells whether the call should wait for the transaction outcome
+	Await bool
+}
+
+// CallResult holds the result of a contract call
+type CallResult struct {
+	TxHash      string
+	Transaction *transaction.FrontendTransaction
+	// Outcome and Values are set only if the call was awaited. Values holds the decoded return data of a
+	// successful call
+	Outcome *data.TransactionOutcome
+	Values  []interface{}
+}
+
+// ArgsContract is the DTO used in the contract constructor
+type ArgsContract struct {
+	Address      core.AddressHandler
+	Codec        Codec
+	Proxy        Proxy
+	CryptoHolder core.CryptoComponentsHolder
+	TxBuilder    TxBuilder
+	NonceHandler TransactionNonceHandler
+	Awaiter      TransactionAwaiter
+}
+
+type contract struct {
+	address       core.AddressHandler
+	bech32Address string
+	codec         Codec
+	proxy         Proxy
+	cryptoHolder  core.CryptoComponentsHolder
+	txBuilder     TxBuilder
+	nonceHandler  TransactionNonceHandler
+	awaiter       TransactionAwaiter
+	queryGetter   vmQueryGetter
+}
+
+type vmQueryGetter interface {
+	ExecuteQueryDecodedFromBuilder(ctx context.Context, builder builders.VMQueryBuilder, decoder blockchain.OutputsDecoder) ([]interface{}, error)
+}
+
+// NewContract creates a component bound to a smart contract address able to query and call its endpoints. If no codec
+// is provided, the arguments should be byte slices and the outputs are returned as byte slices. The awaiter is optional
+// and required only by the calls that wait for their outcome
+func NewContract(args ArgsContract) (*contract, error) {
+	if check.IfNil(args.Address) {
+		return nil, ErrNilAddress
+	}
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+	if check.IfNil(args.CryptoHolder) {
+		return nil, ErrNilCryptoComponentsHolder
+	}
+	if check.IfNil(args.TxBuilder) {
+		return nil, ErrNilTxBuilder
+	}
+	if check.IfNil(args.NonceHandler) {
+		return nil, ErrNilNonceHandler
+	}
+
+	bech32Address, err := args.Address.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	queryGetter, err := blockchain.NewVmQueryGetter(blockchain.ArgsVmQueryGetter{
+		Proxy: args.Proxy,
+		Log:   log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	codec := args.Codec
+	if check.IfNil(codec) {
+		codec = &rawCodec{}
+	}
+
+	return &contract{
+		address:       args.Address,
+		bech32Address: bech32Address,
+		codec:         codec,
+		proxy:         args.Proxy,
+		cryptoHolder:  args.CryptoHolder,
+		txBuilder:     args.TxBuilder,
+		nonceHandler:  args.NonceHandler,
+		awaiter:       args.Awaiter,
+		queryGetter:   queryGetter,
+	}, nil
+}
+
+// Address returns the address of the contract
+func (c *contract) Address() core.AddressHandler {
+	return c.address
+}
+
+// Query executes a read-only call of the provided endpoint and returns its decoded outputs
+func (c *contract) Query(ctx context.Context, function string, args ...interface{}) ([]interface{}, error) {
+	builder := builders.NewVMQueryBuilder().
+		Function(function).
+		CallerAddress(c.cryptoHolder.GetAddressHandler()).
+		Address(c.address).
+		ArgsEncoded(c.codec, args...)
+
+	return c.queryGetter.ExecuteQueryDecodedFromBuilder(ctx, builder, c.codec)
+}
+
+// Call builds a transaction calling the provided endpoint, estimates its gas limit if none was provided, signs it and
+// sends it through the nonce handler. If requested, it also awaits the transaction and decodes the returned values
+func (c *contract) Call(ctx context.Context, function string, args []interface{}, options CallOptions) (*CallResult, error) {
+	value := big.NewInt(0)
+	if options.Value != nil {
+		if options.Value.Sign() < 0 {
+			return nil, fmt.Errorf("%w, provided: %s", ErrInvalidValue, options.Value.String())
+		}
+		value.Set(options.Value)
+	}
+	if options.Await && check.IfNil(c.awaiter) {
+		return nil, ErrNilTransactionAwaiter
+	}
+
+	txData, err := builders.NewTxDataBuilder().
+		Function(function).
+		ArgsEncoded(c.codec, args...).
+		ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	networkConfig, err := c.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.FrontendTransaction{
+		Value:    value.String(),
+		Receiver: c.bech32Address,
+		Sender:   c.cryptoHolder.GetBech32(),
+		GasPrice: networkConfig.MinGasPrice,
+		GasLimit: options.GasLimit,
+		Data:     txData,
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+	}
+	if tx.GasLimit == 0 {
+		tx.GasLimit, err = c.estimateGasLimit(ctx, tx, options.GasLimitMarginPercent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = c.nonceHandler.ApplyNonceAndGasPrice(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.txBuilder.ApplyUserSignature(c.cryptoHolder, tx)
+	if err != nil {
+		c.nonceHandler.ReleaseNonce(tx)
+		return nil, err
+	}
+
+	hashes, err := c.nonceHandler.SendTransactions(ctx, tx)
+	if err != nil {
+		c.nonceHandler.ReleaseNonce(tx)
+		return nil, err
+	}
+	if len(hashes) != 1 || len(hashes[0]) == 0 {
+		return nil, ErrMissingTransactionHash
+	}
+
+	result := &CallResult{
+		TxHash:      hashes[0],
+		Transaction: tx,
+	}
+	log.Debug("contract.Call: transaction sent", "contract", c.bech32Address, "function", function, "hash", result.TxHash)
+	if !options.Await {
+		return result, nil
+	}
+
+	result.Outcome, err = c.awaiter.Await(ctx, result.TxHash)
+	if err != nil {
+		return result, err
+	}
+	if !result.Outcome.IsSuccessful() {
+		return result, nil
+	}
+
+	returnData, found, err := extractReturnData(result.Outcome, tx)
+	if err != nil || !found {
+		return result, err
+	}
+
+	result.Values, err = c.codec.DecodeOutputs(function, returnData)
+
+	return result, err
+}
+
+func (c *contract) estimateGasLimit(ctx context.Context, tx *transaction.FrontendTransaction, marginPercent uint64) (uint64, error) {
+	cost, err := c.proxy.RequestTransactionCost(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if len(cost.RetMessage) > 0 {
+		return 0, fmt.Errorf("%w: %s", ErrGasEstimationFailed, cost.RetMessage)
+	}
+	if cost.TxCost == 0 {
+		return 0, fmt.Errorf("%w: zero gas units estimated", ErrGasEstimationFailed)
+	}
+
+	return cost.TxCost + cost.TxCost*marginPercent/percentDivider, nil
+}
+
+// extractReturnData searches the smart contract results for the one returned to the caller by the provided transaction,
+// holding the "ok" return code followed by the values returned by the called endpoint. The results of the nested calls,
+// which might hold an "ok" return code as well, are ignored
+func extractReturnData(outcome *data.TransactionOutcome, tx *transaction.FrontendTransaction) ([][]byte, bool, error) {
+	prefix := argumentsSeparator + okReturnCode
+	for _, scr := range outcome.ScResults {
+		if scr == nil || !strings.HasPrefix(scr.Data, prefix) {
+			continue
+		}
+		if scr.RcvAddr != tx.Sender || scr.PrevTxHash != outcome.TxHash {
+			continue
+		}
+
+		parts := strings.Split(scr.Data, argumentsSeparator)
+		if parts[1] != okReturnCode {
+			continue
+		}
+
+		returnData := make([][]byte, 0, len(parts)-2)
+		for _, part := range parts[2:] {
+			buff, err := hex.DecodeString(part)
+			if err != nil {
+				return nil, false, fmt.Errorf("%w: %s, provided: %s", ErrInvalidReturnData, err.Error(), scr.Data)
+			}
+
+			returnData = append(returnData, buff)
+		}
+
+		return returnData, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *contract) IsInterfaceNil() bool {
+	return c == nil
+}
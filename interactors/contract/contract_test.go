@@ -0,0 +1,482 @@
+package contract
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing/ed25519"
+	"github.com/TerraDharitri/drt-go-sdk/abi"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/cryptoProvider"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSecretKey   = "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c4"
+	contractAddress = "drt1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqeyzkqc"
+	testTxHash      = "a1b2c3"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createCryptoHolder(t *testing.T) sdkCore.CryptoComponentsHolder {
+	skBytes, err := hex.DecodeString(testSecretKey)
+	require.Nil(t, err)
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(signing.NewKeyGenerator(ed25519.NewEd25519()), skBytes)
+	require.Nil(t, err)
+
+	return holder
+}
+
+func createMockArgsContract(t *testing.T) ArgsContract {
+	address, err := data.NewAddressFromBech32String(contractAddress)
+	require.Nil(t, err)
+
+	return ArgsContract{
+		Address: address,
+		Proxy: &testsCommon.ProxyStub{
+			GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					ChainID:               "T",
+					MinGasPrice:           1000000000,
+					MinTransactionVersion: 2,
+				}, nil
+			},
+		},
+		CryptoHolder: createCryptoHolder(t),
+		TxBuilder:    &testsCommon.TxBuilderStub{},
+		NonceHandler: &testsCommon.TxNonceHandlerV3Stub{
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				return []string{testTxHash}, nil
+			},
+		},
+		Awaiter: &testsCommon.TransactionAwaiterStub{},
+	}
+}
+
+func createExampleCodec(t *testing.T) Codec {
+	codec, err := abi.NewCodecFromFile("../../abi/testdata/example.abi.json")
+	require.Nil(t, err)
+
+	return codec
+}
+
+func TestNewContract(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Address = nil
+		c, err := NewContract(args)
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Proxy = nil
+		c, err := NewContract(args)
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil crypto holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.CryptoHolder = nil
+		c, err := NewContract(args)
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, ErrNilCryptoComponentsHolder, err)
+	})
+	t.Run("nil tx builder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.TxBuilder = nil
+		c, err := NewContract(args)
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, ErrNilTxBuilder, err)
+	})
+	t.Run("nil nonce handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.NonceHandler = nil
+		c, err := NewContract(args)
+		assert.True(t, check.IfNil(c))
+		assert.Equal(t, ErrNilNonceHandler, err)
+	})
+	t.Run("should work without codec and awaiter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Awaiter = nil
+		c, err := NewContract(args)
+		assert.False(t, check.IfNil(c))
+		assert.Nil(t, err)
+		assert.Equal(t, args.Address, c.Address())
+	})
+}
+
+func TestContract_Query(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without codec, only byte slice arguments are accepted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		c, _ := NewContract(args)
+		results, err := c.Query(context.Background(), "getSum", uint64(1))
+		assert.Nil(t, results)
+		assert.True(t, errors.Is(err, ErrInvalidArgument))
+	})
+	t.Run("without codec should return the raw outputs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Proxy = &testsCommon.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				assert.Equal(t, contractAddress, vmRequest.Address)
+				assert.Equal(t, args.CryptoHolder.GetBech32(), vmRequest.CallerAddr)
+				assert.Equal(t, "getValue", vmRequest.FuncName)
+				assert.Equal(t, []string{"0102", ""}, vmRequest.Args)
+
+				response := &data.VmValuesResponseData{Data: &vm.VMOutputApi{}}
+				response.Data.ReturnCode = "ok"
+				response.Data.ReturnData = [][]byte{{7}, {}}
+
+				return response, nil
+			},
+		}
+		c, _ := NewContract(args)
+		results, err := c.Query(context.Background(), "getValue", []byte{1, 2}, []byte{})
+		require.Nil(t, err)
+		assert.Equal(t, []interface{}{[]byte{7}, []byte{}}, results)
+	})
+	t.Run("with codec should encode the arguments and decode the outputs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Codec = createExampleCodec(t)
+		args.Proxy = &testsCommon.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				assert.Equal(t, "getOrder", vmRequest.FuncName)
+				assert.Equal(t, []string{"07"}, vmRequest.Args)
+
+				response := &data.VmValuesResponseData{Data: &vm.VMOutputApi{}}
+				response.Data.ReturnCode = "ok"
+				response.Data.ReturnData = [][]byte{{}, {}}
+
+				return response, nil
+			},
+		}
+		c, _ := NewContract(args)
+		_, err := c.Query(context.Background(), "getOrder", uint32(7))
+		assert.NotNil(t, err)
+
+		args.Proxy = &testsCommon.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				response := &data.VmValuesResponseData{Data: &vm.VMOutputApi{}}
+				response.Data.ReturnCode = "ok"
+				response.Data.ReturnData = [][]byte{big.NewInt(37).Bytes()}
+
+				return response, nil
+			},
+		}
+		c, _ = NewContract(args)
+		results, err := c.Query(context.Background(), "getSum")
+		require.Nil(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, "37", results[0].(*big.Int).String())
+	})
+	t.Run("proxy error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Proxy = &testsCommon.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewContract(args)
+		results, err := c.Query(context.Background(), "getSum")
+		assert.Nil(t, results)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestContract_Call(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid options should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		c, _ := NewContract(args)
+		result, err := c.Call(context.Background(), "add", nil, CallOptions{Value: big.NewInt(-1)})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		args.Awaiter = nil
+		c, _ = NewContract(args)
+		result, err = c.Call(context.Background(), "add", nil, CallOptions{Await: true})
+		assert.Nil(t, result)
+		assert.Equal(t, ErrNilTransactionAwaiter, err)
+	})
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Codec = createExampleCodec(t)
+		c, _ := NewContract(args)
+		result, err := c.Call(context.Background(), "missing", nil, CallOptions{})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, abi.ErrEndpointNotFound))
+	})
+	t.Run("gas estimation failure should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		proxy := args.Proxy.(*testsCommon.ProxyStub)
+		proxy.RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			return &data.TxCostResponseData{RetMessage: "function not found"}, nil
+		}
+		c, _ := NewContract(args)
+		result, err := c.Call(context.Background(), "add", nil, CallOptions{})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrGasEstimationFailed))
+		assert.Contains(t, err.Error(), "function not found")
+
+		proxy.RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			return nil, expectedErr
+		}
+		result, err = c.Call(context.Background(), "add", nil, CallOptions{})
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("nonce handler, signing and sending errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.NonceHandler = &testsCommon.TxNonceHandlerV3Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				return expectedErr
+			},
+		}
+		c, _ := NewContract(args)
+		result, err := c.Call(context.Background(), "add", nil, CallOptions{GasLimit: 1000})
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+
+		releasedNonces := make([]uint64, 0)
+		nonceHandler := &testsCommon.TxNonceHandlerV3Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				txs[0].Nonce = 5
+
+				return nil
+			},
+			ReleaseNonceCalled: func(txs ...*transaction.FrontendTransaction) {
+				require.Len(t, txs, 1)
+				releasedNonces = append(releasedNonces, txs[0].Nonce)
+			},
+		}
+
+		args = createMockArgsContract(t)
+		args.NonceHandler = nonceHandler
+		args.TxBuilder = &testsCommon.TxBuilderStub{
+			ApplyUserSignatureCalled: func(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
+				return expectedErr
+			},
+		}
+		c, _ = NewContract(args)
+		result, err = c.Call(context.Background(), "add", nil, CallOptions{GasLimit: 1000})
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, []uint64{5}, releasedNonces)
+
+		args = createMockArgsContract(t)
+		args.NonceHandler = nonceHandler
+		nonceHandler.SendTransactionsCalled = func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+			return nil, expectedErr
+		}
+		c, _ = NewContract(args)
+		result, err = c.Call(context.Background(), "add", nil, CallOptions{GasLimit: 1000})
+		assert.Nil(t, result)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, []uint64{5, 5}, releasedNonces)
+
+		args = createMockArgsContract(t)
+		args.NonceHandler = &testsCommon.TxNonceHandlerV3Stub{}
+		c, _ = NewContract(args)
+		result, err = c.Call(context.Background(), "add", nil, CallOptions{GasLimit: 1000})
+		assert.Nil(t, result)
+		assert.Equal(t, ErrMissingTransactionHash, err)
+	})
+	t.Run("should build, estimate, sign and send the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Codec = createExampleCodec(t)
+		proxy := args.Proxy.(*testsCommon.ProxyStub)
+		proxy.RequestTransactionCostCalled = func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+			assert.Equal(t, uint64(0), tx.GasLimit)
+			assert.Equal(t, "setLimits@02@01@02@070000000101@00000000", string(tx.Data))
+
+			return &data.TxCostResponseData{TxCost: 1000}, nil
+		}
+		signed := false
+		args.TxBuilder = &testsCommon.TxBuilderStub{
+			ApplyUserSignatureCalled: func(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
+				assert.Equal(t, uint64(1100), tx.GasLimit)
+				assert.Equal(t, uint64(5), tx.Nonce)
+				signed = true
+
+				return nil
+			},
+		}
+		args.NonceHandler = &testsCommon.TxNonceHandlerV3Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+				txs[0].Nonce = 5
+
+				return nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+				assert.True(t, signed)
+
+				return []string{testTxHash}, nil
+			},
+		}
+		args.Awaiter = &testsCommon.TransactionAwaiterStub{
+			AwaitCalled: func(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+				assert.Fail(t, "should have not awaited the transaction")
+
+				return nil, nil
+			},
+		}
+		c, _ := NewContract(args)
+		result, err := c.Call(
+			context.Background(),
+			"setLimits",
+			[]interface{}{[]int64{1, 2}, []interface{}{7, 1}, []byte{0, 0, 0, 0}},
+			CallOptions{
+				Value:                 big.NewInt(10),
+				GasLimitMarginPercent: 10,
+			},
+		)
+		require.Nil(t, err)
+		assert.Equal(t, testTxHash, result.TxHash)
+		assert.Nil(t, result.Outcome)
+		assert.Equal(t, "10", result.Transaction.Value)
+		assert.Equal(t, contractAddress, result.Transaction.Receiver)
+		assert.Equal(t, args.CryptoHolder.GetBech32(), result.Transaction.Sender)
+		assert.Equal(t, "T", result.Transaction.ChainID)
+		assert.Equal(t, uint32(2), result.Transaction.Version)
+	})
+	t.Run("should await and decode the returned values", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Codec = createExampleCodec(t)
+		caller := args.CryptoHolder.GetBech32()
+		outcome := &data.TransactionOutcome{
+			TxHash: testTxHash,
+			Status: transaction.TxStatusSuccess,
+			ScResults: []*transaction.ApiSmartContractResult{
+				{Data: "@6f6b7a", RcvAddr: caller, PrevTxHash: testTxHash},
+				{Data: "@6f6b@2a", RcvAddr: caller, PrevTxHash: testTxHash},
+			},
+		}
+		args.Awaiter = &testsCommon.TransactionAwaiterStub{
+			AwaitCalled: func(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+				assert.Equal(t, testTxHash, txHash)
+
+				return outcome, nil
+			},
+		}
+		c, _ := NewContract(args)
+		result, err := c.Call(context.Background(), "getSum", nil, CallOptions{GasLimit: 1000, Await: true})
+		require.Nil(t, err)
+		assert.Equal(t, outcome, result.Outcome)
+		require.Len(t, result.Values, 1)
+		assert.Equal(t, "42", result.Values[0].(*big.Int).String())
+
+		outcome.ScResults = []*transaction.ApiSmartContractResult{{Data: "@6f6b@zz", RcvAddr: caller, PrevTxHash: testTxHash}}
+		result, err = c.Call(context.Background(), "getSum", nil, CallOptions{GasLimit: 1000, Await: true})
+		assert.True(t, errors.Is(err, ErrInvalidReturnData))
+		assert.Equal(t, outcome, result.Outcome)
+	})
+	t.Run("should decode only the values returned to the caller", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Codec = createExampleCodec(t)
+		caller := args.CryptoHolder.GetBech32()
+		outcome := &data.TransactionOutcome{
+			TxHash: testTxHash,
+			Status: transaction.TxStatusSuccess,
+			ScResults: []*transaction.ApiSmartContractResult{
+				// result of a nested call, returned to the called contract
+				{Data: "@6f6b@07", RcvAddr: contractAddress, PrevTxHash: "d4e5f6"},
+				// result of another transaction of the same caller
+				{Data: "@6f6b@08", RcvAddr: caller, PrevTxHash: "d4e5f6"},
+				{Data: "@6f6b@2a", RcvAddr: caller, PrevTxHash: testTxHash},
+			},
+		}
+		args.Awaiter = &testsCommon.TransactionAwaiterStub{
+			AwaitCalled: func(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+				return outcome, nil
+			},
+		}
+		c, _ := NewContract(args)
+		result, err := c.Call(context.Background(), "getSum", nil, CallOptions{GasLimit: 1000, Await: true})
+		require.Nil(t, err)
+		require.Len(t, result.Values, 1)
+		assert.Equal(t, "42", result.Values[0].(*big.Int).String())
+
+		outcome.ScResults = outcome.ScResults[:2]
+		result, err = c.Call(context.Background(), "getSum", nil, CallOptions{GasLimit: 1000, Await: true})
+		require.Nil(t, err)
+		assert.Nil(t, result.Values)
+	})
+	t.Run("failed or unawaited transactions should not be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContract(t)
+		args.Awaiter = &testsCommon.TransactionAwaiterStub{
+			AwaitCalled: func(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+				return &data.TransactionOutcome{
+					Status:    transaction.TxStatusFail,
+					ScResults: []*transaction.ApiSmartContractResult{{Data: "@6f6b@2a"}},
+				}, nil
+			},
+		}
+		c, _ := NewContract(args)
+		result, err := c.Call(context.Background(), "getSum", nil, CallOptions{GasLimit: 1000, Await: true})
+		require.Nil(t, err)
+		assert.False(t, result.Outcome.IsSuccessful())
+		assert.Nil(t, result.Values)
+
+		args.Awaiter = &testsCommon.TransactionAwaiterStub{
+			AwaitCalled: func(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ = NewContract(args)
+		result, err = c.Call(context.Background(), "getSum", nil, CallOptions{GasLimit: 1000, Await: true})
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, testTxHash, result.TxHash)
+	})
+}
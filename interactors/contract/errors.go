@@ -0,0 +1,36 @@
+package contract
+
+import "errors"
+
+// ErrNilAddress signals that a nil contract address was provided
+var ErrNilAddress = errors.New("nil address")
+
+// ErrNilProxy signals that a nil proxy was provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilCryptoComponentsHolder signals that a nil crypto components holder was provided
+var ErrNilCryptoComponentsHolder = errors.New("nil crypto components holder")
+
+// ErrNilTxBuilder signals that a nil transaction builder was provided
+var ErrNilTxBuilder = errors.New("nil tx builder")
+
+// ErrNilNonceHandler signals that a nil nonce handler was provided
+var ErrNilNonceHandler = errors.New("nil nonce handler")
+
+// ErrNilTransactionAwaiter signals that the outcome of a call was requested but no transaction awaiter was provided
+var ErrNilTransactionAwaiter = errors.New("nil transaction awaiter")
+
+// ErrInvalidArgument signals that a raw argument that is not a byte slice was provided to a contract without ABI
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrInvalidValue signals that an invalid call value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrGasEstimationFailed signals that the network could not estimate the gas limit of a call
+var ErrGasEstimationFailed = errors.New("gas estimation failed")
+
+// ErrInvalidReturnData signals that the return data of a call could not be parsed
+var ErrInvalidReturnData = errors.New("invalid return data")
+
+// ErrMissingTransactionHash signals that the nonce handler did not return the hash of the sent transaction
+var ErrMissingTransactionHash = errors.New("missing transaction hash")
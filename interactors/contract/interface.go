@@ -0,0 +1,44 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// Proxy holds the proxy functions required by the contract
+type Proxy interface {
+	blockchain.Proxy
+	RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
+}
+
+// Codec defines the component able to encode the arguments and decode the outputs of the contract's endpoints
+type Codec interface {
+	EncodeArguments(function string, values ...interface{}) ([][]byte, error)
+	DecodeOutputs(function string, returnData [][]byte) ([]interface{}, error)
+	IsInterfaceNil() bool
+}
+
+// TxBuilder defines the component able to sign a transaction
+type TxBuilder interface {
+	ApplyUserSignature(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	IsInterfaceNil() bool
+}
+
+// TransactionNonceHandler defines the component able to apply nonces, send transactions and give back the nonces of
+// the transactions that could not be sent
+type TransactionNonceHandler interface {
+	ApplyNonceAndGasPrice(ctx context.Context, tx ...*transaction.FrontendTransaction) error
+	SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error)
+	ReleaseNonce(txs ...*transaction.FrontendTransaction)
+	IsInterfaceNil() bool
+}
+
+// TransactionAwaiter defines the component able to wait until a transaction reaches its final state
+type TransactionAwaiter interface {
+	Await(ctx context.Context, txHash string) (*data.TransactionOutcome, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,38 @@
+package contract
+
+import "fmt"
+
+// rawCodec is used when the contract has no ABI: the arguments are passed as they are and the outputs are returned
+// as byte slices
+type rawCodec struct {
+}
+
+// EncodeArguments returns the provided values that should all be byte slices
+func (codec *rawCodec) EncodeArguments(function string, values ...interface{}) ([][]byte, error) {
+	args := make([][]byte, 0, len(values))
+	for idx, value := range values {
+		arg, ok := value.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("%w for function %s at index %d, provided: %T", ErrInvalidArgument, function, idx, value)
+		}
+
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
+
+// DecodeOutputs returns the return data as a slice of byte slices
+func (codec *rawCodec) DecodeOutputs(_ string, returnData [][]byte) ([]interface{}, error) {
+	outputs := make([]interface{}, 0, len(returnData))
+	for _, buff := range returnData {
+		outputs = append(outputs, buff)
+	}
+
+	return outputs, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (codec *rawCodec) IsInterfaceNil() bool {
+	return codec == nil
+}
@@ -37,3 +37,6 @@ var ErrGapNonce = errors.New("gap nonce detected")
 
 // ErrWorkerClosed signals that the worker is closed
 var ErrWorkerClosed = errors.New("worker closed")
+
+// ErrTransactionSimulationFailed signals that the simulation of a transaction failed
+var ErrTransactionSimulationFailed = errors.New("transaction simulation failed")
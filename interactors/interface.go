@@ -3,6 +3,7 @@ package interactors
 import (
 	"context"
 
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 
 	"github.com/TerraDharitri/drt-go-sdk/core"
@@ -13,12 +14,26 @@ import (
 // dependency inversion: blockchain package is considered inner business logic, this package is considered "plugin"
 type Proxy interface {
 	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
-	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	GetAccount(ctx context.Context, address core.AddressHandler, queryOptions api.AccountQueryOptions) (*data.Account, error)
 	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
 	SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
 	IsInterfaceNil() bool
 }
 
+// TransactionSimulator defines the component able to simulate the execution of a transaction
+type TransactionSimulator interface {
+	SimulateTransaction(ctx context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulation, error)
+	IsInterfaceNil() bool
+}
+
+// TransactionsPoolProvider defines the component able to return the transactions of a sender that are waiting in the
+// transactions pool, along with the nonce ranges missing from the pool
+type TransactionsPoolProvider interface {
+	GetTransactionsPoolForSender(ctx context.Context, address core.AddressHandler) ([]*data.PoolTransaction, error)
+	GetTransactionsPoolNonceGapsForSender(ctx context.Context, address core.AddressHandler) ([]data.NonceGap, error)
+	IsInterfaceNil() bool
+}
+
 // TxBuilder defines the component able to build & sign a transaction
 type TxBuilder interface {
 	ApplyUserSignature(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
@@ -32,6 +47,7 @@ type AddressNonceHandler interface {
 	ApplyNonceAndGasPrice(ctx context.Context, tx *transaction.FrontendTransaction) error
 	ReSendTransactionsIfRequired(ctx context.Context) error
 	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	ReleaseNonce(tx *transaction.FrontendTransaction)
 	DropTransactions()
 	IsInterfaceNil() bool
 }
@@ -40,6 +56,8 @@ type AddressNonceHandler interface {
 type AddressNonceHandlerV3 interface {
 	ApplyNonceAndGasPrice(ctx context.Context, tx ...*transaction.FrontendTransaction) error
 	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	ReleaseNonce(tx *transaction.FrontendTransaction)
+	ReconcileTransactions(ctx context.Context) error
 	IsInterfaceNil() bool
 	Close()
 }
@@ -65,6 +83,7 @@ type TransactionNonceHandlerV2 interface {
 type TransactionNonceHandlerV3 interface {
 	ApplyNonceAndGasPrice(ctx context.Context, tx ...*transaction.FrontendTransaction) error
 	SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error)
+	ReleaseNonce(txs ...*transaction.FrontendTransaction)
 	Close()
 	IsInterfaceNil() bool
 }
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 
 	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
@@ -37,7 +38,7 @@ func newAddressNonceHandler(proxy interactors.Proxy, address sdkCore.AddressHand
 }
 
 func (anh *addressNonceHandler) getNonceUpdatingCurrent(ctx context.Context) (uint64, error) {
-	account, err := anh.proxy.GetAccount(ctx, anh.address)
+	account, err := anh.proxy.GetAccount(ctx, anh.address, api.AccountQueryOptions{})
 	if err != nil {
 		return 0, err
 	}
@@ -58,7 +59,7 @@ func (anh *addressNonceHandler) getNonceUpdatingCurrent(ctx context.Context) (ui
 }
 
 func (anh *addressNonceHandler) reSendTransactionsIfRequired(ctx context.Context) error {
-	account, err := anh.proxy.GetAccount(ctx, anh.address)
+	account, err := anh.proxy.GetAccount(ctx, anh.address, api.AccountQueryOptions{})
 	if err != nil {
 		return err
 	}
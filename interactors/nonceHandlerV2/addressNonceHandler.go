@@ -6,6 +6,7 @@ import (
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 
 	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
@@ -95,7 +96,7 @@ func (anh *addressNonceHandler) fetchGasPriceIfRequired(ctx context.Context, non
 }
 
 func (anh *addressNonceHandler) getNonceUpdatingCurrent(ctx context.Context) (uint64, error) {
-	account, err := anh.proxy.GetAccount(ctx, anh.address)
+	account, err := anh.proxy.GetAccount(ctx, anh.address, api.AccountQueryOptions{})
 	if err != nil {
 		return 0, err
 	}
@@ -121,7 +122,7 @@ func (anh *addressNonceHandler) getNonceUpdatingCurrent(ctx context.Context) (ui
 
 // ReSendTransactionsIfRequired will resend the cached transactions that still have a nonce greater that the one fetched from the blockchain
 func (anh *addressNonceHandler) ReSendTransactionsIfRequired(ctx context.Context) error {
-	account, err := anh.proxy.GetAccount(ctx, anh.address)
+	account, err := anh.proxy.GetAccount(ctx, anh.address, api.AccountQueryOptions{})
 	if err != nil {
 		return err
 	}
@@ -176,6 +177,24 @@ func (anh *addressNonceHandler) SendTransaction(ctx context.Context, tx *transac
 	return anh.proxy.SendTransaction(ctx, tx)
 }
 
+// ReleaseNonce gives back the nonce of a transaction that was not sent. If it was the last computed nonce, it will be
+// applied on the next transaction. Otherwise, the released nonce would leave a gap, so the computed nonce is dropped
+// and the next transaction will use the nonce fetched from the account
+func (anh *addressNonceHandler) ReleaseNonce(tx *transaction.FrontendTransaction) {
+	anh.mut.Lock()
+	defer anh.mut.Unlock()
+
+	if !anh.computedNonceWasSet || tx.Nonce > anh.computedNonce {
+		return
+	}
+	if tx.Nonce < anh.computedNonce || anh.computedNonce == 0 {
+		anh.computedNonceWasSet = false
+		return
+	}
+
+	anh.computedNonce--
+}
+
 // DropTransactions will delete the cached transactions and will try to replace the current transactions from the pool using more gas price
 func (anh *addressNonceHandler) DropTransactions() {
 	anh.mut.Lock()
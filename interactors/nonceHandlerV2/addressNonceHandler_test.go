@@ -242,6 +242,42 @@ func TestAddressNonceHandler_DropTransactions(t *testing.T) {
 	require.Equal(t, 0, len(anh.transactions))
 }
 
+func TestAddressNonceHandler_ReleaseNonce(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(10)
+	proxy := &testsCommon.ProxyStub{
+		GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Nonce: accountNonce}, nil
+		},
+	}
+	anh, _ := NewAddressNonceHandlerWithPrivateAccess(proxy, testAddress)
+
+	txs := make([]*transaction.FrontendTransaction, 3)
+	for idx := range txs {
+		tx := createDefaultTx()
+		txs[idx] = &tx
+		err := anh.ApplyNonceAndGasPrice(context.Background(), txs[idx])
+		require.Nil(t, err)
+		require.Equal(t, accountNonce+uint64(idx), txs[idx].Nonce)
+	}
+
+	// releasing the last nonce should reuse it
+	anh.ReleaseNonce(txs[2])
+	tx := createDefaultTx()
+	err := anh.ApplyNonceAndGasPrice(context.Background(), &tx)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(12), tx.Nonce)
+
+	// releasing a nonce in the middle should drop the computed nonce and use the account's nonce
+	anh.ReleaseNonce(txs[1])
+	accountNonce = 11
+	tx = createDefaultTx()
+	err = anh.ApplyNonceAndGasPrice(context.Background(), &tx)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(11), tx.Nonce)
+}
+
 func TestAddressNonceHandler_ReSendTransactionsIfRequired(t *testing.T) {
 	t.Parallel()
 
@@ -23,6 +23,10 @@ var log = logger.GetOrCreate("drt-go-sdk/interactors/nonceHandlerV2")
 type ArgsNonceTransactionsHandlerV2 struct {
 	Proxy            interactors.Proxy
 	IntervalToResend time.Duration
+	// TransactionSimulator, if set, is used to simulate each transaction before sending it. The transactions whose
+	// simulation fails are not sent. The nonce of such a transaction is reused if it was the last applied one,
+	// otherwise the cached nonce is dropped and fetched again from the account
+	TransactionSimulator interactors.TransactionSimulator
 }
 
 // nonceTransactionsHandlerV2 is the handler used for an unlimited number of addresses.
@@ -35,6 +39,7 @@ type ArgsNonceTransactionsHandlerV2 struct {
 // This struct is concurrent safe.
 type nonceTransactionsHandlerV2 struct {
 	proxy            interactors.Proxy
+	simulator        interactors.TransactionSimulator
 	mutHandlers      sync.RWMutex
 	handlers         map[string]interactors.AddressNonceHandler
 	cancelFunc       func()
@@ -53,6 +58,7 @@ func NewNonceTransactionHandlerV2(args ArgsNonceTransactionsHandlerV2) (*nonceTr
 
 	nth := &nonceTransactionsHandlerV2{
 		proxy:            args.Proxy,
+		simulator:        args.TransactionSimulator,
 		handlers:         make(map[string]interactors.AddressNonceHandler),
 		intervalToResend: args.IntervalToResend,
 	}
@@ -141,6 +147,12 @@ func (nth *nonceTransactionsHandlerV2) SendTransaction(ctx context.Context, tx *
 		return "", err
 	}
 
+	err = interactors.CheckTransactionSimulation(ctx, nth.simulator, &txCopy)
+	if err != nil {
+		anh.ReleaseNonce(&txCopy)
+		return "", fmt.Errorf("%w while simulating transaction for address %s", err, addrAsBech32)
+	}
+
 	sentHash, err := anh.SendTransaction(ctx, &txCopy)
 	if err != nil {
 		return "", fmt.Errorf("%w while sending transaction for address %s", err, addrAsBech32)
@@ -487,6 +487,70 @@ func TestNonceTransactionsHandlerV2_ForceNonceReFetch(t *testing.T) {
 	assert.Equal(t, atomic.LoadUint64(&currentNonce), tx.Nonce)
 }
 
+func TestNonceTransactionsHandlerV2_SendTransactionWithSimulation(t *testing.T) {
+	t.Parallel()
+
+	currentNonce := uint64(664)
+	sentNonces := make([]uint64, 0)
+	simulatedNonces := make([]uint64, 0)
+
+	args := createMockArgsNonceTransactionsHandlerV2()
+	args.Proxy = &testsCommon.ProxyStub{
+		GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{
+				Nonce: currentNonce,
+			}, nil
+		},
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			sentNonces = append(sentNonces, tx.Nonce)
+			return "hash", nil
+		},
+	}
+	args.TransactionSimulator = &testsCommon.TransactionSimulatorStub{
+		SimulateTransactionCalled: func(tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulation, error) {
+			assert.False(t, checkSignature)
+			simulatedNonces = append(simulatedNonces, tx.Nonce)
+
+			simulation := &data.TransactionSimulation{}
+			if string(tx.Data) == "fail" {
+				simulation.Status = transaction.TxStatusFail
+				simulation.FailReason = "execution failed"
+			} else if tx.Nonce > currentNonce {
+				simulation.Status = transaction.TxStatusFail
+				simulation.FailReason = "higher nonce in transaction"
+			}
+
+			return simulation, nil
+		},
+	}
+	nth, _ := NewNonceTransactionHandlerV2(args)
+
+	sendTransaction := func(txData string) error {
+		tx := &transaction.FrontendTransaction{
+			Sender: testAddressAsBech32String,
+			Data:   []byte(txData),
+		}
+		err := nth.ApplyNonceAndGasPrice(context.Background(), testAddress, tx)
+		require.Nil(t, err)
+
+		_, err = nth.SendTransaction(context.Background(), tx)
+		return err
+	}
+
+	assert.Nil(t, sendTransaction("ok"))
+	err := sendTransaction("fail")
+	assert.ErrorIs(t, err, interactors.ErrTransactionSimulationFailed)
+	assert.Contains(t, err.Error(), "execution failed")
+	assert.Nil(t, sendTransaction("ok"))
+
+	// the nonce of the refused transaction is reused, all the transactions being simulated with their own nonce.
+	// The transaction ahead of the account nonce is accepted since the node can not simulate it yet
+	assert.Equal(t, []uint64{currentNonce, currentNonce + 1}, sentNonces)
+	assert.Equal(t, []uint64{currentNonce, currentNonce + 1, currentNonce + 1}, simulatedNonces)
+
+	require.Nil(t, nth.Close())
+}
+
 func createMockArgsNonceTransactionsHandlerV2() ArgsNonceTransactionsHandlerV2 {
 	return ArgsNonceTransactionsHandlerV2{
 		Proxy:            &testsCommon.ProxyStub{},
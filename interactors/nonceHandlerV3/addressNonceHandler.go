@@ -3,14 +3,17 @@ package nonceHandlerV3
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/TerraDharitri/drt-go-chain-core/core"
 	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
 	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
 
 	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/core/metrics"
 	"github.com/TerraDharitri/drt-go-sdk/interactors"
 	"github.com/TerraDharitri/drt-go-sdk/interactors/nonceHandlerV3/workers"
 )
@@ -25,31 +28,58 @@ import (
 type addressNonceHandler struct {
 	mut               sync.RWMutex
 	address           sdkCore.AddressHandler
+	addressAsBech32   string
 	proxy             interactors.Proxy
 	nonce             int64
 	gasPrice          uint64
 	transactionWorker *workers.TransactionWorker
 	cancelFunc        func()
+	poolProvider      interactors.TransactionsPoolProvider
+	sentTransactions  map[uint64]*transaction.FrontendTransaction
+	releasedNonces    map[uint64]struct{}
+	metricsHandler    sdkCore.MetricsHandler
 }
 
-// NewAddressNonceHandlerV3 returns a new instance of a addressNonceHandler
-func NewAddressNonceHandlerV3(proxy interactors.Proxy, address sdkCore.AddressHandler, intervalToSend time.Duration) (*addressNonceHandler, error) {
+// NewAddressNonceHandlerV3 returns a new instance of a addressNonceHandler. If a transactions pool provider is given,
+// the sent transactions are kept until executed, so they can be reconciled with the transactions pool. If a metrics
+// handler is given, the queued, sent and failed transactions and the current nonce are reported on it
+func NewAddressNonceHandlerV3(
+	proxy interactors.Proxy,
+	address sdkCore.AddressHandler,
+	intervalToSend time.Duration,
+	poolProvider interactors.TransactionsPoolProvider,
+	metricsHandler sdkCore.MetricsHandler,
+) (*addressNonceHandler, error) {
 	if check.IfNil(proxy) {
 		return nil, interactors.ErrNilProxy
 	}
 	if check.IfNil(address) {
 		return nil, interactors.ErrNilAddress
 	}
+	addressAsBech32, err := address.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(metricsHandler) {
+		metricsHandler = &metrics.DisabledMetricsHandler{}
+	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	anh := &addressNonceHandler{
 		mut:               sync.RWMutex{},
 		address:           address,
+		addressAsBech32:   addressAsBech32,
 		nonce:             -1,
 		proxy:             proxy,
 		transactionWorker: workers.NewTransactionWorker(ctx, proxy, intervalToSend),
 		cancelFunc:        cancelFunc,
+		releasedNonces:    make(map[uint64]struct{}),
+		metricsHandler:    metricsHandler,
+	}
+	if !check.IfNil(poolProvider) {
+		anh.poolProvider = poolProvider
+		anh.sentTransactions = make(map[uint64]*transaction.FrontendTransaction)
 	}
 
 	return anh, nil
@@ -73,10 +103,13 @@ func (anh *addressNonceHandler) ApplyNonceAndGasPrice(ctx context.Context, txs .
 // SendTransaction will save and propagate a transaction to the network
 func (anh *addressNonceHandler) SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
 	ch := anh.transactionWorker.AddTransaction(tx)
+	anh.reportQueuedTransactions()
 
 	select {
 	case response := <-ch:
 		anh.adaptNonceBasedOnResponse(response)
+		anh.storeSentTransaction(tx, response)
+		anh.reportTransactionResponse(response)
 
 		return response.TxHash, response.Error
 
@@ -85,16 +118,171 @@ func (anh *addressNonceHandler) SendTransaction(ctx context.Context, tx *transac
 	}
 }
 
+// ReleaseNonce gives back the nonce of a transaction that was not sent. If it was the last computed nonce, it will be
+// applied on the next transaction. Otherwise, it is kept aside and applied, before any new nonce, on one of the next
+// transactions, so the gap is filled without reusing the nonces of the transactions already sent
+func (anh *addressNonceHandler) ReleaseNonce(tx *transaction.FrontendTransaction) {
+	anh.mut.Lock()
+	defer anh.mut.Unlock()
+
+	if anh.nonce < 0 || tx.Nonce > uint64(anh.nonce) {
+		return
+	}
+	if tx.Nonce < uint64(anh.nonce) {
+		anh.releasedNonces[tx.Nonce] = struct{}{}
+		return
+	}
+
+	anh.nonce--
+	for anh.nonce >= 0 {
+		_, isReleased := anh.releasedNonces[uint64(anh.nonce)]
+		if !isReleased {
+			break
+		}
+
+		delete(anh.releasedNonces, uint64(anh.nonce))
+		anh.nonce--
+	}
+	anh.reportCurrentNonce()
+}
+
+func (anh *addressNonceHandler) reportQueuedTransactions() {
+	anh.metricsHandler.SetQueuedTransactions(anh.addressAsBech32, anh.transactionWorker.NumQueuedTransactions())
+}
+
+func (anh *addressNonceHandler) reportTransactionResponse(response *workers.TransactionResponse) {
+	anh.reportQueuedTransactions()
+	if response.Error != nil {
+		anh.metricsHandler.IncrementFailedTransactions(anh.addressAsBech32)
+		return
+	}
+
+	anh.metricsHandler.IncrementSentTransactions(anh.addressAsBech32)
+}
+
+// reportCurrentNonce should be called under mutex protection
+func (anh *addressNonceHandler) reportCurrentNonce() {
+	if anh.nonce >= 0 {
+		anh.metricsHandler.SetCurrentNonce(anh.addressAsBech32, uint64(anh.nonce))
+	}
+}
+
 func (anh *addressNonceHandler) adaptNonceBasedOnResponse(response *workers.TransactionResponse) {
 	anh.mut.Lock()
 	defer anh.mut.Unlock()
 
 	// if the response did contain any errors, invalidate the cached nonce.
 	if response.Error != nil {
-		anh.nonce = -1
+		anh.invalidateNonce()
 	}
 }
 
+func (anh *addressNonceHandler) storeSentTransaction(tx *transaction.FrontendTransaction, response *workers.TransactionResponse) {
+	if response.Error != nil || anh.sentTransactions == nil {
+		return
+	}
+
+	anh.mut.Lock()
+	anh.sentTransactions[tx.Nonce] = tx
+	anh.mut.Unlock()
+}
+
+// ReconcileTransactions compares the sent transactions with the transactions pool of the address. The executed
+// transactions are forgotten, the dropped ones are resent and the local nonce is moved after the transactions sent
+// by other components on behalf of the same address or already executed. Returns ErrGapNonce if the pool reports nonce
+// gaps that none of the sent transactions can fill. Does nothing if no transactions pool provider was set.
+func (anh *addressNonceHandler) ReconcileTransactions(ctx context.Context) error {
+	if check.IfNil(anh.poolProvider) {
+		return nil
+	}
+
+	// the pool is fetched before the account so that a transaction executed in between is not seen as dropped
+	poolTransactions, err := anh.poolProvider.GetTransactionsPoolForSender(ctx, anh.address)
+	if err != nil {
+		return err
+	}
+	nonceGaps, err := anh.poolProvider.GetTransactionsPoolNonceGapsForSender(ctx, anh.address)
+	if err != nil {
+		return err
+	}
+	account, err := anh.proxy.GetAccount(ctx, anh.address, api.AccountQueryOptions{})
+	if err != nil {
+		return err
+	}
+
+	poolNonces := make(map[uint64]struct{}, len(poolTransactions))
+	lastUsedNonce := int64(account.Nonce) - 1
+	for _, poolTx := range poolTransactions {
+		poolNonces[poolTx.Nonce] = struct{}{}
+		if int64(poolTx.Nonce) > lastUsedNonce {
+			lastUsedNonce = int64(poolTx.Nonce)
+		}
+	}
+
+	anh.mut.Lock()
+	droppedTransactions := make([]*transaction.FrontendTransaction, 0)
+	for nonce, tx := range anh.sentTransactions {
+		if nonce < account.Nonce {
+			delete(anh.sentTransactions, nonce)
+			continue
+		}
+		if _, found := poolNonces[nonce]; !found {
+			droppedTransactions = append(droppedTransactions, tx)
+		}
+	}
+
+	missingNonces := make([]uint64, 0)
+	for _, gap := range nonceGaps {
+		for nonce := core.MaxUint64(gap.From, account.Nonce); nonce <= gap.To; nonce++ {
+			if _, wasSent := anh.sentTransactions[nonce]; !wasSent {
+				missingNonces = append(missingNonces, nonce)
+			}
+		}
+	}
+
+	for nonce := range anh.releasedNonces {
+		_, isInPool := poolNonces[nonce]
+		if nonce < account.Nonce || isInPool {
+			delete(anh.releasedNonces, nonce)
+		}
+	}
+
+	if anh.nonce >= 0 && lastUsedNonce > anh.nonce {
+		anh.nonce = lastUsedNonce
+		anh.reportCurrentNonce()
+	}
+	anh.mut.Unlock()
+
+	err = anh.resendDroppedTransactions(ctx, droppedTransactions)
+	if err != nil {
+		return err
+	}
+	if len(missingNonces) > 0 {
+		return fmt.Errorf("%w, missing nonces: %v", interactors.ErrGapNonce, missingNonces)
+	}
+
+	return nil
+}
+
+func (anh *addressNonceHandler) resendDroppedTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) error {
+	if len(txs) == 0 {
+		return nil
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Nonce < txs[j].Nonce
+	})
+
+	hashes, err := anh.proxy.SendTransactions(ctx, txs)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("resent dropped transactions", "address", anh.addressAsBech32, "total txs", len(txs), "received hashes", len(hashes))
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (anh *addressNonceHandler) IsInterfaceNil() bool {
 	return anh == nil
@@ -132,13 +320,40 @@ func (anh *addressNonceHandler) computeNonce(ctx context.Context) (int64, error)
 	defer anh.mut.Unlock()
 
 	if anh.nonce == -1 {
-		account, err := anh.proxy.GetAccount(ctx, anh.address)
+		account, err := anh.proxy.GetAccount(ctx, anh.address, api.AccountQueryOptions{})
 		if err != nil {
 			return -1, fmt.Errorf("failed to fetch nonce: %w", err)
 		}
 		anh.nonce = int64(account.Nonce)
+	} else if releasedNonce, found := anh.popLowestReleasedNonce(); found {
+		return int64(releasedNonce), nil
 	} else {
 		anh.nonce++
 	}
+	anh.reportCurrentNonce()
+
 	return anh.nonce, nil
 }
+
+// popLowestReleasedNonce should be called under mutex protection
+func (anh *addressNonceHandler) popLowestReleasedNonce() (uint64, bool) {
+	found := false
+	lowestNonce := uint64(0)
+	for nonce := range anh.releasedNonces {
+		if !found || nonce < lowestNonce {
+			lowestNonce = nonce
+			found = true
+		}
+	}
+	if found {
+		delete(anh.releasedNonces, lowestNonce)
+	}
+
+	return lowestNonce, found
+}
+
+// invalidateNonce should be called under mutex protection
+func (anh *addressNonceHandler) invalidateNonce() {
+	anh.nonce = -1
+	anh.releasedNonces = make(map[uint64]struct{})
+}
@@ -3,6 +3,7 @@ package nonceHandlerV3
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/core/metrics"
 	"github.com/TerraDharitri/drt-go-sdk/data"
 	"github.com/TerraDharitri/drt-go-sdk/interactors"
 )
@@ -24,6 +26,18 @@ var log = logger.GetOrCreate("drt-go-sdk/interactors/nonceHandlerV3")
 type ArgsNonceTransactionsHandlerV3 struct {
 	Proxy          interactors.Proxy
 	IntervalToSend time.Duration
+	// TransactionSimulator, if set, is used to simulate all the transactions of a batch before sending any of them.
+	// The transactions whose simulation fails are not sent, nor the transactions of the same sender that follow them
+	// in the batch. The nonces of the transactions that were not sent are applied again on the next transactions.
+	// The transactions ahead of the account nonce can not be fully simulated, see interactors.CheckTransactionSimulation
+	TransactionSimulator interactors.TransactionSimulator
+	// TransactionsPoolProvider, if set, enables the reconciliation mode: the sent transactions are periodically
+	// compared with the transactions pool, so the dropped transactions are resent and the nonce gaps are reported
+	TransactionsPoolProvider interactors.TransactionsPoolProvider
+	// ReconciliationInterval is the time between two reconciliations. Used only in reconciliation mode
+	ReconciliationInterval time.Duration
+	// MetricsHandler, if set, receives the queued, sent and failed transactions and the current nonce of each address
+	MetricsHandler core.MetricsHandler
 }
 
 // nonceTransactionsHandlerV3 is the handler used for an unlimited number of addresses.
@@ -36,9 +50,13 @@ type ArgsNonceTransactionsHandlerV3 struct {
 // This struct is concurrent safe.
 type nonceTransactionsHandlerV3 struct {
 	proxy          interactors.Proxy
+	simulator      interactors.TransactionSimulator
+	poolProvider   interactors.TransactionsPoolProvider
+	metricsHandler core.MetricsHandler
 	mutHandlers    sync.RWMutex
 	handlers       map[string]interactors.AddressNonceHandlerV3
 	intervalToSend time.Duration
+	cancelFunc     func()
 }
 
 // NewNonceTransactionHandlerV3 will create a new instance of the nonceTransactionsHandlerV3. It requires a Proxy implementation
@@ -50,11 +68,31 @@ func NewNonceTransactionHandlerV3(args ArgsNonceTransactionsHandlerV3) (*nonceTr
 	if args.IntervalToSend < minimumIntervalToResend {
 		return nil, fmt.Errorf("%w for intervalToSend in NewNonceTransactionHandlerV2", interactors.ErrInvalidValue)
 	}
+	isReconciliationEnabled := !check.IfNil(args.TransactionsPoolProvider)
+	if isReconciliationEnabled && args.ReconciliationInterval < minimumIntervalToResend {
+		return nil, fmt.Errorf("%w for reconciliationInterval in NewNonceTransactionHandlerV3", interactors.ErrInvalidValue)
+	}
+
+	var metricsHandler core.MetricsHandler = &metrics.DisabledMetricsHandler{}
+	if !check.IfNil(args.MetricsHandler) {
+		metricsHandler = args.MetricsHandler
+	}
 
 	nth := &nonceTransactionsHandlerV3{
 		proxy:          args.Proxy,
+		simulator:      args.TransactionSimulator,
+		metricsHandler: metricsHandler,
 		handlers:       make(map[string]interactors.AddressNonceHandlerV3),
 		intervalToSend: args.IntervalToSend,
+		cancelFunc:     func() {},
+	}
+
+	if isReconciliationEnabled {
+		nth.poolProvider = args.TransactionsPoolProvider
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		nth.cancelFunc = cancelFunc
+		go nth.reconciliationLoop(ctx, args.ReconciliationInterval)
 	}
 
 	return nth, nil
@@ -117,7 +155,7 @@ func (nth *nonceTransactionsHandlerV3) createAddressNonceHandler(address core.Ad
 		return anh, nil
 	}
 
-	anh, err := NewAddressNonceHandlerV3(nth.proxy, address, nth.intervalToSend)
+	anh, err := NewAddressNonceHandlerV3(nth.proxy, address, nth.intervalToSend, nth.poolProvider, nth.metricsHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -141,9 +179,9 @@ func (nth *nonceTransactionsHandlerV3) filterTransactionsBySenderAddress(transac
 
 // SendTransactions will store and send the provided transaction
 func (nth *nonceTransactionsHandlerV3) SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
-	group := errgroup.Group{}
-	sentHashes := make([]string, len(txs))
-	for i, tx := range txs {
+	txsCopies := make([]*transaction.FrontendTransaction, 0, len(txs))
+	handlers := make([]interactors.AddressNonceHandlerV3, 0, len(txs))
+	for _, tx := range txs {
 		if tx == nil {
 			return nil, interactors.ErrNilTransaction
 		}
@@ -163,11 +201,30 @@ func (nth *nonceTransactionsHandlerV3) SendTransactions(ctx context.Context, txs
 			return nil, err
 		}
 
+		txsCopies = append(txsCopies, &txCopy)
+		handlers = append(handlers, anh)
+	}
+
+	errSimulate := nth.simulateTransactions(ctx, txsCopies)
+	firstRefusedNonces := computeFirstRefusedNonces(txsCopies, errSimulate)
+
+	group := errgroup.Group{}
+	sentHashes := make([]string, len(txsCopies))
+	notSentIndexes := make([]int, 0)
+	for i, txCopy := range txsCopies {
+		firstRefusedNonce, hasRefusedTransactions := firstRefusedNonces[txCopy.Sender]
+		if hasRefusedTransactions && txCopy.Nonce >= firstRefusedNonce {
+			notSentIndexes = append(notSentIndexes, i)
+			continue
+		}
+
 		idx := i
+		tx := txCopy
+		anh := handlers[i]
 		group.Go(func() error {
-			sentHash, errSend := anh.SendTransaction(ctx, &txCopy)
+			sentHash, errSend := anh.SendTransaction(ctx, tx)
 			if errSend != nil {
-				return fmt.Errorf("%w while sending transaction for address %s", errSend, addrAsBech32)
+				return fmt.Errorf("%w while sending transaction for address %s", errSend, tx.Sender)
 			}
 
 			sentHashes[idx] = sentHash
@@ -175,13 +232,131 @@ func (nth *nonceTransactionsHandlerV3) SendTransactions(ctx context.Context, txs
 		})
 	}
 
+	// the nonces are released from the highest one, so they can be given back to the address nonce handler
+	sort.Slice(notSentIndexes, func(i, j int) bool {
+		return txsCopies[notSentIndexes[i]].Nonce > txsCopies[notSentIndexes[j]].Nonce
+	})
+	for _, idx := range notSentIndexes {
+		handlers[idx].ReleaseNonce(txsCopies[idx])
+	}
+
 	err := group.Wait()
+	for _, errSimulateTx := range errSimulate {
+		if errSimulateTx != nil {
+			return sentHashes, errSimulateTx
+		}
+	}
 
 	return sentHashes, err
 }
 
+// ReleaseNonce gives back the nonces applied on the provided transactions that will not be sent, for example because
+// their signing failed. The nonces are released from the highest one so they can be reused by the next transactions
+func (nth *nonceTransactionsHandlerV3) ReleaseNonce(txs ...*transaction.FrontendTransaction) {
+	sortedTxs := make([]*transaction.FrontendTransaction, 0, len(txs))
+	for _, tx := range txs {
+		if tx != nil {
+			sortedTxs = append(sortedTxs, tx)
+		}
+	}
+	sort.Slice(sortedTxs, func(i, j int) bool {
+		return sortedTxs[i].Nonce > sortedTxs[j].Nonce
+	})
+
+	for _, tx := range sortedTxs {
+		address, err := data.NewAddressFromBech32String(tx.Sender)
+		if err != nil {
+			log.Warn("nonceTransactionsHandlerV3.ReleaseNonce", "sender", tx.Sender, "error", err)
+			continue
+		}
+
+		anh := nth.getAddressNonceHandler(address)
+		if !check.IfNil(anh) {
+			anh.ReleaseNonce(tx)
+		}
+	}
+}
+
+// simulateTransactions simulates all the transactions and returns, for each of them, the simulation error, if any
+func (nth *nonceTransactionsHandlerV3) simulateTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) []error {
+	errs := make([]error, len(txs))
+	var wg sync.WaitGroup
+	wg.Add(len(txs))
+	for i, tx := range txs {
+		go func(idx int, txToSimulate *transaction.FrontendTransaction) {
+			defer wg.Done()
+
+			err := interactors.CheckTransactionSimulation(ctx, nth.simulator, txToSimulate)
+			if err != nil {
+				nth.metricsHandler.IncrementFailedTransactions(txToSimulate.Sender)
+				errs[idx] = fmt.Errorf("%w while simulating transaction for address %s", err, txToSimulate.Sender)
+			}
+		}(i, tx)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// computeFirstRefusedNonces returns, for each sender with refused transactions, the lowest refused nonce
+func computeFirstRefusedNonces(txs []*transaction.FrontendTransaction, errSimulate []error) map[string]uint64 {
+	firstRefusedNonces := make(map[string]uint64)
+	for i, tx := range txs {
+		if errSimulate[i] == nil {
+			continue
+		}
+
+		nonce, found := firstRefusedNonces[tx.Sender]
+		if !found || tx.Nonce < nonce {
+			firstRefusedNonces[tx.Sender] = tx.Nonce
+		}
+	}
+
+	return firstRefusedNonces
+}
+
+func (nth *nonceTransactionsHandlerV3) reconciliationLoop(ctx context.Context, reconciliationInterval time.Duration) {
+	ticker := time.NewTicker(reconciliationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			nth.reconcileTransactions(ctx, reconciliationInterval)
+		case <-ctx.Done():
+			log.Debug("finishing nonceTransactionsHandlerV3.reconciliationLoop...")
+			return
+		}
+	}
+}
+
+func (nth *nonceTransactionsHandlerV3) reconcileTransactions(ctx context.Context, reconciliationInterval time.Duration) {
+	nth.mutHandlers.RLock()
+	handlers := make([]interactors.AddressNonceHandlerV3, 0, len(nth.handlers))
+	for _, anh := range nth.handlers {
+		handlers = append(handlers, anh)
+	}
+	nth.mutHandlers.RUnlock()
+
+	for _, anh := range handlers {
+		select {
+		case <-ctx.Done():
+			log.Debug("finishing nonceTransactionsHandlerV3.reconcileTransactions...")
+			return
+		default:
+		}
+
+		reconcileCtx, cancel := context.WithTimeout(ctx, reconciliationInterval)
+		err := anh.ReconcileTransactions(reconcileCtx)
+		log.LogIfError(err)
+		cancel()
+	}
+}
+
 // Close will cancel all related processes.
 func (nth *nonceTransactionsHandlerV3) Close() {
+	nth.cancelFunc()
+
 	nth.mutHandlers.RLock()
 	defer nth.mutHandlers.RUnlock()
 	for _, handler := range nth.handlers {
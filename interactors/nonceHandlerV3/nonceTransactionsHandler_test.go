@@ -15,6 +15,7 @@ import (
 
 	"github.com/TerraDharitri/drt-go-sdk/core"
 	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/interactors"
 	"github.com/TerraDharitri/drt-go-sdk/testsCommon"
 )
 
@@ -413,6 +414,386 @@ func TestDoubleCycle(t *testing.T) {
 	}
 }
 
+func TestSendTransactionsWithSimulation(t *testing.T) {
+	t.Parallel()
+
+	var getAccountCalled bool
+	args := createMockArgsNonceTransactionsHandlerV3(&getAccountCalled)
+	args.TransactionSimulator = &testsCommon.TransactionSimulatorStub{
+		SimulateTransactionCalled: func(tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulation, error) {
+			simulation := &data.TransactionSimulation{}
+			if string(tx.Data) == "fail" {
+				simulation.ReceiverShard = &transaction.SimulationResults{
+					Status:     transaction.TxStatusFail,
+					FailReason: "execution failed",
+				}
+			}
+
+			return simulation, nil
+		},
+	}
+	transactionHandler, err := NewNonceTransactionHandlerV3(args)
+	require.NoError(t, err, "failed to create transaction handler")
+
+	sendTransaction := func(txData string) ([]string, error) {
+		tx := &transaction.FrontendTransaction{
+			Sender:   testAddressAsBech32String,
+			Receiver: testAddressAsBech32String,
+			Data:     []byte(txData),
+		}
+		errApply := transactionHandler.ApplyNonceAndGasPrice(context.Background(), tx)
+		require.NoError(t, errApply, "failed to apply nonce")
+
+		return transactionHandler.SendTransactions(context.Background(), tx)
+	}
+
+	hashes, err := sendTransaction("ok")
+	require.NoError(t, err)
+	require.Equal(t, []string{"0"}, hashes)
+
+	_, err = sendTransaction("fail")
+	require.ErrorIs(t, err, interactors.ErrTransactionSimulationFailed)
+
+	// the nonce of the refused transaction is reused
+	hashes, err = sendTransaction("ok")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, hashes)
+
+	transactionHandler.Close()
+}
+
+func TestSendTransactionsWithSimulation_FailedTransactionInTheMiddleOfTheBatch(t *testing.T) {
+	t.Parallel()
+
+	mutSentNonces := sync.Mutex{}
+	sentNonces := make([]uint64, 0)
+	numGetAccountCalls := uint64(0)
+	args := createMockArgsNonceTransactionsHandlerV3(new(bool))
+	args.Proxy = &testsCommon.ProxyStub{
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			mutSentNonces.Lock()
+			sentNonces = append(sentNonces, tx.Nonce)
+			mutSentNonces.Unlock()
+
+			return strconv.FormatUint(tx.Nonce, 10), nil
+		},
+		GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+			atomic.AddUint64(&numGetAccountCalls, 1)
+			return &data.Account{Nonce: 10}, nil
+		},
+	}
+	args.TransactionSimulator = &testsCommon.TransactionSimulatorStub{
+		SimulateTransactionCalled: func(tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulation, error) {
+			simulation := &data.TransactionSimulation{}
+			if string(tx.Data) == "fail" {
+				simulation.Status = transaction.TxStatusFail
+				simulation.FailReason = "execution failed"
+			}
+
+			return simulation, nil
+		},
+	}
+	transactionHandler, err := NewNonceTransactionHandlerV3(args)
+	require.NoError(t, err, "failed to create transaction handler")
+	defer transactionHandler.Close()
+
+	createTx := func(txData string) *transaction.FrontendTransaction {
+		return &transaction.FrontendTransaction{
+			Sender:   testAddressAsBech32String,
+			Receiver: testAddressAsBech32String,
+			Data:     []byte(txData),
+		}
+	}
+
+	// nonces 10..13, the transaction with nonce 11 fails the simulation
+	txs := []*transaction.FrontendTransaction{createTx("ok"), createTx("fail"), createTx("ok"), createTx("ok")}
+	err = transactionHandler.ApplyNonceAndGasPrice(context.Background(), txs...)
+	require.NoError(t, err)
+	hashes, err := transactionHandler.SendTransactions(context.Background(), txs...)
+	require.ErrorIs(t, err, interactors.ErrTransactionSimulationFailed)
+	require.Equal(t, []string{"10", "", "", ""}, hashes)
+
+	// the transactions following the refused one were not sent, so their nonces are applied again
+	nextTxs := []*transaction.FrontendTransaction{createTx("ok"), createTx("ok"), createTx("ok")}
+	err = transactionHandler.ApplyNonceAndGasPrice(context.Background(), nextTxs...)
+	require.NoError(t, err)
+	hashes, err = transactionHandler.SendTransactions(context.Background(), nextTxs...)
+	require.NoError(t, err)
+	require.Equal(t, []string{"11", "12", "13"}, hashes)
+
+	sort.Slice(sentNonces, func(i, j int) bool {
+		return sentNonces[i] < sentNonces[j]
+	})
+	require.Equal(t, []uint64{10, 11, 12, 13}, sentNonces)
+	// the account is fetched only once, to compute the first nonce, and not for each simulation
+	require.Equal(t, uint64(1), atomic.LoadUint64(&numGetAccountCalls))
+}
+
+func TestAddressNonceHandler_ReleaseNonce(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String(testAddressAsBech32String)
+	anh, err := NewAddressNonceHandlerV3(createMockArgsNonceTransactionsHandlerV3(new(bool)).Proxy, address, time.Millisecond, nil, nil)
+	require.NoError(t, err)
+	defer anh.Close()
+
+	applyNonce := func() *transaction.FrontendTransaction {
+		tx := &transaction.FrontendTransaction{}
+		errApply := anh.ApplyNonceAndGasPrice(context.Background(), tx)
+		require.NoError(t, errApply)
+
+		return tx
+	}
+
+	txs := []*transaction.FrontendTransaction{applyNonce(), applyNonce(), applyNonce()}
+
+	// the nonce before the last one is applied again before any new nonce
+	anh.ReleaseNonce(txs[1])
+	require.Equal(t, uint64(1), applyNonce().Nonce)
+	require.Equal(t, uint64(3), applyNonce().Nonce)
+
+	// releasing the last nonce gives back the released nonces below it as well
+	anh.ReleaseNonce(txs[0])
+	anh.ReleaseNonce(&transaction.FrontendTransaction{Nonce: 3})
+	anh.ReleaseNonce(txs[2])
+	anh.ReleaseNonce(&transaction.FrontendTransaction{Nonce: 1})
+	require.Equal(t, uint64(0), applyNonce().Nonce)
+	require.Equal(t, uint64(1), applyNonce().Nonce)
+}
+
+func TestNonceTransactionsHandlerV3_ReleaseNonce(t *testing.T) {
+	t.Parallel()
+
+	transactionHandler, err := NewNonceTransactionHandlerV3(createMockArgsNonceTransactionsHandlerV3(new(bool)))
+	require.NoError(t, err)
+	defer transactionHandler.Close()
+
+	txs := make([]*transaction.FrontendTransaction, 0, 3)
+	for i := 0; i < 3; i++ {
+		txs = append(txs, &transaction.FrontendTransaction{Sender: testAddressAsBech32String})
+	}
+	err = transactionHandler.ApplyNonceAndGasPrice(context.Background(), txs...)
+	require.NoError(t, err)
+
+	// the nonces are given back whatever the order they are provided in, unknown senders being ignored
+	transactionHandler.ReleaseNonce(txs[1], nil, txs[2], &transaction.FrontendTransaction{Sender: "invalid"})
+
+	tx := &transaction.FrontendTransaction{Sender: testAddressAsBech32String}
+	err = transactionHandler.ApplyNonceAndGasPrice(context.Background(), tx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), tx.Nonce)
+}
+
+func TestNewNonceTransactionHandlerV3_InvalidReconciliationInterval(t *testing.T) {
+	t.Parallel()
+
+	var getAccountCalled bool
+	args := createMockArgsNonceTransactionsHandlerV3(&getAccountCalled)
+	args.TransactionsPoolProvider = &testsCommon.TransactionsPoolProviderStub{}
+	nth, err := NewNonceTransactionHandlerV3(args)
+	require.Nil(t, nth)
+	require.ErrorIs(t, err, interactors.ErrInvalidValue)
+}
+
+func TestSendTransactionsWithReconciliation(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(10)
+	resentNonces := make([]uint64, 0)
+
+	args := createMockArgsNonceTransactionsHandlerV3(new(bool))
+	args.Proxy = &testsCommon.ProxyStub{
+		GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Nonce: atomic.LoadUint64(&accountNonce)}, nil
+		},
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			return strconv.FormatUint(tx.Nonce, 10), nil
+		},
+		SendTransactionsCalled: func(txs []*transaction.FrontendTransaction) ([]string, error) {
+			for _, tx := range txs {
+				resentNonces = append(resentNonces, tx.Nonce)
+			}
+
+			return make([]string, len(txs)), nil
+		},
+	}
+
+	// nonce 10 was executed, nonce 11 was dropped, nonce 12 is still in pool and nonce 15 was sent by someone else
+	args.TransactionsPoolProvider = &testsCommon.TransactionsPoolProviderStub{
+		GetTransactionsPoolForSenderCalled: func(address core.AddressHandler) ([]*data.PoolTransaction, error) {
+			return []*data.PoolTransaction{{Nonce: 12}, {Nonce: 15}}, nil
+		},
+		GetTransactionsPoolNonceGapsForSenderCalled: func(address core.AddressHandler) ([]data.NonceGap, error) {
+			return []data.NonceGap{{From: 11, To: 11}, {From: 13, To: 14}}, nil
+		},
+	}
+	args.ReconciliationInterval = time.Hour
+	nth, err := NewNonceTransactionHandlerV3(args)
+	require.Nil(t, err)
+	defer nth.Close()
+
+	txs := make([]*transaction.FrontendTransaction, 0, 3)
+	for i := 0; i < 3; i++ {
+		txs = append(txs, &transaction.FrontendTransaction{
+			Sender:   testAddressAsBech32String,
+			Receiver: testAddressAsBech32String,
+		})
+	}
+	err = nth.ApplyNonceAndGasPrice(context.Background(), txs...)
+	require.Nil(t, err)
+	_, err = nth.SendTransactions(context.Background(), txs...)
+	require.Nil(t, err)
+
+	atomic.StoreUint64(&accountNonce, 11)
+	address, _ := data.NewAddressFromBech32String(testAddressAsBech32String)
+	anh, _ := nth.getOrCreateAddressNonceHandler(address)
+	err = anh.ReconcileTransactions(context.Background())
+	require.ErrorIs(t, err, interactors.ErrGapNonce)
+	require.Contains(t, err.Error(), "[13 14]")
+	require.Equal(t, []uint64{11}, resentNonces)
+
+	// the next transaction is placed after the one sent by someone else
+	tx := &transaction.FrontendTransaction{Sender: testAddressAsBech32String}
+	err = nth.ApplyNonceAndGasPrice(context.Background(), tx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(16), tx.Nonce)
+}
+
+func TestSendTransactionsWithReconciliation_TransactionsExecutedMeanwhile(t *testing.T) {
+	t.Parallel()
+
+	accountNonce := uint64(0)
+	accountNonceAfterPoolFetch := uint64(0)
+	poolTransactions := make([]*data.PoolTransaction, 0)
+	resentNonces := make([]uint64, 0)
+
+	args := createMockArgsNonceTransactionsHandlerV3(new(bool))
+	args.Proxy = &testsCommon.ProxyStub{
+		GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+			return &data.Account{Nonce: atomic.LoadUint64(&accountNonce)}, nil
+		},
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			return strconv.FormatUint(tx.Nonce, 10), nil
+		},
+		SendTransactionsCalled: func(txs []*transaction.FrontendTransaction) ([]string, error) {
+			for _, tx := range txs {
+				resentNonces = append(resentNonces, tx.Nonce)
+			}
+
+			return make([]string, len(txs)), nil
+		},
+	}
+	args.TransactionsPoolProvider = &testsCommon.TransactionsPoolProviderStub{
+		GetTransactionsPoolForSenderCalled: func(address core.AddressHandler) ([]*data.PoolTransaction, error) {
+			// the account nonce moves right after the pool was fetched, as if transactions were executed meanwhile
+			atomic.StoreUint64(&accountNonce, accountNonceAfterPoolFetch)
+
+			return poolTransactions, nil
+		},
+	}
+	args.ReconciliationInterval = time.Hour
+	nth, err := NewNonceTransactionHandlerV3(args)
+	require.Nil(t, err)
+	defer nth.Close()
+
+	txs := []*transaction.FrontendTransaction{
+		{Sender: testAddressAsBech32String},
+		{Sender: testAddressAsBech32String},
+	}
+	err = nth.ApplyNonceAndGasPrice(context.Background(), txs...)
+	require.Nil(t, err)
+	_, err = nth.SendTransactions(context.Background(), txs...)
+	require.Nil(t, err)
+
+	poolTransactions = []*data.PoolTransaction{{Nonce: 0}, {Nonce: 1}}
+	accountNonceAfterPoolFetch = 2
+	address, _ := data.NewAddressFromBech32String(testAddressAsBech32String)
+	anh, _ := nth.getOrCreateAddressNonceHandler(address)
+	err = anh.ReconcileTransactions(context.Background())
+	require.Nil(t, err)
+	require.Empty(t, resentNonces)
+
+	// the pool is empty, but the account is ahead of the local nonce because of transactions sent by someone else
+	poolTransactions = make([]*data.PoolTransaction, 0)
+	accountNonceAfterPoolFetch = 7
+	err = anh.ReconcileTransactions(context.Background())
+	require.Nil(t, err)
+	require.Empty(t, resentNonces)
+
+	tx := &transaction.FrontendTransaction{Sender: testAddressAsBech32String}
+	err = nth.ApplyNonceAndGasPrice(context.Background(), tx)
+	require.Nil(t, err)
+	require.Equal(t, uint64(7), tx.Nonce)
+}
+
+func TestSendTransactionsWithMetrics(t *testing.T) {
+	t.Parallel()
+
+	mut := sync.Mutex{}
+	numSent := 0
+	numFailed := 0
+	currentNonce := uint64(0)
+	numQueued := -1
+
+	args := createMockArgsNonceTransactionsHandlerV3(new(bool))
+	args.Proxy.(*testsCommon.ProxyStub).SendTransactionCalled = func(tx *transaction.FrontendTransaction) (string, error) {
+		if string(tx.Data) == "fail" {
+			return "", errors.New("expected error")
+		}
+
+		return strconv.FormatUint(tx.Nonce, 10), nil
+	}
+	args.MetricsHandler = &testsCommon.MetricsHandlerStub{
+		SetQueuedTransactionsCalled: func(address string, numTransactions int) {
+			mut.Lock()
+			require.Equal(t, testAddressAsBech32String, address)
+			numQueued = numTransactions
+			mut.Unlock()
+		},
+		IncrementSentTransactionsCalled: func(address string) {
+			mut.Lock()
+			numSent++
+			mut.Unlock()
+		},
+		IncrementFailedTransactionsCalled: func(address string) {
+			mut.Lock()
+			numFailed++
+			mut.Unlock()
+		},
+		SetCurrentNonceCalled: func(address string, nonce uint64) {
+			mut.Lock()
+			currentNonce = nonce
+			mut.Unlock()
+		},
+	}
+	nth, err := NewNonceTransactionHandlerV3(args)
+	require.Nil(t, err)
+	defer nth.Close()
+
+	txs := []*transaction.FrontendTransaction{
+		{Sender: testAddressAsBech32String, Data: []byte("ok")},
+		{Sender: testAddressAsBech32String, Data: []byte("ok")},
+		{Sender: testAddressAsBech32String, Data: []byte("fail")},
+	}
+	err = nth.ApplyNonceAndGasPrice(context.Background(), txs...)
+	require.Nil(t, err)
+
+	mut.Lock()
+	require.Equal(t, uint64(2), currentNonce)
+	mut.Unlock()
+
+	for _, tx := range txs {
+		_, _ = nth.SendTransactions(context.Background(), tx)
+	}
+
+	mut.Lock()
+	defer mut.Unlock()
+
+	require.Equal(t, 2, numSent)
+	require.Equal(t, 1, numFailed)
+	require.Equal(t, 0, numQueued)
+}
+
 func createMockArgsNonceTransactionsHandlerV3(getAccountCalled *bool) ArgsNonceTransactionsHandlerV3 {
 	return ArgsNonceTransactionsHandlerV3{
 		Proxy: &testsCommon.ProxyStub{
@@ -117,6 +117,14 @@ func (tw *TransactionWorker) AddTransaction(transaction *transaction.FrontendTra
 	return r
 }
 
+// NumQueuedTransactions returns the number of transactions waiting in the priority queue
+func (tw *TransactionWorker) NumQueuedTransactions() int {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	return tw.tq.Len()
+}
+
 // start will spawn a goroutine tasked with iterating all the transactions inside the priority queue. The priority is
 // given by the nonce, meaning that transaction with lower nonce will be sent first.
 // All these transactions are send with an interval between them.
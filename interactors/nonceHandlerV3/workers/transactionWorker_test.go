@@ -74,3 +74,22 @@ func TestTransactionWorker_AddTransactionWithLowerNonceAfter(t *testing.T) {
 	wg.Wait()
 	require.Equal(t, &TransactionResponse{TxHash: strconv.FormatUint(nonces[2], 10), Error: nil}, <-r3)
 }
+
+func TestTransactionWorker_NumQueuedTransactions(t *testing.T) {
+	t.Parallel()
+	proxy := &testsCommon.ProxyStub{
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			return strconv.FormatUint(tx.Nonce, 10), nil
+		},
+	}
+
+	w := NewTransactionWorker(context.Background(), proxy, time.Hour)
+	require.Equal(t, 0, w.NumQueuedTransactions())
+
+	_ = w.AddTransaction(&transaction.FrontendTransaction{Nonce: 1})
+	_ = w.AddTransaction(&transaction.FrontendTransaction{Nonce: 2})
+	require.Equal(t, 2, w.NumQueuedTransactions())
+
+	w.processNextTransaction(context.Background())
+	require.Equal(t, 1, w.NumQueuedTransactions())
+}
@@ -0,0 +1,44 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+)
+
+// higherNonceFailReason is the reason reported by the node when the nonce of the simulated transaction is higher than
+// the sender's account nonce
+const higherNonceFailReason = "higher nonce in transaction"
+
+// CheckTransactionSimulation simulates the provided transaction and returns ErrTransactionSimulationFailed if the
+// simulation did not succeed. Nothing is simulated if the simulator is nil. The signature is not checked.
+// The transaction is simulated with its own nonce, which is checked by the node against the sender's account nonce:
+// a lower nonce fails the simulation, while a higher nonce means that the transactions preceding it are not executed
+// yet. Since the node can not simulate it before them, such a transaction is accepted without further checks.
+func CheckTransactionSimulation(
+	ctx context.Context,
+	simulator TransactionSimulator,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(simulator) {
+		return nil
+	}
+	if tx == nil {
+		return ErrNilTransaction
+	}
+
+	simulation, err := simulator.SimulateTransaction(ctx, tx, false)
+	if err != nil {
+		return err
+	}
+
+	failReason := simulation.GetFailReason()
+	if len(failReason) == 0 || strings.Contains(failReason, higherNonceFailReason) {
+		return nil
+	}
+
+	return fmt.Errorf("%w, nonce: %d, reason: %s", ErrTransactionSimulationFailed, tx.Nonce, failReason)
+}
@@ -40,6 +40,58 @@ func (des *deserializer) CreatePrimitiveDataType(obj interface{}, buff []byte) e
 	return nil
 }
 
+//DecodeTopEncoded deserializes a top encoded value, as found in a smart contract's storage or in the return data of a
+//VM query. Top encoded numbers are stored without leading zeros and empty buffers stand for zero values, while
+//structs are decoded field by field
+func (des *deserializer) DecodeTopEncoded(obj interface{}, buff []byte) error {
+	reflectedValue, err := des.getReflectedValue(obj)
+	if err != nil {
+		return err
+	}
+
+	if reflectedValue.Kind() == reflect.Struct && reflectedValue.Type() != reflect.TypeOf(big.Int{}) {
+		_, err = des.CreateStruct(reflectedValue, buff)
+		return err
+	}
+
+	size, isFixedSize := fixedSizeOf(reflectedValue.Kind())
+	if !isFixedSize {
+		return des.CreatePrimitiveDataType(reflectedValue, buff)
+	}
+	if len(buff) > size {
+		return errors.New("buffer too large for the provided type")
+	}
+
+	padded := make([]byte, size-len(buff), size)
+	if isSignedKind(reflectedValue.Kind()) && len(buff) > 0 && buff[0]&0x80 != 0 {
+		for idx := range padded {
+			padded[idx] = 0xff
+		}
+	}
+	padded = append(padded, buff...)
+
+	return des.CreatePrimitiveDataType(reflectedValue, padded)
+}
+
+func fixedSizeOf(kind reflect.Kind) (int, bool) {
+	switch kind {
+	case reflect.Bool, reflect.Int8, reflect.Uint8:
+		return 1, true
+	case reflect.Int16, reflect.Uint16:
+		return uint16Size, true
+	case reflect.Int32, reflect.Uint32:
+		return uint32Size, true
+	case reflect.Int64, reflect.Uint64:
+		return uint64Size, true
+	default:
+		return 0, false
+	}
+}
+
+func isSignedKind(kind reflect.Kind) bool {
+	return kind == reflect.Int8 || kind == reflect.Int16 || kind == reflect.Int32 || kind == reflect.Int64
+}
+
 //CreateStruct deserialize the buffer and populate the fields of the received object
 func (des *deserializer) CreateStruct(obj interface{}, buff []byte) (uint64, error) {
 	buffer := NewSourceBuffer(buff)
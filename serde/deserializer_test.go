@@ -104,3 +104,68 @@ func Test_deserializer_CreatePrimitiveDataType(t *testing.T) {
 
 	assert.EqualValues(t, expected, bigInt)
 }
+
+func TestDeserializer_DecodeTopEncoded(t *testing.T) {
+	t.Parallel()
+
+	ds := NewDeserializer()
+
+	t.Run("unsigned numbers without leading zeros", func(t *testing.T) {
+		t.Parallel()
+
+		var u64 uint64
+		assert.Nil(t, ds.DecodeTopEncoded(&u64, []byte{0x01, 0x00}))
+		assert.Equal(t, uint64(256), u64)
+
+		var u32 uint32
+		assert.Nil(t, ds.DecodeTopEncoded(&u32, nil))
+		assert.Equal(t, uint32(0), u32)
+
+		var u8 uint8
+		assert.NotNil(t, ds.DecodeTopEncoded(&u8, []byte{0x01, 0x00}))
+	})
+	t.Run("signed numbers should be sign extended", func(t *testing.T) {
+		t.Parallel()
+
+		var i64 int64
+		assert.Nil(t, ds.DecodeTopEncoded(&i64, []byte{0xff}))
+		assert.Equal(t, int64(-1), i64)
+
+		var i32 int32
+		assert.Nil(t, ds.DecodeTopEncoded(&i32, []byte{0x7f}))
+		assert.Equal(t, int32(127), i32)
+	})
+	t.Run("bool", func(t *testing.T) {
+		t.Parallel()
+
+		value := true
+		assert.Nil(t, ds.DecodeTopEncoded(&value, nil))
+		assert.False(t, value)
+
+		assert.Nil(t, ds.DecodeTopEncoded(&value, []byte{0x01}))
+		assert.True(t, value)
+	})
+	t.Run("big integers and strings take the whole buffer", func(t *testing.T) {
+		t.Parallel()
+
+		value := big.Int{}
+		assert.Nil(t, ds.DecodeTopEncoded(&value, []byte{0x01, 0x00, 0x00}))
+		assert.Equal(t, big.NewInt(65536), &value)
+
+		str := ""
+		assert.Nil(t, ds.DecodeTopEncoded(&str, []byte("WREWA-abcdef")))
+		assert.Equal(t, "WREWA-abcdef", str)
+	})
+	t.Run("structs are decoded field by field", func(t *testing.T) {
+		t.Parallel()
+
+		type pair struct {
+			First  uint32
+			Second string
+		}
+
+		value := pair{}
+		assert.Nil(t, ds.DecodeTopEncoded(&value, []byte{0, 0, 0, 7, 0, 0, 0, 2, 'o', 'k'}))
+		assert.Equal(t, pair{First: 7, Second: "ok"}, value)
+	})
+}
@@ -4,4 +4,5 @@ package serde
 type Deserializer interface {
 	CreateStruct(obj interface{}, buff []byte) (uint64, error)
 	CreatePrimitiveDataType(obj interface{}, buff []byte) error
+	DecodeTopEncoded(obj interface{}, buff []byte) error
 }
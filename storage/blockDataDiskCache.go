@@ -0,0 +1,240 @@
+package storage
+
+import (
+	"container/list"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	blockDataFileExtension   = ".blk"
+	blockDataFilePermissions = 0644
+	blockDataDirPermissions  = 0755
+)
+
+// ArgsBlockDataDiskCache is the DTO used in the block data disk cache constructor
+type ArgsBlockDataDiskCache struct {
+	Directory      string
+	MaxSizeInBytes uint64
+}
+
+type diskCacheEntry struct {
+	key  string
+	size uint64
+}
+
+// blockDataDiskCache is a size bounded cache that keeps each value in its own file, so the cached data survives
+// restarts. When the maximum size is reached, the least recently used entries are evicted.
+type blockDataDiskCache struct {
+	mut            sync.Mutex
+	directory      string
+	maxSizeInBytes uint64
+	sizeInBytes    uint64
+	recency        *list.List
+	entries        map[string]*list.Element
+}
+
+// NewBlockDataDiskCache creates a persistent cache in the provided directory. The entries already present in the
+// directory are loaded and will be evicted first, in the order of their last access.
+func NewBlockDataDiskCache(args ArgsBlockDataDiskCache) (*blockDataDiskCache, error) {
+	if len(args.Directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if args.MaxSizeInBytes == 0 {
+		return nil, fmt.Errorf("%w, provided: %d", ErrInvalidMaxSize, args.MaxSizeInBytes)
+	}
+
+	err := os.MkdirAll(args.Directory, blockDataDirPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &blockDataDiskCache{
+		directory:      args.Directory,
+		maxSizeInBytes: args.MaxSizeInBytes,
+		recency:        list.New(),
+		entries:        make(map[string]*list.Element),
+	}
+
+	err = cache.loadEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+func (cache *blockDataDiskCache) loadEntries() error {
+	dirEntries, err := os.ReadDir(cache.directory)
+	if err != nil {
+		return err
+	}
+
+	type fileEntry struct {
+		key     string
+		size    uint64
+		modTime time.Time
+	}
+
+	files := make([]fileEntry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		name := dirEntry.Name()
+		if dirEntry.IsDir() || !strings.HasSuffix(name, blockDataFileExtension) {
+			continue
+		}
+
+		key, errDecode := hex.DecodeString(strings.TrimSuffix(name, blockDataFileExtension))
+		if errDecode != nil {
+			continue
+		}
+
+		info, errInfo := dirEntry.Info()
+		if errInfo != nil {
+			return errInfo
+		}
+
+		files = append(files, fileEntry{
+			key:     string(key),
+			size:    uint64(info.Size()),
+			modTime: info.ModTime(),
+		})
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].modTime.After(files[j].modTime)
+	})
+
+	for _, file := range files {
+		cache.entries[file.key] = cache.recency.PushBack(&diskCacheEntry{
+			key:  file.key,
+			size: file.size,
+		})
+		cache.sizeInBytes += file.size
+	}
+	cache.evict()
+
+	return nil
+}
+
+// Get returns the bytes stored for the provided key
+func (cache *blockDataDiskCache) Get(key []byte) (interface{}, bool) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	element, found := cache.entries[string(key)]
+	if !found {
+		return nil, false
+	}
+
+	filePath := cache.filePath(key)
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		log.Debug("blockDataDiskCache.Get: can not read the cached data", "file", filePath, "error", err)
+		cache.remove(element)
+		return nil, false
+	}
+
+	cache.recency.MoveToFront(element)
+	now := time.Now()
+	_ = os.Chtimes(filePath, now, now)
+
+	return buff, true
+}
+
+// Put stores the provided value, which must be a byte slice, and returns true if other entries were evicted to
+// make room for it
+func (cache *blockDataDiskCache) Put(key []byte, value interface{}, _ int) (evicted bool) {
+	buff, ok := value.([]byte)
+	if !ok || uint64(len(buff)) > cache.maxSizeInBytes {
+		return false
+	}
+
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	err := cache.writeFile(key, buff)
+	if err != nil {
+		log.Debug("blockDataDiskCache.Put: can not write the cached data", "key", hex.EncodeToString(key), "error", err)
+		return false
+	}
+
+	element, found := cache.entries[string(key)]
+	if found {
+		entry := element.Value.(*diskCacheEntry)
+		cache.sizeInBytes -= entry.size
+		entry.size = uint64(len(buff))
+		cache.recency.MoveToFront(element)
+	} else {
+		cache.entries[string(key)] = cache.recency.PushFront(&diskCacheEntry{
+			key:  string(key),
+			size: uint64(len(buff)),
+		})
+	}
+	cache.sizeInBytes += uint64(len(buff))
+
+	return cache.evict()
+}
+
+func (cache *blockDataDiskCache) writeFile(key []byte, buff []byte) error {
+	filePath := cache.filePath(key)
+	tempFilePath := filePath + ".tmp"
+	err := os.WriteFile(tempFilePath, buff, blockDataFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, filePath)
+}
+
+func (cache *blockDataDiskCache) evict() bool {
+	evicted := false
+	for cache.sizeInBytes > cache.maxSizeInBytes {
+		cache.remove(cache.recency.Back())
+		evicted = true
+	}
+
+	return evicted
+}
+
+func (cache *blockDataDiskCache) remove(element *list.Element) {
+	entry := element.Value.(*diskCacheEntry)
+	cache.recency.Remove(element)
+	delete(cache.entries, entry.key)
+	cache.sizeInBytes -= entry.size
+
+	err := os.Remove(cache.filePath([]byte(entry.key)))
+	if err != nil && !os.IsNotExist(err) {
+		log.Debug("blockDataDiskCache: can not remove the cached data", "key", hex.EncodeToString([]byte(entry.key)), "error", err)
+	}
+}
+
+func (cache *blockDataDiskCache) filePath(key []byte) string {
+	return filepath.Join(cache.directory, hex.EncodeToString(key)+blockDataFileExtension)
+}
+
+// Len returns the number of cached entries
+func (cache *blockDataDiskCache) Len() int {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	return len(cache.entries)
+}
+
+// SizeInBytesContained returns the size in bytes of all the cached entries
+func (cache *blockDataDiskCache) SizeInBytesContained() uint64 {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	return cache.sizeInBytes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *blockDataDiskCache) IsInterfaceNil() bool {
+	return cache == nil
+}
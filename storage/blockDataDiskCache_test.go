@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBlockDataDiskCache(t *testing.T) ArgsBlockDataDiskCache {
+	return ArgsBlockDataDiskCache{
+		Directory:      filepath.Join(t.TempDir(), "blocks"),
+		MaxSizeInBytes: 10,
+	}
+}
+
+func TestNewBlockDataDiskCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockDataDiskCache(t)
+		args.Directory = ""
+		cache, err := NewBlockDataDiskCache(args)
+		assert.True(t, check.IfNil(cache))
+		assert.Equal(t, ErrEmptyDirectory, err)
+	})
+	t.Run("invalid max size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockDataDiskCache(t)
+		args.MaxSizeInBytes = 0
+		cache, err := NewBlockDataDiskCache(args)
+		assert.True(t, check.IfNil(cache))
+		assert.True(t, errors.Is(err, ErrInvalidMaxSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := NewBlockDataDiskCache(createMockArgsBlockDataDiskCache(t))
+		assert.False(t, check.IfNil(cache))
+		assert.Nil(t, err)
+		assert.Equal(t, 0, cache.Len())
+	})
+}
+
+func TestBlockDataDiskCache_PutGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should store and return the values", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := NewBlockDataDiskCache(createMockArgsBlockDataDiskCache(t))
+
+		evicted := cache.Put([]byte("key1"), []byte("abc"), 3)
+		assert.False(t, evicted)
+		evicted = cache.Put([]byte("key2"), []byte("defg"), 4)
+		assert.False(t, evicted)
+
+		value, found := cache.Get([]byte("key1"))
+		assert.True(t, found)
+		assert.Equal(t, []byte("abc"), value)
+
+		value, found = cache.Get([]byte("missing"))
+		assert.False(t, found)
+		assert.Nil(t, value)
+
+		assert.Equal(t, 2, cache.Len())
+		assert.Equal(t, uint64(7), cache.SizeInBytesContained())
+	})
+	t.Run("overwriting a key should update the size", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := NewBlockDataDiskCache(createMockArgsBlockDataDiskCache(t))
+
+		_ = cache.Put([]byte("key1"), []byte("abc"), 3)
+		_ = cache.Put([]byte("key1"), []byte("abcdef"), 6)
+
+		value, _ := cache.Get([]byte("key1"))
+		assert.Equal(t, []byte("abcdef"), value)
+		assert.Equal(t, 1, cache.Len())
+		assert.Equal(t, uint64(6), cache.SizeInBytesContained())
+	})
+	t.Run("non byte slice values and values larger than the cache should not be stored", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := NewBlockDataDiskCache(createMockArgsBlockDataDiskCache(t))
+
+		evicted := cache.Put([]byte("key1"), "abc", 3)
+		assert.False(t, evicted)
+		evicted = cache.Put([]byte("key2"), []byte("0123456789a"), 11)
+		assert.False(t, evicted)
+		assert.Equal(t, 0, cache.Len())
+	})
+	t.Run("should evict the least recently used entries", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := NewBlockDataDiskCache(createMockArgsBlockDataDiskCache(t))
+
+		_ = cache.Put([]byte("key1"), []byte("abc"), 3)
+		_ = cache.Put([]byte("key2"), []byte("def"), 3)
+		_ = cache.Put([]byte("key3"), []byte("ghi"), 3)
+		_, _ = cache.Get([]byte("key1"))
+
+		evicted := cache.Put([]byte("key4"), []byte("jk"), 2)
+		assert.True(t, evicted)
+
+		_, found := cache.Get([]byte("key2"))
+		assert.False(t, found)
+		_, found = cache.Get([]byte("key1"))
+		assert.True(t, found)
+		assert.Equal(t, 3, cache.Len())
+		assert.Equal(t, uint64(8), cache.SizeInBytesContained())
+	})
+	t.Run("removed file should be treated as missing", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockDataDiskCache(t)
+		cache, _ := NewBlockDataDiskCache(args)
+		_ = cache.Put([]byte("key1"), []byte("abc"), 3)
+
+		require.Nil(t, os.Remove(cache.filePath([]byte("key1"))))
+
+		_, found := cache.Get([]byte("key1"))
+		assert.False(t, found)
+		assert.Equal(t, 0, cache.Len())
+		assert.Equal(t, uint64(0), cache.SizeInBytesContained())
+	})
+}
+
+func TestBlockDataDiskCache_ShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBlockDataDiskCache(t)
+	cache, _ := NewBlockDataDiskCache(args)
+	_ = cache.Put([]byte("key1"), []byte("abc"), 3)
+	_ = cache.Put([]byte("key2"), []byte("def"), 3)
+	_ = cache.Put([]byte("key3"), []byte("ghi"), 3)
+
+	past := time.Now().Add(-time.Hour)
+	require.Nil(t, os.Chtimes(cache.filePath([]byte("key2")), past, past))
+
+	reopenedCache, err := NewBlockDataDiskCache(args)
+	require.Nil(t, err)
+	assert.Equal(t, 3, reopenedCache.Len())
+	assert.Equal(t, uint64(9), reopenedCache.SizeInBytesContained())
+
+	value, found := reopenedCache.Get([]byte("key3"))
+	assert.True(t, found)
+	assert.Equal(t, []byte("ghi"), value)
+
+	args.MaxSizeInBytes = 6
+	smallerCache, err := NewBlockDataDiskCache(args)
+	require.Nil(t, err)
+	assert.Equal(t, 2, smallerCache.Len())
+	_, found = smallerCache.Get([]byte("key2"))
+	assert.False(t, found, "the least recently accessed entry should have been evicted")
+}
@@ -0,0 +1,12 @@
+package storage
+
+import "errors"
+
+// ErrEmptyFilePath signals that an empty file path was provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrEmptyDirectory signals that an empty directory path was provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidMaxSize signals that an invalid maximum size was provided
+var ErrInvalidMaxSize = errors.New("invalid maximum size")
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-sdk/core"
+)
+
+const logsCursorFilePermissions = 0644
+
+// logsCursorFileStorer persists a logs cursor as a JSON file
+type logsCursorFileStorer struct {
+	mut      sync.Mutex
+	filePath string
+}
+
+// NewLogsCursorFileStorer creates a logs cursor storer that keeps the cursor in the provided file. The file is
+// replaced atomically on each save, so a crash while saving will not corrupt the previously saved cursor.
+func NewLogsCursorFileStorer(filePath string) (*logsCursorFileStorer, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	return &logsCursorFileStorer{
+		filePath: filePath,
+	}, nil
+}
+
+// Load returns the saved cursor or nil if no cursor was saved yet
+func (storer *logsCursorFileStorer) Load() (*core.LogsCursor, error) {
+	storer.mut.Lock()
+	defer storer.mut.Unlock()
+
+	buff, err := os.ReadFile(storer.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := &core.LogsCursor{}
+	err = json.Unmarshal(buff, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
+
+// Save writes the provided cursor in the file
+func (storer *logsCursorFileStorer) Save(cursor core.LogsCursor) error {
+	storer.mut.Lock()
+	defer storer.mut.Unlock()
+
+	buff, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := os.CreateTemp(filepath.Dir(storer.filePath), filepath.Base(storer.filePath)+".tmp")
+	if err != nil {
+		return err
+	}
+	tempFilePath := tempFile.Name()
+
+	_, err = tempFile.Write(buff)
+	if err == nil {
+		err = tempFile.Sync()
+	}
+	errClose := tempFile.Close()
+	if err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = os.Chmod(tempFilePath, logsCursorFilePermissions)
+	}
+	if err != nil {
+		_ = os.Remove(tempFilePath)
+		return err
+	}
+
+	return os.Rename(tempFilePath, storer.filePath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *logsCursorFileStorer) IsInterfaceNil() bool {
+	return storer == nil
+}
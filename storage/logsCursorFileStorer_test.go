@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogsCursorFileStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewLogsCursorFileStorer("")
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewLogsCursorFileStorer(filepath.Join(t.TempDir(), "cursor.json"))
+		assert.False(t, check.IfNil(storer))
+		assert.Nil(t, err)
+	})
+}
+
+func TestLogsCursorFileStorer_LoadSave(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should return nil cursor", func(t *testing.T) {
+		t.Parallel()
+
+		storer, _ := NewLogsCursorFileStorer(filepath.Join(t.TempDir(), "cursor.json"))
+		cursor, err := storer.Load()
+		assert.Nil(t, err)
+		assert.Nil(t, cursor)
+	})
+	t.Run("corrupted file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "cursor.json")
+		require.Nil(t, os.WriteFile(filePath, []byte("not a json"), 0644))
+
+		storer, _ := NewLogsCursorFileStorer(filePath)
+		cursor, err := storer.Load()
+		assert.NotNil(t, err)
+		assert.Nil(t, cursor)
+	})
+	t.Run("should load the last saved cursor", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		filePath := filepath.Join(dir, "cursor.json")
+		storer, _ := NewLogsCursorFileStorer(filePath)
+
+		require.Nil(t, storer.Save(core.LogsCursor{ShardID: 1, Nonce: 10}))
+		require.Nil(t, storer.Save(core.LogsCursor{ShardID: 1, Nonce: 11}))
+
+		cursor, err := storer.Load()
+		assert.Nil(t, err)
+		assert.Equal(t, &core.LogsCursor{ShardID: 1, Nonce: 11}, cursor)
+
+		otherStorer, _ := NewLogsCursorFileStorer(filePath)
+		cursor, err = otherStorer.Load()
+		assert.Nil(t, err)
+		assert.Equal(t, &core.LogsCursor{ShardID: 1, Nonce: 11}, cursor)
+
+		entries, err := os.ReadDir(dir)
+		require.Nil(t, err)
+		assert.Equal(t, 1, len(entries))
+	})
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+// ArgumentsEncoderStub -
+type ArgumentsEncoderStub struct {
+	EncodeArgumentsCalled func(function string, values ...interface{}) ([][]byte, error)
+}
+
+// EncodeArguments -
+func (stub *ArgumentsEncoderStub) EncodeArguments(function string, values ...interface{}) ([][]byte, error) {
+	if stub.EncodeArgumentsCalled != nil {
+		return stub.EncodeArgumentsCalled(function, values...)
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *ArgumentsEncoderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
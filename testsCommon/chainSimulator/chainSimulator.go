@@ -0,0 +1,548 @@
+package chainSimulator
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
+	"github.com/TerraDharitri/drt-go-chain-core/data/block"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/blake2b"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon/fakeProxy"
+)
+
+const millisecondsInSecond = 1000
+
+var (
+	log          = logger.GetOrCreate("drt-go-sdk/testsCommon/chainSimulator")
+	blocksHasher = blake2b.NewBlake2b()
+)
+
+// ArgsChainSimulator is the DTO used in the chain simulator constructor
+type ArgsChainSimulator struct {
+	// State holds the network config and the initial accounts. Defaults to an empty state
+	State *fakeProxy.State
+	// BlockInterval is the time between two automatically generated blocks. 0 disables the automatic generation, so
+	// the blocks are only generated by calling GenerateBlocks
+	BlockInterval time.Duration
+}
+
+type pendingTransaction struct {
+	hash string
+	tx   *transaction.FrontendTransaction
+}
+
+type chainSimulator struct {
+	state      *fakeProxy.State
+	server     proxyServer
+	cancelFunc func()
+
+	mut                sync.Mutex
+	pendingTxs         map[string][]*pendingTransaction
+	nonce              uint64
+	lastHyperBlockHash string
+	lastBlockHashes    map[uint32]string
+}
+
+// NewChainSimulator creates a deterministic in-memory chain served through a fake proxy. The signed transactions
+// sent to it are checked and kept in a pool until the next block generation, when the move balance and the
+// fungible DCDT transfers are applied on the accounts and the shard blocks and the hyperblock are produced.
+// The simulator should be closed after use.
+func NewChainSimulator(args ArgsChainSimulator) *chainSimulator {
+	state := args.State
+	if state == nil {
+		state = fakeProxy.NewState()
+	}
+
+	simulator := &chainSimulator{
+		state:           state,
+		pendingTxs:      make(map[string][]*pendingTransaction),
+		lastBlockHashes: make(map[uint32]string),
+		cancelFunc:      func() {},
+	}
+	simulator.server = fakeProxy.NewFakeProxy(fakeProxy.ArgsFakeProxy{
+		State:               state,
+		TransactionsHandler: simulator,
+	})
+
+	if args.BlockInterval > 0 {
+		var ctx context.Context
+		ctx, simulator.cancelFunc = context.WithCancel(context.Background())
+		go simulator.generateBlocksLoop(ctx, args.BlockInterval)
+	}
+
+	return simulator
+}
+
+func (simulator *chainSimulator) generateBlocksLoop(ctx context.Context, blockInterval time.Duration) {
+	ticker := time.NewTicker(blockInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := simulator.GenerateBlocks(1)
+			log.LogIfError(err)
+		case <-ctx.Done():
+			log.Debug("terminating chainSimulator.generateBlocksLoop...")
+			return
+		}
+	}
+}
+
+// URL returns the URL of the fake proxy serving the simulated chain
+func (simulator *chainSimulator) URL() string {
+	return simulator.server.URL()
+}
+
+// State returns the state of the simulated chain
+func (simulator *chainSimulator) State() *fakeProxy.State {
+	return simulator.state
+}
+
+// ProcessTransaction checks the transaction and adds it in the pool. A transaction from the pool with the same
+// sender and nonce is replaced. Returns the hex encoded hash of the transaction
+func (simulator *chainSimulator) ProcessTransaction(tx *transaction.FrontendTransaction) (string, error) {
+	networkConfig := simulator.state.NetworkConfig()
+	err := checkTransaction(tx, networkConfig)
+	if err != nil {
+		return "", err
+	}
+
+	simulator.mut.Lock()
+	defer simulator.mut.Unlock()
+
+	account := simulator.state.Account(tx.Sender)
+	if tx.Nonce < account.Nonce {
+		return "", fmt.Errorf("%w, provided: %d, account nonce: %d", ErrLowerNonceInTransaction, tx.Nonce, account.Nonce)
+	}
+	value, _ := parseValue(tx.Value)
+	maxCost := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasPrice), big.NewInt(0).SetUint64(tx.GasLimit))
+	maxCost.Add(maxCost, value)
+	balance, _ := big.NewInt(0).SetString(account.Balance, 10)
+	if balance == nil || balance.Cmp(maxCost) < 0 {
+		return "", fmt.Errorf("%w, balance: %s, needed: %s", ErrInsufficientFunds, account.Balance, maxCost.String())
+	}
+
+	txHash, err := builders.ComputeTransactionHash(tx)
+	if err != nil {
+		return "", err
+	}
+	txOnNetwork, err := fakeProxy.CreateTransactionOnNetwork(tx, networkConfig.NumShardsWithoutMeta)
+	if err != nil {
+		return "", err
+	}
+	txOnNetwork.Hash = hex.EncodeToString(txHash)
+	txOnNetwork.Status = string(transaction.TxStatusPending)
+	err = simulator.state.AddTransaction(txOnNetwork)
+	if err != nil {
+		return "", err
+	}
+
+	txCopy := *tx
+	simulator.addPendingTransaction(&pendingTransaction{
+		hash: txOnNetwork.Hash,
+		tx:   &txCopy,
+	})
+
+	return txOnNetwork.Hash, nil
+}
+
+// addPendingTransaction should be called under mutex protection
+func (simulator *chainSimulator) addPendingTransaction(pendingTx *pendingTransaction) {
+	pendingTxs := simulator.pendingTxs[pendingTx.tx.Sender]
+	for i, existing := range pendingTxs {
+		if existing.tx.Nonce == pendingTx.tx.Nonce {
+			_ = simulator.state.SetTransactionStatus(existing.hash, string(transaction.TxStatusInvalid))
+			pendingTxs[i] = pendingTx
+			return
+		}
+	}
+
+	simulator.pendingTxs[pendingTx.tx.Sender] = append(pendingTxs, pendingTx)
+}
+
+// NumPendingTransactions returns the number of transactions waiting in the pool
+func (simulator *chainSimulator) NumPendingTransactions() int {
+	simulator.mut.Lock()
+	defer simulator.mut.Unlock()
+
+	numPendingTxs := 0
+	for _, pendingTxs := range simulator.pendingTxs {
+		numPendingTxs += len(pendingTxs)
+	}
+
+	return numPendingTxs
+}
+
+// CurrentNonce returns the nonce of the last generated hyperblock
+func (simulator *chainSimulator) CurrentNonce() uint64 {
+	simulator.mut.Lock()
+	defer simulator.mut.Unlock()
+
+	return simulator.nonce
+}
+
+// GenerateBlocks generates the provided number of rounds. In each round, the executable transactions from the pool
+// are applied, then a block for each shard, including the metachain, and the hyperblock are produced
+func (simulator *chainSimulator) GenerateBlocks(numBlocks int) error {
+	if numBlocks <= 0 {
+		return fmt.Errorf("%w, provided: %d", ErrInvalidNumBlocks, numBlocks)
+	}
+
+	simulator.mut.Lock()
+	defer simulator.mut.Unlock()
+
+	for i := 0; i < numBlocks; i++ {
+		err := simulator.generateBlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generateBlock should be called under mutex protection
+func (simulator *chainSimulator) generateBlock() error {
+	networkConfig := simulator.state.NetworkConfig()
+	simulator.nonce++
+	round := simulator.nonce
+	epoch := uint64(0)
+	if networkConfig.RoundsPerEpoch > 0 {
+		epoch = round / uint64(networkConfig.RoundsPerEpoch)
+	}
+	timestamp := uint64(networkConfig.StartTime) + round*uint64(networkConfig.RoundDuration)/millisecondsInSecond
+
+	executedTxs := simulator.executePendingTransactions(networkConfig)
+
+	hyperBlock := &data.HyperBlock{
+		Nonce:         simulator.nonce,
+		Round:         round,
+		Hash:          computeBlockHash(core.MetachainShardId, simulator.nonce),
+		PrevBlockHash: simulator.lastHyperBlockHash,
+		Epoch:         epoch,
+		NumTxs:        uint64(len(executedTxs)),
+		Timestamp:     timestamp,
+		Transactions:  make([]data.TransactionOnNetwork, 0, len(executedTxs)),
+	}
+
+	shardIDs := make([]uint32, 0, networkConfig.NumShardsWithoutMeta+1)
+	for shardID := uint32(0); shardID < networkConfig.NumShardsWithoutMeta; shardID++ {
+		shardIDs = append(shardIDs, shardID)
+	}
+	shardIDs = append(shardIDs, core.MetachainShardId)
+
+	for _, shardID := range shardIDs {
+		apiBlock := &api.Block{
+			Nonce:         simulator.nonce,
+			Round:         round,
+			Epoch:         uint32(epoch),
+			Shard:         shardID,
+			Hash:          computeBlockHash(shardID, simulator.nonce),
+			PrevBlockHash: simulator.lastBlockHashes[shardID],
+			Timestamp:     time.Duration(timestamp),
+		}
+
+		miniBlock := &api.MiniBlock{
+			Hash:             computeMiniBlockHash(shardID, simulator.nonce),
+			Type:             block.TxBlock.String(),
+			SourceShard:      shardID,
+			DestinationShard: shardID,
+		}
+		for _, tx := range executedTxs {
+			if tx.SourceShard != shardID {
+				continue
+			}
+
+			tx.BlockNonce = apiBlock.Nonce
+			tx.BlockHash = apiBlock.Hash
+			tx.MiniblockHash = miniBlock.Hash
+			tx.MiniblockType = miniBlock.Type
+			tx.Timestamp = timestamp
+			tx.HyperBlockNonce = hyperBlock.Nonce
+			tx.HyperBlockHash = hyperBlock.Hash
+			tx.NotarizedAtSourceInMetaNonce = hyperBlock.Nonce
+			tx.NotarizedAtSourceInMetaHash = hyperBlock.Hash
+			tx.NotarizedAtDestinationInMetaNonce = hyperBlock.Nonce
+			tx.NotarizedAtDestinationInMetaHash = hyperBlock.Hash
+
+			miniBlock.Transactions = append(miniBlock.Transactions, createApiTransactionResult(tx, round, uint32(epoch)))
+		}
+		if len(miniBlock.Transactions) > 0 {
+			apiBlock.NumTxs = uint32(len(miniBlock.Transactions))
+			apiBlock.MiniBlocks = []*api.MiniBlock{miniBlock}
+		}
+
+		err := simulator.state.AddBlock(apiBlock)
+		if err != nil {
+			return err
+		}
+		simulator.lastBlockHashes[shardID] = apiBlock.Hash
+
+		err = simulator.state.SetNetworkStatus(shardID, &data.NetworkStatus{
+			CurrentRound:         round,
+			EpochNumber:          epoch,
+			Nonce:                simulator.nonce,
+			HighestNonce:         simulator.nonce,
+			ProbableHighestNonce: simulator.nonce,
+			RoundsPerEpoch:       uint64(networkConfig.RoundsPerEpoch),
+		})
+		if err != nil {
+			return err
+		}
+
+		if shardID != core.MetachainShardId {
+			hyperBlock.ShardBlocks = append(hyperBlock.ShardBlocks, struct {
+				Hash  string `json:"hash"`
+				Nonce uint64 `json:"nonce"`
+				Shard uint32 `json:"shard"`
+			}{
+				Hash:  apiBlock.Hash,
+				Nonce: apiBlock.Nonce,
+				Shard: shardID,
+			})
+		}
+	}
+
+	for _, tx := range executedTxs {
+		err := simulator.state.AddTransaction(tx)
+		if err != nil {
+			return err
+		}
+
+		hyperBlock.Transactions = append(hyperBlock.Transactions, *tx)
+	}
+
+	simulator.lastHyperBlockHash = hyperBlock.Hash
+
+	log.Debug("chain simulator generated block", "nonce", hyperBlock.Nonce, "hash", hyperBlock.Hash, "num txs", len(executedTxs))
+
+	return simulator.state.AddHyperBlock(hyperBlock)
+}
+
+// executePendingTransactions applies, for each sender, the pooled transactions with consecutive nonces starting from
+// the account nonce. The transactions with higher nonces remain in the pool. Should be called under mutex protection
+func (simulator *chainSimulator) executePendingTransactions(networkConfig *data.NetworkConfig) []*data.TransactionOnNetwork {
+	senders := make([]string, 0, len(simulator.pendingTxs))
+	for sender := range simulator.pendingTxs {
+		senders = append(senders, sender)
+	}
+	sort.Strings(senders)
+
+	executedTxs := make([]*data.TransactionOnNetwork, 0)
+	for _, sender := range senders {
+		pendingTxs := simulator.pendingTxs[sender]
+		sort.Slice(pendingTxs, func(i, j int) bool {
+			return pendingTxs[i].tx.Nonce < pendingTxs[j].tx.Nonce
+		})
+
+		remainingTxs := make([]*pendingTransaction, 0, len(pendingTxs))
+		for _, pendingTx := range pendingTxs {
+			accountNonce := simulator.state.Account(sender).Nonce
+			if pendingTx.tx.Nonce > accountNonce {
+				remainingTxs = append(remainingTxs, pendingTx)
+				continue
+			}
+			if pendingTx.tx.Nonce < accountNonce {
+				_ = simulator.state.SetTransactionStatus(pendingTx.hash, string(transaction.TxStatusInvalid))
+				continue
+			}
+
+			executedTxs = append(executedTxs, simulator.executeTransaction(pendingTx, networkConfig))
+		}
+
+		if len(remainingTxs) == 0 {
+			delete(simulator.pendingTxs, sender)
+			continue
+		}
+		simulator.pendingTxs[sender] = remainingTxs
+	}
+
+	return executedTxs
+}
+
+// executeTransaction applies the move balance and the DCDT transfer on the accounts. A transaction that can not be
+// applied fails, consuming the sender nonce and the fee
+func (simulator *chainSimulator) executeTransaction(
+	pendingTx *pendingTransaction,
+	networkConfig *data.NetworkConfig,
+) *data.TransactionOnNetwork {
+	tx := pendingTx.tx
+	txOnNetwork, found := simulator.state.Transaction(pendingTx.hash)
+	if !found {
+		txOnNetwork, _ = fakeProxy.CreateTransactionOnNetwork(tx, networkConfig.NumShardsWithoutMeta)
+		txOnNetwork.Hash = pendingTx.hash
+	}
+
+	gasUsed := computeGasUsed(tx, networkConfig)
+	fee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(gasUsed), big.NewInt(0).SetUint64(tx.GasPrice))
+	initiallyPaidFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(tx.GasLimit), big.NewInt(0).SetUint64(tx.GasPrice))
+	txOnNetwork.GasUsed = gasUsed
+	txOnNetwork.Fee = fee.String()
+	txOnNetwork.InitiallyPaidFee = initiallyPaidFee.String()
+
+	sender := simulator.state.Account(tx.Sender)
+	senderBalance, _ := big.NewInt(0).SetString(sender.Balance, 10)
+	if senderBalance == nil {
+		senderBalance = big.NewInt(0)
+	}
+	sender.Nonce++
+
+	value, _ := parseValue(tx.Value)
+	transfer, isDCDTTransfer, _ := parseDCDTTransfer(tx.Data)
+	err := simulator.checkFunds(tx, senderBalance, value, fee, transfer)
+	if err != nil {
+		log.Debug("chain simulator transaction failed", "hash", pendingTx.hash, "error", err)
+
+		senderBalance.Sub(senderBalance, minBigInt(fee, senderBalance))
+		sender.Balance = senderBalance.String()
+		_ = simulator.state.SetAccount(sender)
+		txOnNetwork.Status = string(transaction.TxStatusFail)
+
+		return txOnNetwork
+	}
+
+	senderBalance.Sub(senderBalance, fee)
+	senderBalance.Sub(senderBalance, value)
+	sender.Balance = senderBalance.String()
+	_ = simulator.state.SetAccount(sender)
+
+	receiver := simulator.state.Account(tx.Receiver)
+	receiverBalance, _ := big.NewInt(0).SetString(receiver.Balance, 10)
+	if receiverBalance == nil {
+		receiverBalance = big.NewInt(0)
+	}
+	receiverBalance.Add(receiverBalance, value)
+	receiver.Balance = receiverBalance.String()
+	_ = simulator.state.SetAccount(receiver)
+
+	if isDCDTTransfer {
+		simulator.applyDCDTTransfer(tx, transfer)
+		txOnNetwork.Logs = createDCDTTransferLogs(tx, transfer)
+	}
+	txOnNetwork.Status = string(transaction.TxStatusSuccess)
+
+	return txOnNetwork
+}
+
+func (simulator *chainSimulator) checkFunds(
+	tx *transaction.FrontendTransaction,
+	senderBalance *big.Int,
+	value *big.Int,
+	fee *big.Int,
+	transfer *dcdtTransfer,
+) error {
+	cost := big.NewInt(0).Add(value, fee)
+	if senderBalance.Cmp(cost) < 0 {
+		return fmt.Errorf("%w, balance: %s, needed: %s", ErrInsufficientFunds, senderBalance.String(), cost.String())
+	}
+	if transfer == nil {
+		return nil
+	}
+
+	tokenBalance := simulator.state.DCDTBalance(tx.Sender, transfer.tokenIdentifier)
+	if tokenBalance.Cmp(transfer.amount) < 0 {
+		return fmt.Errorf("%w for %s, balance: %s, needed: %s",
+			ErrInsufficientFunds, transfer.tokenIdentifier, tokenBalance.String(), transfer.amount.String())
+	}
+
+	return nil
+}
+
+func (simulator *chainSimulator) applyDCDTTransfer(tx *transaction.FrontendTransaction, transfer *dcdtTransfer) {
+	senderTokenBalance := simulator.state.DCDTBalance(tx.Sender, transfer.tokenIdentifier)
+	_ = simulator.state.SetDCDTBalance(tx.Sender, transfer.tokenIdentifier, senderTokenBalance.Sub(senderTokenBalance, transfer.amount))
+
+	receiverTokenBalance := simulator.state.DCDTBalance(tx.Receiver, transfer.tokenIdentifier)
+	_ = simulator.state.SetDCDTBalance(tx.Receiver, transfer.tokenIdentifier, receiverTokenBalance.Add(receiverTokenBalance, transfer.amount))
+}
+
+func createDCDTTransferLogs(tx *transaction.FrontendTransaction, transfer *dcdtTransfer) *transaction.ApiLogs {
+	receiver, _ := data.NewAddressFromBech32String(tx.Receiver)
+
+	return &transaction.ApiLogs{
+		Address: tx.Sender,
+		Events: []*transaction.Events{
+			{
+				Address:    tx.Sender,
+				Identifier: core.BuiltInFunctionDCDTTransfer,
+				Topics: [][]byte{
+					[]byte(transfer.tokenIdentifier),
+					{},
+					transfer.amount.Bytes(),
+					receiver.AddressBytes(),
+				},
+			},
+		},
+	}
+}
+
+func createApiTransactionResult(tx *data.TransactionOnNetwork, round uint64, epoch uint32) *transaction.ApiTransactionResult {
+	return &transaction.ApiTransactionResult{
+		Type:             tx.Type,
+		Hash:             tx.Hash,
+		Nonce:            tx.Nonce,
+		Round:            round,
+		Epoch:            epoch,
+		Value:            tx.Value,
+		Receiver:         tx.Receiver,
+		Sender:           tx.Sender,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		GasUsed:          tx.GasUsed,
+		Data:             tx.Data,
+		Signature:        tx.Signature,
+		SourceShard:      tx.SourceShard,
+		DestinationShard: tx.DestinationShard,
+		BlockNonce:       tx.BlockNonce,
+		BlockHash:        tx.BlockHash,
+		MiniBlockType:    tx.MiniblockType,
+		MiniBlockHash:    tx.MiniblockHash,
+		HyperblockNonce:  tx.HyperBlockNonce,
+		HyperblockHash:   tx.HyperBlockHash,
+		Timestamp:        int64(tx.Timestamp),
+		Logs:             tx.Logs,
+		Status:           transaction.TxStatus(tx.Status),
+		InitiallyPaidFee: tx.InitiallyPaidFee,
+		Fee:              tx.Fee,
+	}
+}
+
+func computeBlockHash(shardID uint32, nonce uint64) string {
+	return hex.EncodeToString(blocksHasher.Compute(fmt.Sprintf("block/%d/%d", shardID, nonce)))
+}
+
+func computeMiniBlockHash(shardID uint32, nonce uint64) string {
+	return hex.EncodeToString(blocksHasher.Compute(fmt.Sprintf("miniblock/%d/%d", shardID, nonce)))
+}
+
+func minBigInt(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) < 0 {
+		return a
+	}
+
+	return b
+}
+
+// Close stops the automatic block generation and the fake proxy
+func (simulator *chainSimulator) Close() error {
+	simulator.cancelFunc()
+	simulator.server.Close()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (simulator *chainSimulator) IsInterfaceNil() bool {
+	return simulator == nil
+}
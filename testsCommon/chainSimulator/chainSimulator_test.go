@@ -0,0 +1,324 @@
+package chainSimulator
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/cryptoProvider"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/testsCommon/fakeProxy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	senderSecretKey   = "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c4"
+	receiverSecretKey = "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68"
+	testTokenID       = "USDC-a1b2c3"
+)
+
+var initialBalance = big.NewInt(1000000000000000000)
+
+func createCryptoHolder(t *testing.T, secretKey string) sdkCore.CryptoComponentsHolder {
+	skBytes, err := hex.DecodeString(secretKey)
+	require.Nil(t, err)
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, skBytes)
+	require.Nil(t, err)
+
+	return holder
+}
+
+func createSimulatorWithAccounts(t *testing.T, holders ...sdkCore.CryptoComponentsHolder) *chainSimulator {
+	state := fakeProxy.NewState()
+	for _, holder := range holders {
+		err := state.SetAccount(&data.Account{
+			Address: holder.GetBech32(),
+			Balance: initialBalance.String(),
+		})
+		require.Nil(t, err)
+	}
+
+	simulator := NewChainSimulator(ArgsChainSimulator{State: state})
+	t.Cleanup(func() {
+		_ = simulator.Close()
+	})
+
+	return simulator
+}
+
+func createSignedTransaction(
+	t *testing.T,
+	simulator *chainSimulator,
+	holder sdkCore.CryptoComponentsHolder,
+	receiver string,
+	nonce uint64,
+	value string,
+	txData []byte,
+) *transaction.FrontendTransaction {
+	networkConfig := simulator.State().NetworkConfig()
+	tx := &transaction.FrontendTransaction{
+		Nonce:    nonce,
+		Value:    value,
+		Receiver: receiver,
+		GasPrice: networkConfig.MinGasPrice,
+		GasLimit: networkConfig.MinGasLimit + uint64(len(txData))*networkConfig.GasPerDataByte,
+		Data:     txData,
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+	}
+
+	txBuilder, _ := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	err := txBuilder.ApplyUserSignature(holder, tx)
+	require.Nil(t, err)
+
+	return tx
+}
+
+func createArgsProxy(simulator *chainSimulator) blockchain.ArgsProxy {
+	return blockchain.ArgsProxy{
+		ProxyURL:            simulator.URL(),
+		AllowedDeltaToFinal: 1,
+		CacheExpirationTime: time.Minute,
+		EntityType:          sdkCore.Proxy,
+	}
+}
+
+func TestChainSimulator_ProcessTransaction(t *testing.T) {
+	t.Parallel()
+
+	sender := createCryptoHolder(t, senderSecretKey)
+	receiver := createCryptoHolder(t, receiverSecretKey)
+
+	t.Run("invalid transactions should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorWithAccounts(t, sender)
+
+		_, err := simulator.ProcessTransaction(nil)
+		assert.Equal(t, ErrNilTransaction, err)
+
+		tx := createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1", nil)
+		tx.ChainID = "other"
+		_, err = simulator.ProcessTransaction(tx)
+		assert.True(t, errors.Is(err, ErrInvalidChainID))
+
+		tx = createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1", nil)
+		tx.GasPrice--
+		_, err = simulator.ProcessTransaction(tx)
+		assert.True(t, errors.Is(err, ErrInsufficientGasPrice))
+
+		tx = createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1", []byte("data"))
+		tx.GasLimit--
+		_, err = simulator.ProcessTransaction(tx)
+		assert.True(t, errors.Is(err, ErrInsufficientGasLimit))
+
+		tx = createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1", nil)
+		tx.Value = "2"
+		_, err = simulator.ProcessTransaction(tx)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+
+		tx = createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, initialBalance.String(), nil)
+		_, err = simulator.ProcessTransaction(tx)
+		assert.True(t, errors.Is(err, ErrInsufficientFunds))
+
+		tx = createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1", []byte("DCDTTransfer@zz@01"))
+		_, err = simulator.ProcessTransaction(tx)
+		assert.True(t, errors.Is(err, ErrInvalidDCDTTransfer))
+
+		assert.Equal(t, 0, simulator.NumPendingTransactions())
+	})
+	t.Run("lower nonce should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorWithAccounts(t, sender)
+		_ = simulator.State().SetAccount(&data.Account{Address: sender.GetBech32(), Nonce: 5, Balance: initialBalance.String()})
+
+		tx := createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 4, "1", nil)
+		_, err := simulator.ProcessTransaction(tx)
+		assert.True(t, errors.Is(err, ErrLowerNonceInTransaction))
+	})
+	t.Run("valid transaction should be pending", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorWithAccounts(t, sender)
+
+		tx := createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1", nil)
+		txHash, err := simulator.ProcessTransaction(tx)
+		require.Nil(t, err)
+
+		expectedHash, _ := builders.ComputeTransactionHash(tx)
+		assert.Equal(t, hex.EncodeToString(expectedHash), txHash)
+		txOnNetwork, found := simulator.State().Transaction(txHash)
+		require.True(t, found)
+		assert.Equal(t, string(transaction.TxStatusPending), txOnNetwork.Status)
+		assert.Equal(t, 1, simulator.NumPendingTransactions())
+	})
+	t.Run("same nonce should replace the pending transaction", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorWithAccounts(t, sender)
+
+		firstHash, err := simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1", nil))
+		require.Nil(t, err)
+		_, err = simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "2", nil))
+		require.Nil(t, err)
+
+		assert.Equal(t, 1, simulator.NumPendingTransactions())
+		txOnNetwork, _ := simulator.State().Transaction(firstHash)
+		assert.Equal(t, string(transaction.TxStatusInvalid), txOnNetwork.Status)
+	})
+}
+
+func TestChainSimulator_GenerateBlocks(t *testing.T) {
+	t.Parallel()
+
+	sender := createCryptoHolder(t, senderSecretKey)
+	receiver := createCryptoHolder(t, receiverSecretKey)
+
+	t.Run("invalid number of blocks should error", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorWithAccounts(t)
+		err := simulator.GenerateBlocks(0)
+		assert.True(t, errors.Is(err, ErrInvalidNumBlocks))
+	})
+	t.Run("move balance should be applied", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorWithAccounts(t, sender)
+		networkConfig := simulator.State().NetworkConfig()
+
+		hash0, err := simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1000", nil))
+		require.Nil(t, err)
+		hash1, err := simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 1, "2000", []byte("hello")))
+		require.Nil(t, err)
+		// nonce gap, should wait for the transaction with nonce 2
+		hash3, err := simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 3, "1", nil))
+		require.Nil(t, err)
+
+		err = simulator.GenerateBlocks(1)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), simulator.CurrentNonce())
+		assert.Equal(t, 1, simulator.NumPendingTransactions())
+
+		fee0 := networkConfig.MinGasPrice * networkConfig.MinGasLimit
+		fee1 := networkConfig.MinGasPrice * (networkConfig.MinGasLimit + 5*networkConfig.GasPerDataByte)
+		expectedBalance := big.NewInt(0).Sub(initialBalance, big.NewInt(int64(3000+fee0+fee1)))
+		senderAccount := simulator.State().Account(sender.GetBech32())
+		assert.Equal(t, uint64(2), senderAccount.Nonce)
+		assert.Equal(t, expectedBalance.String(), senderAccount.Balance)
+		assert.Equal(t, "3000", simulator.State().Account(receiver.GetBech32()).Balance)
+
+		hyperBlock, found := simulator.State().HyperBlockByNonce(1)
+		require.True(t, found)
+		require.Len(t, hyperBlock.Transactions, 2)
+		assert.Equal(t, hash0, hyperBlock.Transactions[0].Hash)
+		assert.Equal(t, hash1, hyperBlock.Transactions[1].Hash)
+		assert.Len(t, hyperBlock.ShardBlocks, int(networkConfig.NumShardsWithoutMeta))
+
+		tx, _ := simulator.State().Transaction(hash1)
+		assert.Equal(t, string(transaction.TxStatusSuccess), tx.Status)
+		assert.Equal(t, fmt.Sprintf("%d", fee1), tx.Fee)
+		assert.Equal(t, hyperBlock.Hash, tx.HyperBlockHash)
+		shardBlock, found := simulator.State().BlockByHash(tx.SourceShard, tx.BlockHash)
+		require.True(t, found)
+		require.Len(t, shardBlock.MiniBlocks, 1)
+		assert.Len(t, shardBlock.MiniBlocks[0].Transactions, 2)
+
+		tx, _ = simulator.State().Transaction(hash3)
+		assert.Equal(t, string(transaction.TxStatusPending), tx.Status)
+
+		_, err = simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 2, "1", nil))
+		require.Nil(t, err)
+		err = simulator.GenerateBlocks(2)
+		require.Nil(t, err)
+		assert.Equal(t, 0, simulator.NumPendingTransactions())
+		assert.Equal(t, uint64(4), simulator.State().Account(sender.GetBech32()).Nonce)
+		hyperBlock, _ = simulator.State().HyperBlockByNonce(3)
+		assert.Equal(t, uint64(0), hyperBlock.NumTxs)
+		assert.Equal(t, uint64(3), simulator.State().NetworkStatus(core.MetachainShardId).Nonce)
+	})
+	t.Run("DCDT transfer should be applied", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := createSimulatorWithAccounts(t, sender)
+		_ = simulator.State().SetDCDTBalance(sender.GetBech32(), testTokenID, big.NewInt(100))
+
+		txData := []byte("DCDTTransfer@" + hex.EncodeToString([]byte(testTokenID)) + "@28")
+		txHash, err := simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "0", txData))
+		require.Nil(t, err)
+		failedTxHash, err := simulator.ProcessTransaction(createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 1, "0", txData))
+		require.Nil(t, err)
+		_ = simulator.State().SetDCDTBalance(sender.GetBech32(), testTokenID, big.NewInt(50))
+
+		err = simulator.GenerateBlocks(1)
+		require.Nil(t, err)
+
+		assert.Equal(t, big.NewInt(10), simulator.State().DCDTBalance(sender.GetBech32(), testTokenID))
+		assert.Equal(t, big.NewInt(40), simulator.State().DCDTBalance(receiver.GetBech32(), testTokenID))
+
+		tx, _ := simulator.State().Transaction(txHash)
+		assert.Equal(t, string(transaction.TxStatusSuccess), tx.Status)
+		require.NotNil(t, tx.Logs)
+		assert.Equal(t, core.BuiltInFunctionDCDTTransfer, tx.Logs.Events[0].Identifier)
+		assert.Equal(t, []byte(testTokenID), tx.Logs.Events[0].Topics[0])
+
+		tx, _ = simulator.State().Transaction(failedTxHash)
+		assert.Equal(t, string(transaction.TxStatusFail), tx.Status)
+		assert.Equal(t, uint64(2), simulator.State().Account(sender.GetBech32()).Nonce)
+	})
+	t.Run("blocks should be generated on timer", func(t *testing.T) {
+		t.Parallel()
+
+		simulator := NewChainSimulator(ArgsChainSimulator{BlockInterval: time.Millisecond * 10})
+		defer func() {
+			_ = simulator.Close()
+		}()
+
+		assert.Eventually(t, func() bool {
+			return simulator.CurrentNonce() >= 3
+		}, time.Second*5, time.Millisecond*10)
+	})
+}
+
+func TestChainSimulator_WithProxy(t *testing.T) {
+	t.Parallel()
+
+	sender := createCryptoHolder(t, senderSecretKey)
+	receiver := createCryptoHolder(t, receiverSecretKey)
+	simulator := createSimulatorWithAccounts(t, sender)
+	proxy, err := blockchain.NewProxy(createArgsProxy(simulator))
+	require.Nil(t, err)
+
+	txHash, err := proxy.SendTransaction(context.Background(), createSignedTransaction(t, simulator, sender, receiver.GetBech32(), 0, "1000", nil))
+	require.Nil(t, err)
+	status, err := proxy.GetTransactionStatus(context.Background(), txHash)
+	require.Nil(t, err)
+	assert.Equal(t, string(transaction.TxStatusPending), status)
+
+	err = simulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	status, err = proxy.GetTransactionStatus(context.Background(), txHash)
+	require.Nil(t, err)
+	assert.Equal(t, string(transaction.TxStatusSuccess), status)
+
+	latestNonce, err := proxy.GetLatestHyperBlockNonce(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), latestNonce)
+
+	account, err := proxy.GetAccount(context.Background(), receiver.GetAddressHandler(), api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "1000", account.Balance)
+}
@@ -0,0 +1,36 @@
+package chainSimulator
+
+import "errors"
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrInvalidChainID signals that the transaction has a different chain ID than the network
+var ErrInvalidChainID = errors.New("invalid chain ID")
+
+// ErrInvalidTransactionVersion signals that the transaction version is lower than the network's minimum
+var ErrInvalidTransactionVersion = errors.New("invalid transaction version")
+
+// ErrInsufficientGasPrice signals that the transaction gas price is lower than the network's minimum
+var ErrInsufficientGasPrice = errors.New("insufficient gas price")
+
+// ErrInsufficientGasLimit signals that the transaction gas limit does not cover the gas needed to process it
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit")
+
+// ErrInvalidValue signals that the transaction value is not a positive integer
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrLowerNonceInTransaction signals that the transaction nonce was already used by the sender
+var ErrLowerNonceInTransaction = errors.New("lower nonce in transaction")
+
+// ErrInsufficientFunds signals that the sender does not have enough funds for the transaction
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrInvalidDCDTTransfer signals that the DCDT transfer data field is malformed
+var ErrInvalidDCDTTransfer = errors.New("invalid DCDT transfer")
+
+// ErrInvalidSignature signals that a transaction signature could not be verified
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrInvalidNumBlocks signals that an invalid number of blocks was provided
+var ErrInvalidNumBlocks = errors.New("invalid number of blocks")
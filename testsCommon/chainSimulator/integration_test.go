@@ -0,0 +1,157 @@
+package chainSimulator
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/cryptoProvider"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/examples/examplesFlowWalletTracker/mock"
+	"github.com/TerraDharitri/drt-go-sdk/interactors"
+	"github.com/TerraDharitri/drt-go-sdk/interactors/nonceHandlerV3"
+	"github.com/TerraDharitri/drt-go-sdk/workflows"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainSimulator_NonceTransactionsHandlerV3(t *testing.T) {
+	t.Parallel()
+
+	sender := createCryptoHolder(t, senderSecretKey)
+	receiver := createCryptoHolder(t, receiverSecretKey)
+	simulator := createSimulatorWithAccounts(t, sender)
+	proxy, err := blockchain.NewProxy(createArgsProxy(simulator))
+	require.Nil(t, err)
+
+	nonceHandler, err := nonceHandlerV3.NewNonceTransactionHandlerV3(nonceHandlerV3.ArgsNonceTransactionsHandlerV3{
+		Proxy:          proxy,
+		IntervalToSend: time.Millisecond * 10,
+	})
+	require.Nil(t, err)
+	defer nonceHandler.Close()
+
+	networkConfig := simulator.State().NetworkConfig()
+	txBuilder, _ := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	numTransactions := 5
+	txs := make([]*transaction.FrontendTransaction, 0, numTransactions)
+	for i := 0; i < numTransactions; i++ {
+		tx := &transaction.FrontendTransaction{
+			Sender:   sender.GetBech32(),
+			Receiver: receiver.GetBech32(),
+			Value:    "1000",
+			GasLimit: networkConfig.MinGasLimit,
+			ChainID:  networkConfig.ChainID,
+			Version:  networkConfig.MinTransactionVersion,
+		}
+		err = nonceHandler.ApplyNonceAndGasPrice(context.Background(), tx)
+		require.Nil(t, err)
+		err = txBuilder.ApplyUserSignature(sender, tx)
+		require.Nil(t, err)
+
+		txs = append(txs, tx)
+	}
+
+	hashes, err := nonceHandler.SendTransactions(context.Background(), txs...)
+	require.Nil(t, err)
+	require.Len(t, hashes, numTransactions)
+	assert.Equal(t, numTransactions, simulator.NumPendingTransactions())
+
+	err = simulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	for _, hash := range hashes {
+		status, errStatus := proxy.GetTransactionStatus(context.Background(), hash)
+		require.Nil(t, errStatus)
+		assert.Equal(t, string(transaction.TxStatusSuccess), status)
+	}
+	assert.Equal(t, uint64(numTransactions), simulator.State().Account(sender.GetBech32()).Nonce)
+	assert.Equal(t, "5000", simulator.State().Account(receiver.GetBech32()).Balance)
+}
+
+func TestChainSimulator_WalletTrackerAndMoveBalanceHandler(t *testing.T) {
+	t.Parallel()
+
+	hotWallet := createCryptoHolder(t, senderSecretKey)
+	trackedWallet := createCryptoHolder(t, receiverSecretKey)
+	simulator := createSimulatorWithAccounts(t, hotWallet)
+	proxy, err := blockchain.NewProxy(createArgsProxy(simulator))
+	require.Nil(t, err)
+
+	trackedSecretKey, _ := hex.DecodeString(receiverSecretKey)
+	trackableAddressesProvider := mock.NewTrackableAddressProviderMock()
+	trackableAddressesProvider.AddTrackableAddress(trackedWallet.GetBech32(), trackedSecretKey)
+
+	minimumBalance := big.NewInt(1000000000000000)
+	tracker, err := workflows.NewWalletTracker(workflows.WalletTrackerArgs{
+		TrackableAddressesProvider: trackableAddressesProvider,
+		Proxy:                      proxy,
+		NonceHandler:               &mock.MemoryNonceTracker{},
+		CheckInterval:              time.Millisecond * 10,
+		MinimumBalance:             minimumBalance,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = tracker.Close()
+	}()
+
+	mutDeposits := sync.Mutex{}
+	deposits := make([]data.TransactionOnNetwork, 0)
+	tracker.SetHandlerForNewDepositTransactionFound(func(tx data.TransactionOnNetwork) {
+		mutDeposits.Lock()
+		deposits = append(deposits, tx)
+		mutDeposits.Unlock()
+	})
+
+	depositValue := "100000000000000000"
+	depositHash, err := simulator.ProcessTransaction(createSignedTransaction(t, simulator, hotWallet, trackedWallet.GetBech32(), 0, depositValue, nil))
+	require.Nil(t, err)
+	err = simulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		mutDeposits.Lock()
+		defer mutDeposits.Unlock()
+
+		return len(deposits) == 1
+	}, time.Second*5, time.Millisecond*10)
+	assert.Equal(t, depositHash, deposits[0].Hash)
+	assert.Equal(t, []string{trackedWallet.GetBech32()}, tracker.GetLatestTrackedAddresses())
+
+	txBuilder, _ := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	txInteractor, err := interactors.NewTransactionInteractor(proxy, txBuilder)
+	require.Nil(t, err)
+	moveBalanceHandler, err := workflows.NewMoveBalanceHandler(workflows.MoveBalanceHandlerArgs{
+		Proxy:                      proxy,
+		TxInteractor:               txInteractor,
+		ReceiverAddress:            hotWallet.GetBech32(),
+		TrackableAddressesProvider: trackableAddressesProvider,
+		MinimumBalance:             minimumBalance,
+	})
+	require.Nil(t, err)
+
+	err = moveBalanceHandler.CacheNetworkConfigs(context.Background())
+	require.Nil(t, err)
+	moveBalanceHandler.GenerateMoveBalanceTransactions(context.Background(), []string{trackedWallet.GetBech32()})
+	hashes, err := txInteractor.SendTransactionsAsBunch(context.Background(), 10)
+	require.Nil(t, err)
+	require.Len(t, hashes, 1)
+
+	hotWalletBalanceBefore, _ := big.NewInt(0).SetString(simulator.State().Account(hotWallet.GetBech32()).Balance, 10)
+	err = simulator.GenerateBlocks(1)
+	require.Nil(t, err)
+
+	sweepTransaction, _ := simulator.State().Transaction(hashes[0])
+	assert.Equal(t, string(transaction.TxStatusSuccess), sweepTransaction.Status)
+	assert.Equal(t, "0", simulator.State().Account(trackedWallet.GetBech32()).Balance)
+	assert.Equal(t, uint64(1), simulator.State().Account(trackedWallet.GetBech32()).Nonce)
+
+	hotWalletBalanceAfter, _ := big.NewInt(0).SetString(simulator.State().Account(hotWallet.GetBech32()).Balance, 10)
+	assert.Equal(t, sweepTransaction.Value, big.NewInt(0).Sub(hotWalletBalanceAfter, hotWalletBalanceBefore).String())
+}
@@ -0,0 +1,7 @@
+package chainSimulator
+
+type proxyServer interface {
+	URL() string
+	Close()
+	IsInterfaceNil() bool
+}
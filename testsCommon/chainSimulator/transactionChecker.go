@@ -0,0 +1,149 @@
+package chainSimulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/hashing/keccak"
+	"github.com/TerraDharitri/drt-go-chain-core/marshal"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing"
+	"github.com/TerraDharitri/drt-go-chain-crypto/signing/ed25519"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain/cryptoProvider"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/TerraDharitri/drt-go-sdk/txcheck"
+)
+
+var (
+	keyGen            = signing.NewKeyGenerator(ed25519.NewEd25519())
+	signatureVerifier = cryptoProvider.NewSigner()
+	txMarshaller      = &marshal.JsonMarshalizer{}
+	txSigningHasher   = keccak.NewKeccak()
+)
+
+// dcdtTransfer holds the token and amount of a fungible DCDT transfer
+type dcdtTransfer struct {
+	tokenIdentifier string
+	amount          *big.Int
+}
+
+// checkTransaction verifies the transaction fields against the network config and all its signatures
+func checkTransaction(tx *transaction.FrontendTransaction, networkConfig *data.NetworkConfig) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+	if tx.ChainID != networkConfig.ChainID {
+		return fmt.Errorf("%w, provided: %s, network: %s", ErrInvalidChainID, tx.ChainID, networkConfig.ChainID)
+	}
+	if tx.Version < networkConfig.MinTransactionVersion {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", ErrInvalidTransactionVersion, tx.Version, networkConfig.MinTransactionVersion)
+	}
+	if tx.GasPrice < networkConfig.MinGasPrice {
+		return fmt.Errorf("%w, provided: %d, minimum: %d", ErrInsufficientGasPrice, tx.GasPrice, networkConfig.MinGasPrice)
+	}
+	gasNeeded := computeGasUsed(tx, networkConfig)
+	if tx.GasLimit < gasNeeded {
+		return fmt.Errorf("%w, provided: %d, needed: %d", ErrInsufficientGasLimit, tx.GasLimit, gasNeeded)
+	}
+	_, err := parseValue(tx.Value)
+	if err != nil {
+		return err
+	}
+	_, err = data.NewAddressFromBech32String(tx.Receiver)
+	if err != nil {
+		return fmt.Errorf("%w for the receiver %s", err, tx.Receiver)
+	}
+	_, _, err = parseDCDTTransfer(tx.Data)
+	if err != nil {
+		return err
+	}
+
+	err = verifySignature(tx, tx.Sender, tx.Signature)
+	if err != nil {
+		return fmt.Errorf("%w of the sender", err)
+	}
+	if len(tx.GuardianAddr) > 0 {
+		err = verifySignature(tx, tx.GuardianAddr, tx.GuardianSignature)
+		if err != nil {
+			return fmt.Errorf("%w of the guardian", err)
+		}
+	}
+	if len(tx.RelayerAddr) > 0 {
+		err = verifySignature(tx, tx.RelayerAddr, tx.RelayerSignature)
+		if err != nil {
+			return fmt.Errorf("%w of the relayer", err)
+		}
+	}
+
+	return nil
+}
+
+func verifySignature(tx *transaction.FrontendTransaction, signerAddress string, hexSignature string) error {
+	address, err := data.NewAddressFromBech32String(signerAddress)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+	publicKey, err := keyGen.PublicKeyFromByteArray(address.AddressBytes())
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+	signature, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	err = txcheck.VerifyTransactionSignature(tx, publicKey, signature, signatureVerifier, txMarshaller, txSigningHasher)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	return nil
+}
+
+// computeGasUsed returns the gas consumed by a move balance or a DCDT transfer, as the simulator does not execute
+// smart contract code
+func computeGasUsed(tx *transaction.FrontendTransaction, networkConfig *data.NetworkConfig) uint64 {
+	return networkConfig.MinGasLimit + uint64(len(tx.Data))*networkConfig.GasPerDataByte
+}
+
+func parseValue(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || result.Sign() < 0 {
+		return nil, fmt.Errorf("%w, provided: %s", ErrInvalidValue, value)
+	}
+
+	return result, nil
+}
+
+// parseDCDTTransfer returns the fungible token transfer encoded in the data field, if any, in the
+// DCDTTransfer@<token identifier>@<amount>[@<function>@<arguments>...] format
+func parseDCDTTransfer(txData []byte) (*dcdtTransfer, bool, error) {
+	tokens := strings.Split(string(txData), "@")
+	if tokens[0] != core.BuiltInFunctionDCDTTransfer {
+		return nil, false, nil
+	}
+	if len(tokens) < 3 {
+		return nil, false, fmt.Errorf("%w, too few arguments", ErrInvalidDCDTTransfer)
+	}
+
+	tokenIdentifier, err := hex.DecodeString(tokens[1])
+	if err != nil || len(tokenIdentifier) == 0 {
+		return nil, false, fmt.Errorf("%w, invalid token identifier %s", ErrInvalidDCDTTransfer, tokens[1])
+	}
+	amountBytes, err := hex.DecodeString(tokens[2])
+	if err != nil {
+		return nil, false, fmt.Errorf("%w, invalid amount %s", ErrInvalidDCDTTransfer, tokens[2])
+	}
+
+	return &dcdtTransfer{
+		tokenIdentifier: string(tokenIdentifier),
+		amount:          big.NewInt(0).SetBytes(amountBytes),
+	}, true, nil
+}
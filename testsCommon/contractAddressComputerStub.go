@@ -0,0 +1,24 @@
+package testsCommon
+
+import (
+	"github.com/TerraDharitri/drt-go-sdk/core"
+)
+
+// ContractAddressComputerStub -
+type ContractAddressComputerStub struct {
+	ComputeWasmVMScAddressCalled func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error)
+}
+
+// ComputeWasmVMScAddress -
+func (stub *ContractAddressComputerStub) ComputeWasmVMScAddress(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+	if stub.ComputeWasmVMScAddressCalled != nil {
+		return stub.ComputeWasmVMScAddressCalled(address, nonce)
+	}
+
+	return address, nil
+}
+
+// IsInterfaceNil -
+func (stub *ContractAddressComputerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
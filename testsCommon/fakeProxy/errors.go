@@ -0,0 +1,54 @@
+package fakeProxy
+
+import "errors"
+
+// ErrNilNetworkConfig signals that a nil network config was provided
+var ErrNilNetworkConfig = errors.New("nil network config")
+
+// ErrNilNetworkStatus signals that a nil network status was provided
+var ErrNilNetworkStatus = errors.New("nil network status")
+
+// ErrNilAccount signals that a nil account was provided
+var ErrNilAccount = errors.New("nil account")
+
+// ErrEmptyTokenIdentifier signals that an empty token identifier was provided
+var ErrEmptyTokenIdentifier = errors.New("empty token identifier")
+
+// ErrNilBalance signals that a nil balance was provided
+var ErrNilBalance = errors.New("nil balance")
+
+// ErrInvalidBalance signals that an invalid balance was provided
+var ErrInvalidBalance = errors.New("invalid balance")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrEmptyTransactionHash signals that an empty transaction hash was provided
+var ErrEmptyTransactionHash = errors.New("empty transaction hash")
+
+// ErrTransactionNotFound signals that the requested transaction is not known
+var ErrTransactionNotFound = errors.New("transaction not found")
+
+// ErrNilHyperBlock signals that a nil hyperblock was provided
+var ErrNilHyperBlock = errors.New("nil hyperblock")
+
+// ErrHyperBlockNotFound signals that the requested hyperblock is not known
+var ErrHyperBlockNotFound = errors.New("hyperblock not found")
+
+// ErrNilBlock signals that a nil block was provided
+var ErrNilBlock = errors.New("nil block")
+
+// ErrBlockNotFound signals that the requested block is not known
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrNilVmOutput signals that a nil VM output was provided
+var ErrNilVmOutput = errors.New("nil VM output")
+
+// ErrVmQueryNotFound signals that no response was set for the requested VM query
+var ErrVmQueryNotFound = errors.New("no response set for the VM query")
+
+// ErrEmptyEndpoint signals that an empty endpoint was provided
+var ErrEmptyEndpoint = errors.New("empty endpoint")
+
+// ErrRouteNotFound signals that the requested route is not served
+var ErrRouteNotFound = errors.New("route not found")
@@ -0,0 +1,391 @@
+package fakeProxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core/check"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	logger "github.com/TerraDharitri/drt-go-chain-logger"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const (
+	codeSuccessful    = "successful"
+	codeRequestError  = "bad_request"
+	codeInternalIssue = "internal_issue"
+)
+
+var log = logger.GetOrCreate("drt-go-sdk/testsCommon/fakeProxy")
+
+// ArgsFakeProxy is the DTO used in the fake proxy constructor
+type ArgsFakeProxy struct {
+	// State holds the served data. Defaults to an empty state
+	State *State
+	// TransactionsHandler processes the sent transactions. Defaults to storing them in the state as pending
+	TransactionsHandler TransactionsHandler
+}
+
+type routeHandler func(request *http.Request, params []string) (interface{}, int, error)
+
+type route struct {
+	method   string
+	segments []string
+	handler  routeHandler
+}
+
+type responseEnvelope struct {
+	Data  interface{} `json:"data"`
+	Error string      `json:"error"`
+	Code  string      `json:"code"`
+}
+
+type fakeProxy struct {
+	state               *State
+	transactionsHandler TransactionsHandler
+	routes              []*route
+	server              *httptest.Server
+}
+
+// NewFakeProxy creates and starts an in-process HTTP server that mimics a Dharitri proxy. It serves the
+// routes of the proxy endpoint provider from the in-memory state, so the real proxy implementation can be used
+// against it. The server should be closed after use.
+func NewFakeProxy(args ArgsFakeProxy) *fakeProxy {
+	state := args.State
+	if state == nil {
+		state = NewState()
+	}
+	transactionsHandler := args.TransactionsHandler
+	if check.IfNil(transactionsHandler) {
+		transactionsHandler = NewPendingTransactionsHandler(state)
+	}
+
+	proxy := &fakeProxy{
+		state:               state,
+		transactionsHandler: transactionsHandler,
+	}
+	proxy.createRoutes()
+	proxy.server = httptest.NewServer(proxy)
+
+	return proxy
+}
+
+func (proxy *fakeProxy) createRoutes() {
+	proxy.addRoute(http.MethodGet, "network/config", proxy.getNetworkConfig)
+	proxy.addRoute(http.MethodGet, "network/status/:shard", proxy.getNetworkStatus)
+	proxy.addRoute(http.MethodGet, "address/:address", proxy.getAccount)
+	proxy.addRoute(http.MethodGet, "address/:address/dcdt", proxy.getAllDCDTTokens)
+	proxy.addRoute(http.MethodGet, "address/:address/dcdt/:token", proxy.getDCDTTokenData)
+	proxy.addRoute(http.MethodPost, "transaction/send", proxy.sendTransaction)
+	proxy.addRoute(http.MethodPost, "transaction/send-multiple", proxy.sendTransactions)
+	proxy.addRoute(http.MethodGet, "transaction/:hash/status", proxy.getTransactionStatus)
+	proxy.addRoute(http.MethodGet, "transaction/:hash/process-status", proxy.getTransactionStatus)
+	proxy.addRoute(http.MethodGet, "transaction/:hash", proxy.getTransactionInfo)
+	proxy.addRoute(http.MethodGet, "hyperblock/by-nonce/:nonce", proxy.getHyperBlockByNonce)
+	proxy.addRoute(http.MethodGet, "hyperblock/by-hash/:hash", proxy.getHyperBlockByHash)
+	proxy.addRoute(http.MethodGet, "block/:shard/by-nonce/:nonce", proxy.getBlockByNonce)
+	proxy.addRoute(http.MethodGet, "block/:shard/by-hash/:hash", proxy.getBlockByHash)
+	proxy.addRoute(http.MethodPost, "vm-values/query", proxy.executeVmQuery)
+}
+
+func (proxy *fakeProxy) addRoute(method string, path string, handler routeHandler) {
+	proxy.routes = append(proxy.routes, &route{
+		method:   method,
+		segments: strings.Split(path, "/"),
+		handler:  handler,
+	})
+}
+
+// URL returns the base URL of the fake proxy, to be used as the proxy URL
+func (proxy *fakeProxy) URL() string {
+	return proxy.server.URL
+}
+
+// State returns the state served by the fake proxy
+func (proxy *fakeProxy) State() *State {
+	return proxy.state
+}
+
+// ServeHTTP serves the raw response set for the requested endpoint, if any, otherwise the response of the
+// matching route
+func (proxy *fakeProxy) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	rawResponse, found := proxy.state.getRawResponse(request.URL.Path)
+	if found {
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write(rawResponse)
+		return
+	}
+
+	handler, params := proxy.matchRoute(request.Method, normalizeEndpoint(request.URL.Path))
+	if handler == nil {
+		writeResponse(writer, request, nil, http.StatusNotFound, ErrRouteNotFound)
+		return
+	}
+
+	responseData, status, err := handler(request, params)
+	writeResponse(writer, request, responseData, status, err)
+}
+
+func (proxy *fakeProxy) matchRoute(method string, path string) (routeHandler, []string) {
+	segments := strings.Split(path, "/")
+	for _, r := range proxy.routes {
+		params, matched := r.match(method, segments)
+		if matched {
+			return r.handler, params
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *route) match(method string, segments []string) ([]string, bool) {
+	if r.method != method || len(r.segments) != len(segments) {
+		return nil, false
+	}
+
+	params := make([]string, 0)
+	for i, segment := range r.segments {
+		if strings.HasPrefix(segment, ":") {
+			params = append(params, segments[i])
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+func writeResponse(writer http.ResponseWriter, request *http.Request, responseData interface{}, status int, err error) {
+	envelope := responseEnvelope{
+		Data: responseData,
+		Code: codeSuccessful,
+	}
+	if err != nil {
+		log.Debug("fake proxy request failed", "method", request.Method, "path", request.URL.Path, "error", err)
+
+		envelope.Error = err.Error()
+		envelope.Code = codeRequestError
+		if status == http.StatusInternalServerError {
+			envelope.Code = codeInternalIssue
+		}
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	_ = json.NewEncoder(writer).Encode(envelope)
+}
+
+func (proxy *fakeProxy) getNetworkConfig(_ *http.Request, _ []string) (interface{}, int, error) {
+	return map[string]interface{}{"config": proxy.state.NetworkConfig()}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getNetworkStatus(_ *http.Request, params []string) (interface{}, int, error) {
+	shardID, err := strconv.ParseUint(params[0], 10, 32)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w while parsing the shard ID", err)
+	}
+
+	return map[string]interface{}{"status": proxy.state.NetworkStatus(uint32(shardID))}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getAccount(_ *http.Request, params []string) (interface{}, int, error) {
+	_, err := data.NewAddressFromBech32String(params[0])
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w for the address %s", err, params[0])
+	}
+
+	return map[string]interface{}{"account": proxy.state.Account(params[0])}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getAllDCDTTokens(_ *http.Request, params []string) (interface{}, int, error) {
+	_, err := data.NewAddressFromBech32String(params[0])
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w for the address %s", err, params[0])
+	}
+
+	tokens := make(map[string]*data.DCDTNFTTokenData)
+	for tokenIdentifier, balance := range proxy.state.DCDTBalances(params[0]) {
+		tokens[tokenIdentifier] = &data.DCDTNFTTokenData{
+			TokenIdentifier: tokenIdentifier,
+			Balance:         balance.String(),
+		}
+	}
+
+	return map[string]interface{}{"dcdts": tokens}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getDCDTTokenData(_ *http.Request, params []string) (interface{}, int, error) {
+	_, err := data.NewAddressFromBech32String(params[0])
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w for the address %s", err, params[0])
+	}
+
+	tokenData := &data.DCDTFungibleTokenData{
+		TokenIdentifier: params[1],
+		Balance:         proxy.state.DCDTBalance(params[0], params[1]).String(),
+	}
+
+	return map[string]interface{}{"tokenData": tokenData}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) sendTransaction(request *http.Request, _ []string) (interface{}, int, error) {
+	tx := &transaction.FrontendTransaction{}
+	err := decodeRequestBody(request, tx)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	txHash, err := proxy.transactionsHandler.ProcessTransaction(tx)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return map[string]interface{}{"txHash": txHash}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) sendTransactions(request *http.Request, _ []string) (interface{}, int, error) {
+	txs := make([]*transaction.FrontendTransaction, 0)
+	err := decodeRequestBody(request, &txs)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	// as the real proxy does, the rejected transactions are skipped and only the sent ones are returned
+	txsHashes := make(map[int]string)
+	for i, tx := range txs {
+		txHash, errProcess := proxy.transactionsHandler.ProcessTransaction(tx)
+		if errProcess != nil {
+			log.Debug("fake proxy rejected transaction", "index", i, "error", errProcess)
+			continue
+		}
+
+		txsHashes[i] = txHash
+	}
+
+	responseData := map[string]interface{}{
+		"numOfSentTxs": len(txsHashes),
+		"txsHashes":    txsHashes,
+	}
+
+	return responseData, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getTransactionStatus(_ *http.Request, params []string) (interface{}, int, error) {
+	tx, found := proxy.state.Transaction(params[0])
+	if !found {
+		return nil, http.StatusNotFound, fmt.Errorf("%w, hash: %s", ErrTransactionNotFound, params[0])
+	}
+
+	return map[string]interface{}{"status": tx.Status}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getTransactionInfo(_ *http.Request, params []string) (interface{}, int, error) {
+	tx, found := proxy.state.Transaction(params[0])
+	if !found {
+		return nil, http.StatusNotFound, fmt.Errorf("%w, hash: %s", ErrTransactionNotFound, params[0])
+	}
+
+	return map[string]interface{}{"transaction": tx}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getHyperBlockByNonce(_ *http.Request, params []string) (interface{}, int, error) {
+	nonce, err := strconv.ParseUint(params[0], 10, 64)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w while parsing the nonce", err)
+	}
+
+	hyperBlock, found := proxy.state.HyperBlockByNonce(nonce)
+	if !found {
+		return nil, http.StatusNotFound, fmt.Errorf("%w, nonce: %d", ErrHyperBlockNotFound, nonce)
+	}
+
+	return map[string]interface{}{"hyperblock": hyperBlock}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getHyperBlockByHash(_ *http.Request, params []string) (interface{}, int, error) {
+	hyperBlock, found := proxy.state.HyperBlockByHash(params[0])
+	if !found {
+		return nil, http.StatusNotFound, fmt.Errorf("%w, hash: %s", ErrHyperBlockNotFound, params[0])
+	}
+
+	return map[string]interface{}{"hyperblock": hyperBlock}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getBlockByNonce(_ *http.Request, params []string) (interface{}, int, error) {
+	shardID, err := strconv.ParseUint(params[0], 10, 32)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w while parsing the shard ID", err)
+	}
+	nonce, err := strconv.ParseUint(params[1], 10, 64)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w while parsing the nonce", err)
+	}
+
+	block, found := proxy.state.BlockByNonce(uint32(shardID), nonce)
+	if !found {
+		return nil, http.StatusNotFound, fmt.Errorf("%w, shard: %d, nonce: %d", ErrBlockNotFound, shardID, nonce)
+	}
+
+	return map[string]interface{}{"block": block}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) getBlockByHash(_ *http.Request, params []string) (interface{}, int, error) {
+	shardID, err := strconv.ParseUint(params[0], 10, 32)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w while parsing the shard ID", err)
+	}
+
+	block, found := proxy.state.BlockByHash(uint32(shardID), params[1])
+	if !found {
+		return nil, http.StatusNotFound, fmt.Errorf("%w, shard: %d, hash: %s", ErrBlockNotFound, shardID, params[1])
+	}
+
+	return map[string]interface{}{"block": block}, http.StatusOK, nil
+}
+
+func (proxy *fakeProxy) executeVmQuery(request *http.Request, _ []string) (interface{}, int, error) {
+	vmRequest := &data.VmValueRequest{}
+	err := decodeRequestBody(request, vmRequest)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	output, found := proxy.state.getVmQueryOutput(vmRequest)
+	if !found {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w, contract: %s, function: %s",
+			ErrVmQueryNotFound, vmRequest.Address, vmRequest.FuncName)
+	}
+
+	return map[string]interface{}{"data": output}, http.StatusOK, nil
+}
+
+func decodeRequestBody(request *http.Request, destination interface{}) error {
+	buff, err := io.ReadAll(request.Body)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(buff, destination)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the request body", err)
+	}
+
+	return nil
+}
+
+// Close stops the HTTP server
+func (proxy *fakeProxy) Close() {
+	proxy.server.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (proxy *fakeProxy) IsInterfaceNil() bool {
+	return proxy == nil
+}
@@ -0,0 +1,324 @@
+package fakeProxy
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/TerraDharitri/drt-go-chain-core/core"
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress         = "drt1dglncxk6sl9a3xumj78n6z2xux4ghp5c92cstv5zsn56tjgtdwpstfdrqw"
+	testReceiverAddress = "drt1rh5ws22jxm9pe7dtvhfy6j3uttuupkepferdwtmslms5fydtrh5smd3qya"
+	testScAddress       = "drt1qqqqqqqqqqqqqpgqp699jngundfqw07d8jzkepucvpzush6k3wvqeyzkqc"
+)
+
+func createFakeProxyWithFixture(t *testing.T) *fakeProxy {
+	state := NewState()
+	err := state.LoadFixtureFromFile("testdata/state.json")
+	require.Nil(t, err)
+
+	fake := NewFakeProxy(ArgsFakeProxy{State: state})
+	t.Cleanup(fake.Close)
+
+	return fake
+}
+
+func createArgsProxy(url string) blockchain.ArgsProxy {
+	return blockchain.ArgsProxy{
+		ProxyURL:            url,
+		AllowedDeltaToFinal: 1,
+		CacheExpirationTime: time.Minute,
+		EntityType:          sdkCore.Proxy,
+	}
+}
+
+func createSignedTransaction(nonce uint64) *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Nonce:     nonce,
+		Value:     "1000",
+		Receiver:  testReceiverAddress,
+		Sender:    testAddress,
+		GasPrice:  1000000000,
+		GasLimit:  50000,
+		ChainID:   "T",
+		Version:   1,
+		Signature: "aabbccdd",
+	}
+}
+
+func TestFakeProxy_NetworkRoutes(t *testing.T) {
+	t.Parallel()
+
+	fake := createFakeProxyWithFixture(t)
+	proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+	require.Nil(t, err)
+
+	networkConfig, err := proxy.GetNetworkConfig(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "T", networkConfig.ChainID)
+	assert.Equal(t, uint32(3), networkConfig.NumShardsWithoutMeta)
+
+	latestNonce, err := proxy.GetLatestHyperBlockNonce(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, uint64(100), latestNonce)
+
+	networkStatus, err := proxy.GetNetworkStatus(context.Background(), 1)
+	require.Nil(t, err)
+	assert.Equal(t, &data.NetworkStatus{ShardID: 1}, networkStatus)
+}
+
+func TestFakeProxy_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	fake := createFakeProxyWithFixture(t)
+	proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+	require.Nil(t, err)
+
+	address, _ := data.NewAddressFromBech32String(testAddress)
+	account, err := proxy.GetAccount(context.Background(), address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(37), account.Nonce)
+	assert.Equal(t, "1000000000000000000", account.Balance)
+
+	address, _ = data.NewAddressFromBech32String(testReceiverAddress)
+	account, err = proxy.GetAccount(context.Background(), address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, testReceiverAddress, account.Address)
+	assert.Equal(t, uint64(0), account.Nonce)
+	assert.Equal(t, "0", account.Balance)
+}
+
+func TestFakeProxy_DCDTTokens(t *testing.T) {
+	t.Parallel()
+
+	fake := createFakeProxyWithFixture(t)
+	proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+	require.Nil(t, err)
+
+	address, _ := data.NewAddressFromBech32String(testAddress)
+	tokenData, err := proxy.GetDCDTTokenData(context.Background(), address, "USDC-a1b2c3", api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "5000000", tokenData.Balance)
+
+	tokenData, err = proxy.GetDCDTTokenData(context.Background(), address, "WREWA-a1b2c3", api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, "0", tokenData.Balance)
+
+	tokens, err := proxy.GetAllDCDTTokens(context.Background(), address, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "5000000", tokens[0].Balance)
+}
+
+func TestFakeProxy_Transactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stored transaction should be served", func(t *testing.T) {
+		t.Parallel()
+
+		fake := createFakeProxyWithFixture(t)
+		proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+		require.Nil(t, err)
+
+		status, err := proxy.GetTransactionStatus(context.Background(), "a1b2c3")
+		require.Nil(t, err)
+		assert.Equal(t, "success", status)
+
+		processedStatus, err := proxy.ProcessTransactionStatus(context.Background(), "a1b2c3")
+		require.Nil(t, err)
+		assert.Equal(t, transaction.TxStatusSuccess, processedStatus)
+
+		txInfo, err := proxy.GetTransactionInfoWithResults(context.Background(), "a1b2c3")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(36), txInfo.Data.Transaction.Nonce)
+		assert.Equal(t, testReceiverAddress, txInfo.Data.Transaction.Receiver)
+	})
+	t.Run("unknown transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		fake := createFakeProxyWithFixture(t)
+		proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+		require.Nil(t, err)
+
+		_, err = proxy.GetTransactionStatus(context.Background(), "ffff")
+		assert.NotNil(t, err)
+	})
+	t.Run("sent transactions should be stored as pending", func(t *testing.T) {
+		t.Parallel()
+
+		fake := createFakeProxyWithFixture(t)
+		proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+		require.Nil(t, err)
+
+		txHash, err := proxy.SendTransaction(context.Background(), createSignedTransaction(37))
+		require.Nil(t, err)
+		assert.Len(t, txHash, 64)
+
+		status, err := proxy.GetTransactionStatus(context.Background(), txHash)
+		require.Nil(t, err)
+		assert.Equal(t, string(transaction.TxStatusPending), status)
+
+		err = fake.State().SetTransactionStatus(txHash, string(transaction.TxStatusSuccess))
+		require.Nil(t, err)
+		txInfo, err := proxy.GetTransactionInfo(context.Background(), txHash)
+		require.Nil(t, err)
+		assert.Equal(t, string(transaction.TxStatusSuccess), txInfo.Data.Transaction.Status)
+		assert.Equal(t, uint64(37), txInfo.Data.Transaction.Nonce)
+		assert.Equal(t, uint32(1), txInfo.Data.Transaction.SourceShard)
+	})
+	t.Run("send multiple should skip the rejected transactions", func(t *testing.T) {
+		t.Parallel()
+
+		fake := createFakeProxyWithFixture(t)
+		proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+		require.Nil(t, err)
+
+		unsignedTx := createSignedTransaction(38)
+		unsignedTx.Signature = ""
+		txHashes, err := proxy.SendTransactions(context.Background(), []*transaction.FrontendTransaction{
+			createSignedTransaction(37),
+			unsignedTx,
+			createSignedTransaction(39),
+		})
+		require.Nil(t, err)
+		require.Len(t, txHashes, 2)
+		assert.NotEqual(t, txHashes[0], txHashes[1])
+	})
+	t.Run("custom transactions handler should be used", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		fake := NewFakeProxy(ArgsFakeProxy{
+			TransactionsHandler: &transactionsHandlerStub{
+				ProcessTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+					return "", expectedErr
+				},
+			},
+		})
+		defer fake.Close()
+		proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+		require.Nil(t, err)
+
+		_, err = proxy.SendTransaction(context.Background(), createSignedTransaction(37))
+		assert.Equal(t, expectedErr.Error(), err.Error())
+	})
+}
+
+func TestFakeProxy_Blocks(t *testing.T) {
+	t.Parallel()
+
+	fake := createFakeProxyWithFixture(t)
+	proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+	require.Nil(t, err)
+
+	hyperBlock, err := proxy.GetHyperBlockByNonce(context.Background(), 100)
+	require.Nil(t, err)
+	assert.Equal(t, "0a0b0c", hyperBlock.Hash)
+	hyperBlock, err = proxy.GetHyperBlockByHash(context.Background(), "0a0b0c")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(100), hyperBlock.Nonce)
+	_, err = proxy.GetHyperBlockByNonce(context.Background(), 101)
+	assert.NotNil(t, err)
+
+	// served from the blockchain/testdata fixture referenced by the state fixture
+	block, err := proxy.GetBlockByNonce(context.Background(), 0, 21000000, blockchain.BlockQueryOptions{WithTransactions: true})
+	require.Nil(t, err)
+	assert.Equal(t, "94f7d0e806c5ff3768236f78c67007c82d36a2d917657ae265409a07ff835d8e", block.Hash)
+
+	err = fake.State().AddBlock(&api.Block{Nonce: 7, Shard: core.MetachainShardId, Hash: "0d0e0f"})
+	require.Nil(t, err)
+	block, err = proxy.GetBlockByHash(context.Background(), core.MetachainShardId, "0d0e0f", blockchain.BlockQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, uint64(7), block.Nonce)
+}
+
+func TestFakeProxy_ExecuteVMQuery(t *testing.T) {
+	t.Parallel()
+
+	fake := createFakeProxyWithFixture(t)
+	proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+	require.Nil(t, err)
+
+	err = fake.State().SetVmQueryResponse(&VmQueryResponse{
+		ScAddress: testScAddress,
+		FuncName:  "getSum",
+		Args:      []string{"0A"},
+		Output: &vm.VMOutputApi{
+			ReturnData: [][]byte{big.NewInt(10).Bytes()},
+			ReturnCode: "ok",
+		},
+	})
+	require.Nil(t, err)
+
+	response, err := proxy.ExecuteVMQuery(context.Background(), &data.VmValueRequest{
+		Address:  testScAddress,
+		FuncName: "getSum",
+	}, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{{42}}, response.Data.ReturnData)
+
+	response, err = proxy.ExecuteVMQuery(context.Background(), &data.VmValueRequest{
+		Address:  testScAddress,
+		FuncName: "getSum",
+		Args:     []string{"0a"},
+	}, api.AccountQueryOptions{})
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{{10}}, response.Data.ReturnData)
+
+	_, err = proxy.ExecuteVMQuery(context.Background(), &data.VmValueRequest{
+		Address:  testScAddress,
+		FuncName: "getOther",
+	}, api.AccountQueryOptions{})
+	assert.NotNil(t, err)
+}
+
+func TestFakeProxy_RawResponsesAndUnknownRoutes(t *testing.T) {
+	t.Parallel()
+
+	fake := NewFakeProxy(ArgsFakeProxy{})
+	defer fake.Close()
+	proxy, err := blockchain.NewProxy(createArgsProxy(fake.URL()))
+	require.Nil(t, err)
+
+	err = fake.State().SetRawResponse("/network/economics", []byte(`{"data":{"metrics":{"drt_total_supply":"20000000"}},"code":"successful"}`))
+	require.Nil(t, err)
+	economics, err := proxy.GetNetworkEconomics(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, "20000000", economics.TotalSupply)
+
+	response, err := http.Get(fake.URL() + "/network/unknown")
+	require.Nil(t, err)
+	_ = response.Body.Close()
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+type transactionsHandlerStub struct {
+	ProcessTransactionCalled func(tx *transaction.FrontendTransaction) (string, error)
+}
+
+// ProcessTransaction -
+func (stub *transactionsHandlerStub) ProcessTransaction(tx *transaction.FrontendTransaction) (string, error) {
+	if stub.ProcessTransactionCalled != nil {
+		return stub.ProcessTransactionCalled(tx)
+	}
+
+	return "", nil
+}
+
+// IsInterfaceNil -
+func (stub *transactionsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
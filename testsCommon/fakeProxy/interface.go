@@ -0,0 +1,9 @@
+package fakeProxy
+
+import "github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+
+// TransactionsHandler defines the component that processes the transactions sent to the fake proxy
+type TransactionsHandler interface {
+	ProcessTransaction(tx *transaction.FrontendTransaction) (string, error)
+	IsInterfaceNil() bool
+}
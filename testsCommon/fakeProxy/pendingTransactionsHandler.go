@@ -0,0 +1,90 @@
+package fakeProxy
+
+import (
+	"encoding/hex"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/blockchain"
+	"github.com/TerraDharitri/drt-go-sdk/builders"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const transactionTypeNormal = "normal"
+
+type pendingTransactionsHandler struct {
+	state *State
+}
+
+// NewPendingTransactionsHandler creates a transactions handler that stores the received transactions in the state
+// with the pending status, without executing them
+func NewPendingTransactionsHandler(state *State) *pendingTransactionsHandler {
+	return &pendingTransactionsHandler{
+		state: state,
+	}
+}
+
+// ProcessTransaction stores the signed transaction as pending and returns its hex encoded hash
+func (handler *pendingTransactionsHandler) ProcessTransaction(tx *transaction.FrontendTransaction) (string, error) {
+	txHash, err := builders.ComputeTransactionHash(tx)
+	if err != nil {
+		return "", err
+	}
+
+	txOnNetwork, err := CreateTransactionOnNetwork(tx, handler.state.NetworkConfig().NumShardsWithoutMeta)
+	if err != nil {
+		return "", err
+	}
+	txOnNetwork.Hash = hex.EncodeToString(txHash)
+	txOnNetwork.Status = string(transaction.TxStatusPending)
+
+	err = handler.state.AddTransaction(txOnNetwork)
+	if err != nil {
+		return "", err
+	}
+
+	return txOnNetwork.Hash, nil
+}
+
+// CreateTransactionOnNetwork converts the sent transaction in the format returned by the transaction info route.
+// The source and destination shards are computed from the sender and receiver addresses
+func CreateTransactionOnNetwork(tx *transaction.FrontendTransaction, numShards uint32) (*data.TransactionOnNetwork, error) {
+	shardCoordinator, err := blockchain.NewShardCoordinator(numShards, 0)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := data.NewAddressFromBech32String(tx.Sender)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := data.NewAddressFromBech32String(tx.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	sourceShard, err := shardCoordinator.ComputeShardId(sender)
+	if err != nil {
+		return nil, err
+	}
+	destinationShard, err := shardCoordinator.ComputeShardId(receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.TransactionOnNetwork{
+		Type:             transactionTypeNormal,
+		Nonce:            tx.Nonce,
+		Value:            tx.Value,
+		Receiver:         tx.Receiver,
+		Sender:           tx.Sender,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Data:             tx.Data,
+		Signature:        tx.Signature,
+		SourceShard:      sourceShard,
+		DestinationShard: destinationShard,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *pendingTransactionsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
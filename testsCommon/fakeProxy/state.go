@@ -0,0 +1,565 @@
+package fakeProxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/api"
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+const zeroBalance = "0"
+
+// VmQueryResponse holds the response returned by the fake proxy for a VM query. The response is served for the
+// queries matching the contract address, the function and, if provided, the hex encoded arguments
+type VmQueryResponse struct {
+	ScAddress string          `json:"scAddress"`
+	FuncName  string          `json:"funcName"`
+	Args      []string        `json:"args"`
+	Output    *vm.VMOutputApi `json:"output"`
+}
+
+// StateFixture is the JSON format of the files that can be loaded in the state. The raw response files paths are
+// relative to the fixture file, so the fixtures from other packages, such as the blockchain/testdata ones, can be reused
+type StateFixture struct {
+	NetworkConfig    *data.NetworkConfig            `json:"networkConfig"`
+	NetworkStatus    map[uint32]*data.NetworkStatus `json:"networkStatus"`
+	Accounts         []*data.Account                `json:"accounts"`
+	DCDTBalances     map[string]map[string]string   `json:"dcdtBalances"`
+	Transactions     []*data.TransactionOnNetwork   `json:"transactions"`
+	HyperBlocks      []*data.HyperBlock             `json:"hyperBlocks"`
+	Blocks           []*api.Block                   `json:"blocks"`
+	VmQueries        []*VmQueryResponse             `json:"vmQueries"`
+	RawResponses     map[string]json.RawMessage     `json:"rawResponses"`
+	RawResponseFiles map[string]string              `json:"rawResponseFiles"`
+}
+
+// State holds the in-memory data served by the fake proxy. All the setters store copies of the provided objects.
+// This struct is concurrent safe.
+type State struct {
+	mut                sync.RWMutex
+	networkConfig      *data.NetworkConfig
+	networkStatus      map[uint32]*data.NetworkStatus
+	accounts           map[string]*data.Account
+	dcdtBalances       map[string]map[string]*big.Int
+	transactions       map[string]*data.TransactionOnNetwork
+	hyperBlocksByNonce map[uint64]*data.HyperBlock
+	hyperBlocksByHash  map[string]*data.HyperBlock
+	blocksByNonce      map[uint32]map[uint64]*api.Block
+	blocksByHash       map[uint32]map[string]*api.Block
+	vmQueries          map[string][]*VmQueryResponse
+	rawResponses       map[string][]byte
+}
+
+// NewState creates an empty state with a default network config of 3 shards
+func NewState() *State {
+	return &State{
+		networkConfig:      createDefaultNetworkConfig(),
+		networkStatus:      make(map[uint32]*data.NetworkStatus),
+		accounts:           make(map[string]*data.Account),
+		dcdtBalances:       make(map[string]map[string]*big.Int),
+		transactions:       make(map[string]*data.TransactionOnNetwork),
+		hyperBlocksByNonce: make(map[uint64]*data.HyperBlock),
+		hyperBlocksByHash:  make(map[string]*data.HyperBlock),
+		blocksByNonce:      make(map[uint32]map[uint64]*api.Block),
+		blocksByHash:       make(map[uint32]map[string]*api.Block),
+		vmQueries:          make(map[string][]*VmQueryResponse),
+		rawResponses:       make(map[string][]byte),
+	}
+}
+
+func createDefaultNetworkConfig() *data.NetworkConfig {
+	return &data.NetworkConfig{
+		ChainID:               "local-testnet",
+		Denomination:          18,
+		GasPerDataByte:        1500,
+		MinGasLimit:           50000,
+		MinGasPrice:           1000000000,
+		MinTransactionVersion: 1,
+		NumShardsWithoutMeta:  3,
+		RoundDuration:         6000,
+		RoundsPerEpoch:        20,
+	}
+}
+
+// SetNetworkConfig sets the network config
+func (state *State) SetNetworkConfig(networkConfig *data.NetworkConfig) error {
+	if networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	configCopy := *networkConfig
+	state.networkConfig = &configCopy
+
+	return nil
+}
+
+// NetworkConfig returns a copy of the network config
+func (state *State) NetworkConfig() *data.NetworkConfig {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	configCopy := *state.networkConfig
+
+	return &configCopy
+}
+
+// SetNetworkStatus sets the network status of a shard
+func (state *State) SetNetworkStatus(shardID uint32, networkStatus *data.NetworkStatus) error {
+	if networkStatus == nil {
+		return ErrNilNetworkStatus
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	statusCopy := *networkStatus
+	statusCopy.ShardID = shardID
+	state.networkStatus[shardID] = &statusCopy
+
+	return nil
+}
+
+// NetworkStatus returns a copy of the network status of a shard. A shard without a set status reports
+// a zero-valued status
+func (state *State) NetworkStatus(shardID uint32) *data.NetworkStatus {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	status, found := state.networkStatus[shardID]
+	if !found {
+		return &data.NetworkStatus{ShardID: shardID}
+	}
+
+	statusCopy := *status
+
+	return &statusCopy
+}
+
+// SetAccount sets the account stored under its bech32 address
+func (state *State) SetAccount(account *data.Account) error {
+	if account == nil {
+		return ErrNilAccount
+	}
+	_, err := data.NewAddressFromBech32String(account.Address)
+	if err != nil {
+		return fmt.Errorf("%w for the account address %s", err, account.Address)
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	accountCopy := *account
+	if len(accountCopy.Balance) == 0 {
+		accountCopy.Balance = zeroBalance
+	}
+	state.accounts[account.Address] = &accountCopy
+
+	return nil
+}
+
+// Account returns a copy of the account. As the real proxy does, an unknown address returns an empty account
+func (state *State) Account(address string) *data.Account {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	account, found := state.accounts[address]
+	if !found {
+		return &data.Account{
+			Address: address,
+			Balance: zeroBalance,
+		}
+	}
+
+	accountCopy := *account
+
+	return &accountCopy
+}
+
+// SetDCDTBalance sets the balance of the fungible token held by the address
+func (state *State) SetDCDTBalance(address string, tokenIdentifier string, balance *big.Int) error {
+	if len(tokenIdentifier) == 0 {
+		return ErrEmptyTokenIdentifier
+	}
+	if balance == nil {
+		return ErrNilBalance
+	}
+	_, err := data.NewAddressFromBech32String(address)
+	if err != nil {
+		return fmt.Errorf("%w for the token holder address %s", err, address)
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	if state.dcdtBalances[address] == nil {
+		state.dcdtBalances[address] = make(map[string]*big.Int)
+	}
+	state.dcdtBalances[address][tokenIdentifier] = big.NewInt(0).Set(balance)
+
+	return nil
+}
+
+// DCDTBalance returns the balance of the fungible token held by the address, 0 if the token is not held
+func (state *State) DCDTBalance(address string, tokenIdentifier string) *big.Int {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	balance, found := state.dcdtBalances[address][tokenIdentifier]
+	if !found {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(balance)
+}
+
+// DCDTBalances returns the balances of all the fungible tokens held by the address
+func (state *State) DCDTBalances(address string) map[string]*big.Int {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	balances := make(map[string]*big.Int, len(state.dcdtBalances[address]))
+	for tokenIdentifier, balance := range state.dcdtBalances[address] {
+		balances[tokenIdentifier] = big.NewInt(0).Set(balance)
+	}
+
+	return balances
+}
+
+// AddTransaction adds or replaces the transaction stored under its hash
+func (state *State) AddTransaction(tx *data.TransactionOnNetwork) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+	if len(tx.Hash) == 0 {
+		return ErrEmptyTransactionHash
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	txCopy := *tx
+	state.transactions[tx.Hash] = &txCopy
+
+	return nil
+}
+
+// SetTransactionStatus changes the status of a stored transaction
+func (state *State) SetTransactionStatus(hash string, status string) error {
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	tx, found := state.transactions[hash]
+	if !found {
+		return fmt.Errorf("%w, hash: %s", ErrTransactionNotFound, hash)
+	}
+
+	tx.Status = status
+
+	return nil
+}
+
+// Transaction returns a copy of the transaction stored under the provided hash
+func (state *State) Transaction(hash string) (*data.TransactionOnNetwork, bool) {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	tx, found := state.transactions[hash]
+	if !found {
+		return nil, false
+	}
+
+	txCopy := *tx
+
+	return &txCopy, true
+}
+
+// AddHyperBlock adds the hyperblock, served both by nonce and by hash
+func (state *State) AddHyperBlock(hyperBlock *data.HyperBlock) error {
+	if hyperBlock == nil {
+		return ErrNilHyperBlock
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	hyperBlockCopy := *hyperBlock
+	state.hyperBlocksByNonce[hyperBlock.Nonce] = &hyperBlockCopy
+	if len(hyperBlock.Hash) > 0 {
+		state.hyperBlocksByHash[hyperBlock.Hash] = &hyperBlockCopy
+	}
+
+	return nil
+}
+
+// AddBlock adds the block in its shard, served both by nonce and by hash
+func (state *State) AddBlock(block *api.Block) error {
+	if block == nil {
+		return ErrNilBlock
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	blockCopy := *block
+	if state.blocksByNonce[block.Shard] == nil {
+		state.blocksByNonce[block.Shard] = make(map[uint64]*api.Block)
+		state.blocksByHash[block.Shard] = make(map[string]*api.Block)
+	}
+	state.blocksByNonce[block.Shard][block.Nonce] = &blockCopy
+	if len(block.Hash) > 0 {
+		state.blocksByHash[block.Shard][block.Hash] = &blockCopy
+	}
+
+	return nil
+}
+
+// HyperBlockByNonce returns a copy of the hyperblock with the provided nonce
+func (state *State) HyperBlockByNonce(nonce uint64) (*data.HyperBlock, bool) {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	return copyHyperBlock(state.hyperBlocksByNonce[nonce])
+}
+
+// HyperBlockByHash returns a copy of the hyperblock with the provided hash
+func (state *State) HyperBlockByHash(hash string) (*data.HyperBlock, bool) {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	return copyHyperBlock(state.hyperBlocksByHash[hash])
+}
+
+func copyHyperBlock(hyperBlock *data.HyperBlock) (*data.HyperBlock, bool) {
+	if hyperBlock == nil {
+		return nil, false
+	}
+
+	hyperBlockCopy := *hyperBlock
+
+	return &hyperBlockCopy, true
+}
+
+// BlockByNonce returns a copy of the shard's block with the provided nonce
+func (state *State) BlockByNonce(shardID uint32, nonce uint64) (*api.Block, bool) {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	return copyBlock(state.blocksByNonce[shardID][nonce])
+}
+
+// BlockByHash returns a copy of the shard's block with the provided hash
+func (state *State) BlockByHash(shardID uint32, hash string) (*api.Block, bool) {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	return copyBlock(state.blocksByHash[shardID][hash])
+}
+
+func copyBlock(block *api.Block) (*api.Block, bool) {
+	if block == nil {
+		return nil, false
+	}
+
+	blockCopy := *block
+
+	return &blockCopy, true
+}
+
+// SetVmQueryResponse sets the response of the VM queries on the contract's function. If the response has no
+// arguments, it is served for any arguments that do not have their own response
+func (state *State) SetVmQueryResponse(response *VmQueryResponse) error {
+	if response == nil || response.Output == nil {
+		return ErrNilVmOutput
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	key := createVmQueryKey(response.ScAddress, response.FuncName)
+	responses := make([]*VmQueryResponse, 0, len(state.vmQueries[key])+1)
+	for _, existing := range state.vmQueries[key] {
+		if !isSameArgs(existing.Args, response.Args) {
+			responses = append(responses, existing)
+		}
+	}
+
+	responseCopy := *response
+	state.vmQueries[key] = append(responses, &responseCopy)
+
+	return nil
+}
+
+func (state *State) getVmQueryOutput(request *data.VmValueRequest) (*vm.VMOutputApi, bool) {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	var wildcard *vm.VMOutputApi
+	for _, response := range state.vmQueries[createVmQueryKey(request.Address, request.FuncName)] {
+		if len(response.Args) == 0 {
+			wildcard = response.Output
+		}
+		if isSameArgs(response.Args, request.Args) {
+			return response.Output, true
+		}
+	}
+
+	return wildcard, wildcard != nil
+}
+
+func createVmQueryKey(scAddress string, funcName string) string {
+	return scAddress + "/" + funcName
+}
+
+func isSameArgs(args []string, otherArgs []string) bool {
+	if len(args) != len(otherArgs) {
+		return false
+	}
+	for i := range args {
+		if !strings.EqualFold(args[i], otherArgs[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SetRawResponse sets the response body served on the endpoint, for any HTTP method and query parameters. The raw
+// responses take precedence over the ones computed from the state and can serve any endpoint
+func (state *State) SetRawResponse(endpoint string, response []byte) error {
+	endpoint = normalizeEndpoint(endpoint)
+	if len(endpoint) == 0 {
+		return ErrEmptyEndpoint
+	}
+
+	state.mut.Lock()
+	defer state.mut.Unlock()
+
+	state.rawResponses[endpoint] = append([]byte{}, response...)
+
+	return nil
+}
+
+// LoadRawResponseFromFile sets the content of the file as the raw response of the endpoint
+func (state *State) LoadRawResponseFromFile(endpoint string, filePath string) error {
+	response, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	return state.SetRawResponse(endpoint, response)
+}
+
+func (state *State) getRawResponse(endpoint string) ([]byte, bool) {
+	state.mut.RLock()
+	defer state.mut.RUnlock()
+
+	response, found := state.rawResponses[normalizeEndpoint(endpoint)]
+
+	return response, found
+}
+
+func normalizeEndpoint(endpoint string) string {
+	endpoint, _, _ = strings.Cut(endpoint, "?")
+
+	return strings.Trim(endpoint, "/")
+}
+
+// LoadFixtureFromFile loads the JSON state fixture from the provided file
+func (state *State) LoadFixtureFromFile(filePath string) error {
+	buff, err := os.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	fixture := &StateFixture{}
+	err = json.Unmarshal(buff, fixture)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the fixture %s", err, filePath)
+	}
+
+	return state.LoadFixture(fixture, filepath.Dir(filePath))
+}
+
+// LoadFixture loads the fixture in the state. The raw response files are resolved relative to the provided directory
+func (state *State) LoadFixture(fixture *StateFixture, baseDir string) error {
+	if fixture.NetworkConfig != nil {
+		_ = state.SetNetworkConfig(fixture.NetworkConfig)
+	}
+	for shardID, status := range fixture.NetworkStatus {
+		err := state.SetNetworkStatus(shardID, status)
+		if err != nil {
+			return err
+		}
+	}
+	for _, account := range fixture.Accounts {
+		err := state.SetAccount(account)
+		if err != nil {
+			return err
+		}
+	}
+	for address, tokens := range fixture.DCDTBalances {
+		for tokenIdentifier, balanceString := range tokens {
+			balance, ok := big.NewInt(0).SetString(balanceString, 10)
+			if !ok {
+				return fmt.Errorf("%w, provided: %s for %s", ErrInvalidBalance, balanceString, tokenIdentifier)
+			}
+
+			err := state.SetDCDTBalance(address, tokenIdentifier, balance)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, tx := range fixture.Transactions {
+		err := state.AddTransaction(tx)
+		if err != nil {
+			return err
+		}
+	}
+	for _, hyperBlock := range fixture.HyperBlocks {
+		err := state.AddHyperBlock(hyperBlock)
+		if err != nil {
+			return err
+		}
+	}
+	for _, block := range fixture.Blocks {
+		err := state.AddBlock(block)
+		if err != nil {
+			return err
+		}
+	}
+	for _, vmQuery := range fixture.VmQueries {
+		err := state.SetVmQueryResponse(vmQuery)
+		if err != nil {
+			return fmt.Errorf("%w for %s", err, createVmQueryKey(vmQuery.ScAddress, vmQuery.FuncName))
+		}
+	}
+	for endpoint, response := range fixture.RawResponses {
+		err := state.SetRawResponse(endpoint, response)
+		if err != nil {
+			return err
+		}
+	}
+	for endpoint, file := range fixture.RawResponseFiles {
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(baseDir, file)
+		}
+
+		err := state.LoadRawResponseFromFile(endpoint, file)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package fakeProxy
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/vm"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestState_Setters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil values should error", func(t *testing.T) {
+		t.Parallel()
+
+		state := NewState()
+		assert.Equal(t, ErrNilNetworkConfig, state.SetNetworkConfig(nil))
+		assert.Equal(t, ErrNilNetworkStatus, state.SetNetworkStatus(0, nil))
+		assert.Equal(t, ErrNilAccount, state.SetAccount(nil))
+		assert.Equal(t, ErrEmptyTokenIdentifier, state.SetDCDTBalance(testAddress, "", big.NewInt(1)))
+		assert.Equal(t, ErrNilBalance, state.SetDCDTBalance(testAddress, "USDC-a1b2c3", nil))
+		assert.Equal(t, ErrNilTransaction, state.AddTransaction(nil))
+		assert.Equal(t, ErrEmptyTransactionHash, state.AddTransaction(&data.TransactionOnNetwork{}))
+		assert.Equal(t, ErrNilHyperBlock, state.AddHyperBlock(nil))
+		assert.Equal(t, ErrNilBlock, state.AddBlock(nil))
+		assert.Equal(t, ErrNilVmOutput, state.SetVmQueryResponse(&VmQueryResponse{}))
+		assert.Equal(t, ErrEmptyEndpoint, state.SetRawResponse("/", nil))
+		assert.True(t, errors.Is(state.SetTransactionStatus("aa", "success"), ErrTransactionNotFound))
+	})
+	t.Run("invalid account address should error", func(t *testing.T) {
+		t.Parallel()
+
+		state := NewState()
+		err := state.SetAccount(&data.Account{Address: "invalid"})
+		assert.NotNil(t, err)
+	})
+	t.Run("stored values should be copies", func(t *testing.T) {
+		t.Parallel()
+
+		state := NewState()
+		account := &data.Account{Address: testAddress, Nonce: 5}
+		err := state.SetAccount(account)
+		require.Nil(t, err)
+
+		account.Nonce = 6
+		assert.Equal(t, uint64(5), state.Account(testAddress).Nonce)
+		assert.Equal(t, "0", state.Account(testAddress).Balance)
+
+		state.Account(testAddress).Nonce = 7
+		assert.Equal(t, uint64(5), state.Account(testAddress).Nonce)
+	})
+	t.Run("vm query responses should be replaced by arguments", func(t *testing.T) {
+		t.Parallel()
+
+		state := NewState()
+		_ = state.SetVmQueryResponse(&VmQueryResponse{ScAddress: testScAddress, FuncName: "f", Args: []string{"01"}, Output: &vm.VMOutputApi{ReturnCode: "first"}})
+		_ = state.SetVmQueryResponse(&VmQueryResponse{ScAddress: testScAddress, FuncName: "f", Args: []string{"01"}, Output: &vm.VMOutputApi{ReturnCode: "second"}})
+
+		output, found := state.getVmQueryOutput(&data.VmValueRequest{Address: testScAddress, FuncName: "f", Args: []string{"01"}})
+		assert.True(t, found)
+		assert.Equal(t, "second", output.ReturnCode)
+		_, found = state.getVmQueryOutput(&data.VmValueRequest{Address: testScAddress, FuncName: "f", Args: []string{"02"}})
+		assert.False(t, found)
+	})
+}
+
+func TestState_LoadFixtureFromFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewState().LoadFixtureFromFile("testdata/missing.json")
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("invalid fixture should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "fixture.json")
+		_ = os.WriteFile(filePath, []byte(`{"accounts":[{"address":"invalid"}]}`), 0600)
+		err := NewState().LoadFixtureFromFile(filePath)
+		assert.NotNil(t, err)
+
+		_ = os.WriteFile(filePath, []byte(`not a json`), 0600)
+		err = NewState().LoadFixtureFromFile(filePath)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		state := NewState()
+		err := state.LoadFixtureFromFile("testdata/state.json")
+		require.Nil(t, err)
+
+		assert.Equal(t, "T", state.NetworkConfig().ChainID)
+		assert.Equal(t, uint64(37), state.Account(testAddress).Nonce)
+		_, found := state.Transaction("a1b2c3")
+		assert.True(t, found)
+		_, found = state.HyperBlockByHash("0a0b0c")
+		assert.True(t, found)
+		_, found = state.getRawResponse("block/0/by-nonce/21000000?withTxs=true")
+		assert.True(t, found)
+	})
+}
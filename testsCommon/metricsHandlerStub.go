@@ -0,0 +1,68 @@
+package testsCommon
+
+import "time"
+
+// MetricsHandlerStub -
+type MetricsHandlerStub struct {
+	ObserveRequestCalled              func(endpoint string, method string, statusCode int, duration time.Duration)
+	IncrementRetriesCalled            func(endpoint string, method string)
+	SetQueuedTransactionsCalled       func(address string, numTransactions int)
+	IncrementSentTransactionsCalled   func(address string)
+	IncrementFailedTransactionsCalled func(address string)
+	SetCurrentNonceCalled             func(address string, nonce uint64)
+	ObserveCacheLookupCalled          func(class string, isHit bool)
+}
+
+// ObserveRequest -
+func (stub *MetricsHandlerStub) ObserveRequest(endpoint string, method string, statusCode int, duration time.Duration) {
+	if stub.ObserveRequestCalled != nil {
+		stub.ObserveRequestCalled(endpoint, method, statusCode, duration)
+	}
+}
+
+// IncrementRetries -
+func (stub *MetricsHandlerStub) IncrementRetries(endpoint string, method string) {
+	if stub.IncrementRetriesCalled != nil {
+		stub.IncrementRetriesCalled(endpoint, method)
+	}
+}
+
+// SetQueuedTransactions -
+func (stub *MetricsHandlerStub) SetQueuedTransactions(address string, numTransactions int) {
+	if stub.SetQueuedTransactionsCalled != nil {
+		stub.SetQueuedTransactionsCalled(address, numTransactions)
+	}
+}
+
+// IncrementSentTransactions -
+func (stub *MetricsHandlerStub) IncrementSentTransactions(address string) {
+	if stub.IncrementSentTransactionsCalled != nil {
+		stub.IncrementSentTransactionsCalled(address)
+	}
+}
+
+// IncrementFailedTransactions -
+func (stub *MetricsHandlerStub) IncrementFailedTransactions(address string) {
+	if stub.IncrementFailedTransactionsCalled != nil {
+		stub.IncrementFailedTransactionsCalled(address)
+	}
+}
+
+// SetCurrentNonce -
+func (stub *MetricsHandlerStub) SetCurrentNonce(address string, nonce uint64) {
+	if stub.SetCurrentNonceCalled != nil {
+		stub.SetCurrentNonceCalled(address, nonce)
+	}
+}
+
+// ObserveCacheLookup -
+func (stub *MetricsHandlerStub) ObserveCacheLookup(class string, isHit bool) {
+	if stub.ObserveCacheLookupCalled != nil {
+		stub.ObserveCacheLookupCalled(class, isHit)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *MetricsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+// OutputsDecoderStub -
+type OutputsDecoderStub struct {
+	DecodeOutputsCalled func(function string, returnData [][]byte) ([]interface{}, error)
+}
+
+// DecodeOutputs -
+func (stub *OutputsDecoderStub) DecodeOutputs(function string, returnData [][]byte) ([]interface{}, error) {
+	if stub.DecodeOutputsCalled != nil {
+		return stub.DecodeOutputsCalled(function, returnData)
+	}
+
+	return make([]interface{}, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *OutputsDecoderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
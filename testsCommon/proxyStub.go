@@ -34,12 +34,15 @@ type ProxyStub struct {
 	GetHyperBlockByHashCalled            func(ctx context.Context, hash string) (*data.HyperBlock, error)
 	GetDefaultTransactionArgumentsCalled func(ctx context.Context, address sdkCore.AddressHandler, networkConfigs *data.NetworkConfig) (transaction.FrontendTransaction, string, error)
 	GetValidatorsInfoByEpochCalled       func(ctx context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error)
-	GetGuardianDataCalled                func(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error)
+	GetGuardianDataCalled                func(ctx context.Context, address sdkCore.AddressHandler) (*data.GuardianData, error)
 	FilterLogsCalled                     func(ctx context.Context, filter *sdkCore.FilterQuery) ([]string, error)
+	ProcessTransactionStatusCalled       func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	GetTransactionInfoWithResultsCalled  func(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	RequestTransactionCostCalled         func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
 }
 
 // ExecuteVMQuery -
-func (stub *ProxyStub) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+func (stub *ProxyStub) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest, _ api.AccountQueryOptions) (*data.VmValuesResponseData, error) {
 	if stub.ExecuteVMQueryCalled != nil {
 		return stub.ExecuteVMQueryCalled(ctx, vmRequest)
 	}
@@ -75,7 +78,7 @@ func (stub *ProxyStub) GetEnableEpochsConfig(_ context.Context) (*data.EnableEpo
 }
 
 // GetAccount -
-func (stub *ProxyStub) GetAccount(_ context.Context, address sdkCore.AddressHandler) (*data.Account, error) {
+func (stub *ProxyStub) GetAccount(_ context.Context, address sdkCore.AddressHandler, _ api.AccountQueryOptions) (*data.Account, error) {
 	if stub.GetAccountCalled != nil {
 		return stub.GetAccountCalled(address)
 	}
@@ -222,12 +225,12 @@ func (stub *ProxyStub) GetValidatorsInfoByEpoch(ctx context.Context, epoch uint3
 }
 
 // GetGuardianData -
-func (stub *ProxyStub) GetGuardianData(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error) {
+func (stub *ProxyStub) GetGuardianData(ctx context.Context, address sdkCore.AddressHandler, _ api.AccountQueryOptions) (*data.GuardianData, error) {
 	if stub.GetGuardianDataCalled != nil {
 		return stub.GetGuardianDataCalled(ctx, address)
 	}
 
-	return &api.GuardianData{}, nil
+	return &data.GuardianData{}, nil
 }
 
 // FilterLogs -
@@ -239,6 +242,33 @@ func (stub *ProxyStub) FilterLogs(ctx context.Context, filter *sdkCore.FilterQue
 	return nil, nil
 }
 
+// ProcessTransactionStatus -
+func (stub *ProxyStub) ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+	if stub.ProcessTransactionStatusCalled != nil {
+		return stub.ProcessTransactionStatusCalled(ctx, hexTxHash)
+	}
+
+	return transaction.TxStatusSuccess, nil
+}
+
+// GetTransactionInfoWithResults -
+func (stub *ProxyStub) GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+	if stub.GetTransactionInfoWithResultsCalled != nil {
+		return stub.GetTransactionInfoWithResultsCalled(ctx, hash)
+	}
+
+	return &data.TransactionInfo{}, nil
+}
+
+// RequestTransactionCost -
+func (stub *ProxyStub) RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+	if stub.RequestTransactionCostCalled != nil {
+		return stub.RequestTransactionCostCalled(ctx, tx)
+	}
+
+	return &data.TxCostResponseData{}, nil
+}
+
 // IsInterfaceNil -
 func (stub *ProxyStub) IsInterfaceNil() bool {
 	return stub == nil
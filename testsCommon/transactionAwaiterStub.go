@@ -0,0 +1,26 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// TransactionAwaiterStub -
+type TransactionAwaiterStub struct {
+	AwaitCalled func(ctx context.Context, txHash string) (*data.TransactionOutcome, error)
+}
+
+// Await -
+func (stub *TransactionAwaiterStub) Await(ctx context.Context, txHash string) (*data.TransactionOutcome, error) {
+	if stub.AwaitCalled != nil {
+		return stub.AwaitCalled(ctx, txHash)
+	}
+
+	return &data.TransactionOutcome{TxHash: txHash}, nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionAwaiterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,27 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// TransactionSimulatorStub -
+type TransactionSimulatorStub struct {
+	SimulateTransactionCalled func(tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulation, error)
+}
+
+// SimulateTransaction -
+func (stub *TransactionSimulatorStub) SimulateTransaction(_ context.Context, tx *transaction.FrontendTransaction, checkSignature bool) (*data.TransactionSimulation, error) {
+	if stub.SimulateTransactionCalled != nil {
+		return stub.SimulateTransactionCalled(tx, checkSignature)
+	}
+
+	return &data.TransactionSimulation{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionSimulatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
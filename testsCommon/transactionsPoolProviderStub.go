@@ -0,0 +1,37 @@
+package testsCommon
+
+import (
+	"context"
+
+	sdkCore "github.com/TerraDharitri/drt-go-sdk/core"
+	"github.com/TerraDharitri/drt-go-sdk/data"
+)
+
+// TransactionsPoolProviderStub -
+type TransactionsPoolProviderStub struct {
+	GetTransactionsPoolForSenderCalled          func(address sdkCore.AddressHandler) ([]*data.PoolTransaction, error)
+	GetTransactionsPoolNonceGapsForSenderCalled func(address sdkCore.AddressHandler) ([]data.NonceGap, error)
+}
+
+// GetTransactionsPoolForSender -
+func (stub *TransactionsPoolProviderStub) GetTransactionsPoolForSender(_ context.Context, address sdkCore.AddressHandler) ([]*data.PoolTransaction, error) {
+	if stub.GetTransactionsPoolForSenderCalled != nil {
+		return stub.GetTransactionsPoolForSenderCalled(address)
+	}
+
+	return make([]*data.PoolTransaction, 0), nil
+}
+
+// GetTransactionsPoolNonceGapsForSender -
+func (stub *TransactionsPoolProviderStub) GetTransactionsPoolNonceGapsForSender(_ context.Context, address sdkCore.AddressHandler) ([]data.NonceGap, error) {
+	if stub.GetTransactionsPoolNonceGapsForSenderCalled != nil {
+		return stub.GetTransactionsPoolNonceGapsForSenderCalled(address)
+	}
+
+	return make([]data.NonceGap, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionsPoolProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,52 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/TerraDharitri/drt-go-chain-core/data/transaction"
+)
+
+// TxNonceHandlerV3Stub -
+type TxNonceHandlerV3Stub struct {
+	ApplyNonceAndGasPriceCalled func(ctx context.Context, txs ...*transaction.FrontendTransaction) error
+	SendTransactionsCalled      func(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error)
+	ReleaseNonceCalled          func(txs ...*transaction.FrontendTransaction)
+	CloseCalled                 func()
+}
+
+// ApplyNonceAndGasPrice -
+func (stub *TxNonceHandlerV3Stub) ApplyNonceAndGasPrice(ctx context.Context, txs ...*transaction.FrontendTransaction) error {
+	if stub.ApplyNonceAndGasPriceCalled != nil {
+		return stub.ApplyNonceAndGasPriceCalled(ctx, txs...)
+	}
+
+	return nil
+}
+
+// SendTransactions -
+func (stub *TxNonceHandlerV3Stub) SendTransactions(ctx context.Context, txs ...*transaction.FrontendTransaction) ([]string, error) {
+	if stub.SendTransactionsCalled != nil {
+		return stub.SendTransactionsCalled(ctx, txs...)
+	}
+
+	return make([]string, len(txs)), nil
+}
+
+// ReleaseNonce -
+func (stub *TxNonceHandlerV3Stub) ReleaseNonce(txs ...*transaction.FrontendTransaction) {
+	if stub.ReleaseNonceCalled != nil {
+		stub.ReleaseNonceCalled(txs...)
+	}
+}
+
+// Close -
+func (stub *TxNonceHandlerV3Stub) Close() {
+	if stub.CloseCalled != nil {
+		stub.CloseCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TxNonceHandlerV3Stub) IsInterfaceNil() bool {
+	return stub == nil
+}